@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	httpopenapi "github.com/dibbla-agents/go-worker-starter-template/internal/http_handlers/openapi"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/openapi"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tsgen"
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
+)
+
+//go:generate go run . tsclient -o ../../frontend/src/api.gen.ts
+
+// runCommand runs a worker subcommand instead of starting the worker.
+func runCommand(serverName string, args []string) error {
+	switch args[0] {
+	case "openapi":
+		return openapiCommand(serverName, args[1:])
+	case "tsclient":
+		return tsclientCommand(serverName, args[1:])
+	case "invoke":
+		return invokeCommand(serverName, args[1:])
+	case "help", "-h", "--help":
+		printUsage()
+		return nil
+	default:
+		printUsage()
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+// printUsage lists the subcommands.
+func printUsage() {
+	fmt.Fprintln(os.Stderr, `Usage: worker [command]
+
+Without a command the worker connects to the gRPC server and serves HTTP.
+
+Commands:
+  openapi [-o file]   write the OpenAPI document for the HTTP API (default stdout)
+  tsclient [-o file]  write the TypeScript client for the frontend (default stdout)
+  invoke [function] [-i json | -f file] [-repeat n] [-concurrency n]
+                      run a worker function in this process and print its output;
+                      input is read from stdin when piped, otherwise {};
+                      without a function, list them`)
+}
+
+// openapiCommand writes the OpenAPI document for the routes registered by
+// newHTTPRouter, without connecting to the gRPC server.
+func openapiCommand(serverName string, args []string) error {
+	flags := flag.NewFlagSet("openapi", flag.ContinueOnError)
+	output := flags.String("o", "", "output file (default stdout)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	doc, err := apiDocument(serverName)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode OpenAPI document: %w", err)
+	}
+	data = append(data, '\n')
+
+	if err := writeOutput(*output, data); err != nil {
+		return err
+	}
+	if *output != "" {
+		fmt.Fprintf(os.Stderr, "✅ Wrote %s (%d operations)\n", *output, len(doc.Operations()))
+	}
+	return nil
+}
+
+// tsclientCommand writes the TypeScript client for the HTTP routes and the
+// worker functions (see internal/tsgen). Run it through go generate.
+func tsclientCommand(serverName string, args []string) error {
+	flags := flag.NewFlagSet("tsclient", flag.ContinueOnError)
+	output := flags.String("o", "", "output file (default stdout)")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	src, operations, functions, err := tsClient(serverName)
+	if err != nil {
+		return err
+	}
+	if err := writeOutput(*output, src); err != nil {
+		return err
+	}
+	if *output != "" {
+		fmt.Fprintf(os.Stderr, "✅ Wrote %s (%d operations, %d functions)\n", *output, operations, functions)
+	}
+	return nil
+}
+
+// tsClient generates the TypeScript client source and reports how many
+// operations and worker functions it covers.
+func tsClient(serverName string) (src []byte, operations, functions int, err error) {
+	doc, err := apiDocument(serverName)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	// The SPA's window.__APP_CONFIG__ (served at /config.js) gets an interface too
+	doc.SchemaFor(frontend.RuntimeConfig{})
+
+	defs := workerFunctions()
+	return tsgen.Generate(doc, defs), len(doc.Operations()), len(defs), nil
+}
+
+// apiDocument builds the router like the worker does and describes its routes.
+func apiDocument(serverName string) (*openapi.Document, error) {
+	policy, err := loadPolicy()
+	if err != nil {
+		return nil, err
+	}
+	router, err := newHTTPRouter(serverName, "127.0.0.1", modeConnected, policy, workerfunctions.NewLocalServer())
+	if err != nil {
+		return nil, err
+	}
+	return openapi.Generate(router.Routes(), httpopenapi.DefaultInfo(serverName)), nil
+}
+
+// writeOutput writes data to the file at path, or to stdout when path is empty.
+func writeOutput(path string, data []byte) error {
+	if path == "" {
+		_, err := os.Stdout.Write(data)
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
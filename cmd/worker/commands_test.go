@@ -0,0 +1,25 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// TestGeneratedClientIsUpToDate fails when routes, request/response types or
+// worker functions changed without regenerating frontend/src/api.gen.ts.
+func TestGeneratedClientIsUpToDate(t *testing.T) {
+	const path = "../../frontend/src/api.gen.ts"
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, _, _, err := tsClient("worker-starter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s is out of date, run: go generate ./cmd/worker", path)
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
+
+	// Built-in functions
+	"github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions/greeting"
+	processbatch "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions/process_batch"
+	// TODO: Import your worker functions here
+	// Example:
+	// myfunction "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions/my_function"
+)
+
+// workerFunctions lists the functions registered with the SDK server. The
+// TypeScript client generator reads their input and output types from here too.
+func workerFunctions() []workerfunctions.Definition {
+	return []workerfunctions.Definition{
+		workerfunctions.Simple(greeting.Function()),     // Simple example
+		workerfunctions.Simple(processbatch.Function()), // Calls a job
+
+		// TODO: Add your functions here
+		// workerfunctions.Simple(myfunction.Function()),
+	}
+}
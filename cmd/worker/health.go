@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	sdk "github.com/dibbla-agents/sdk-go"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/circuitbreaker"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/health"
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
+)
+
+// registerHealthChecks configures the shared health registry (HEALTH_*
+// environment variables) and registers the worker's own checks. Shared
+// resources register theirs in state.NewAsyncGlobalState.
+func registerHealthChecks(server workerfunctions.Server) error {
+	cfg, err := health.ConfigFromEnv()
+	if err != nil {
+		return fmt.Errorf("invalid health configuration: %w", err)
+	}
+	health.DefaultRegistry.Configure(cfg)
+
+	// The gRPC stream to the Dibbla server: without it no function is invoked.
+	// Offline mode has no stream to check.
+	if sdkServer, ok := server.(*sdk.Server); ok {
+		health.DefaultRegistry.Register(health.Check{
+			Name:     "dibbla_grpc",
+			Critical: true,
+			Run: func(ctx context.Context) error {
+				return sdkConnected(sdkServer)
+			},
+		})
+	} else {
+		log.Println("ℹ️  Health: dibbla_grpc check skipped (offline mode)")
+	}
+
+	// Any open breaker means an external dependency is failing
+	health.DefaultRegistry.Register(health.Check{
+		Name: "circuit_breakers",
+		Run: func(ctx context.Context) error {
+			for _, status := range circuitbreaker.DefaultRegistry.Statuses() {
+				if status.State == circuitbreaker.Open {
+					return fmt.Errorf("%s is open: %s", status.Name, status.LastError)
+				}
+			}
+			return nil
+		},
+	})
+	return nil
+}
+
+// sdkConnected reports whether the SDK server has started and its gRPC
+// communicator is connected. The SDK sets its global state in Start, so the
+// check fails until the worker has connected.
+func sdkConnected(server *sdk.Server) error {
+	gs := server.GetGlobalState()
+	if gs == nil {
+		return errors.New("not connected yet")
+	}
+	// The communicator type is internal to the SDK; gRPC mode reports its state
+	conn, ok := gs.WorkflowComm.(interface{ IsConnected() bool })
+	if ok && !conn.IsConnected() {
+		return errors.New("gRPC stream disconnected")
+	}
+	return nil
+}
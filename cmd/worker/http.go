@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/auth"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/circuitbreaker"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend/middleware"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/health"
+	httpadmin "github.com/dibbla-agents/go-worker-starter-template/internal/http_handlers/admin"
+	httpauth "github.com/dibbla-agents/go-worker-starter-template/internal/http_handlers/auth"
+	httpbreakers "github.com/dibbla-agents/go-worker-starter-template/internal/http_handlers/breakers"
+	httpfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/http_handlers/functions"
+	httpgreeting "github.com/dibbla-agents/go-worker-starter-template/internal/http_handlers/greeting"
+	httphealth "github.com/dibbla-agents/go-worker-starter-template/internal/http_handlers/health"
+	httpmetrics "github.com/dibbla-agents/go-worker-starter-template/internal/http_handlers/metrics"
+	httpopenapi "github.com/dibbla-agents/go-worker-starter-template/internal/http_handlers/openapi"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/jobs"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/rbac"
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
+)
+
+// loadPolicy loads the RBAC policy from RBAC_* environment variables.
+// Without RBAC_POLICY_FILE the returned enforcer allows everything.
+func loadPolicy() (*rbac.Enforcer, error) {
+	rbacConfig, err := rbac.ConfigFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("invalid RBAC configuration: %w", err)
+	}
+	policy, err := rbac.New(rbacConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load RBAC policy: %w", err)
+	}
+	if policy.Enabled() {
+		log.Printf("🛡️  RBAC policy loaded from %s", rbacConfig.PolicyFile)
+	}
+	return policy, nil
+}
+
+// newHTTPRouter builds the router serving the HTTP API and the embedded frontend.
+// Register your HTTP handlers here (optional - remove if not using frontend).
+// mode is the worker mode (see mode.go), reported by the admin API. local
+// runs the registered functions for the functions and admin APIs.
+func newHTTPRouter(serverName, httpHost, mode string, policy *rbac.Enforcer, local *workerfunctions.LocalServer) (*frontend.Router, error) {
+	router := frontend.NewRouter()
+	router.Static = frontend.StaticConfigFromEnv()
+	router.DevServer = frontend.DevServerFromEnv()
+	runtimeConfig := frontend.RuntimeConfigFromEnv(serverName)
+	router.RuntimeConfig = &runtimeConfig
+	router.Use(middleware.RequestID(), middleware.Recover(), middleware.Gzip())
+
+	// CORS is global so preflight OPTIONS requests are answered before routing
+	if cors := middleware.CORSConfigFromEnv(); len(cors.AllowedOrigins) > 0 {
+		router.Use(middleware.CORS(cors))
+	}
+	// Tracing and metrics go last so they see the route pattern the router matched
+	router.Use(middleware.Tracing("/healthz", "/readyz", "/metrics"), middleware.Metrics())
+
+	// API authentication (AUTH_* environment variables)
+	authConfig, err := auth.ConfigFromEnv()
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth configuration: %w", err)
+	}
+	authn, err := auth.New(authConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize auth: %w", err)
+	}
+	localOnly := httpHost == "127.0.0.1" || httpHost == "localhost"
+	if authn.Enabled() {
+		log.Printf("🔒 HTTP API auth enabled: %v", authn.Methods())
+	} else if !localOnly {
+		log.Printf("⚠️  HTTP API is listening on %s without authentication (set AUTH_TOKENS)", httpHost)
+	}
+
+	// Probes and metrics are outside /api: no access logs, no credentials
+	httphealth.Register(router, health.DefaultRegistry)
+	httpmetrics.Register(router, metrics.DefaultRegistry)
+
+	// API routes get access logs, request body size limits and the caller's identity
+	api := router.Group("/api",
+		middleware.AccessLog(),
+		middleware.BodyLimit(middleware.MaxBodyBytesFromEnv()),
+		authn.Middleware(),
+	)
+
+	httpauth.Register(api, authn)
+	httpopenapi.Register(api, router, httpopenapi.DefaultInfo(serverName))
+
+	// Routes below are also checked against the RBAC policy
+	protected := api.Group("", policy.Middleware())
+	httpgreeting.Register(protected.Group("", authn.Require("greeting")))
+	httpbreakers.Register(protected.Group("", authn.Require("health:read")), circuitbreaker.DefaultRegistry)
+
+	// Function calls over HTTP need the functions:invoke scope, or a policy to
+	// decide who may call what; without either they are only served on localhost
+	if authn.Enabled() || localOnly || policy.Enabled() {
+		httpfunctions.Register(protected.Group("", authn.Require("functions:invoke")), local, policy)
+	} else {
+		log.Printf("⚠️  Functions API disabled: HTTP API is listening on %s without authentication or RBAC policy", httpHost)
+	}
+
+	// The admin API needs the admin scope; without auth it is only served on localhost
+	if authn.Enabled() || localOnly {
+		httpadmin.Register(protected.Group("/admin", authn.Require("admin")), httpadmin.Options{
+			ServerName:  serverName,
+			Mode:        mode,
+			Functions:   workerFunctions(),
+			Invoker:     local,
+			Policy:      policy,
+			Jobs:        jobs.DefaultTracker,
+			Schedules:   jobs.DefaultScheduler,
+			Invocations: workerfunctions.DefaultInvocationLog,
+			Health:      health.DefaultRegistry,
+		})
+	} else {
+		log.Printf("⚠️  Admin API disabled: HTTP API is listening on %s without authentication", httpHost)
+	}
+
+	return router, nil
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tracing"
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
+)
+
+// invokeCommand runs a function from workerFunctions in this process, without
+// a gRPC connection or SERVER_API_TOKEN:
+//
+//	worker invoke greeting -i '{"name":"Ada"}'
+//	echo '{"batch_name":"b","item_count":5}' | worker invoke process_batch
+//	worker invoke greeting -f input.json -repeat 1000 -concurrency 20
+//
+// With -repeat the outputs are not printed; a latency summary is instead.
+func invokeCommand(serverName string, args []string) error {
+	flags := flag.NewFlagSet("invoke", flag.ContinueOnError)
+	inputFlag := flags.String("i", "", "JSON input")
+	file := flags.String("f", "", "read the JSON input from a file (- for stdin)")
+	repeat := flags.Int("repeat", 1, "number of calls")
+	concurrency := flags.Int("concurrency", 1, "calls in flight at once")
+
+	// The function name may come before the flags
+	var name string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if name == "" {
+		name = flags.Arg(0)
+	}
+	if *repeat < 1 || *concurrency < 1 {
+		return fmt.Errorf("-repeat and -concurrency must be at least 1")
+	}
+
+	functions := workerFunctions()
+	if name == "" {
+		printFunctions(functions)
+		return nil
+	}
+	fn, ok := findFunction(functions, name)
+	if !ok {
+		printFunctions(functions)
+		return fmt.Errorf("unknown function %q", name)
+	}
+
+	input, err := readInput(*inputFlag, *file)
+	if err != nil {
+		return err
+	}
+
+	// Traces go wherever TRACING_EXPORTER says, e.g. stdout while developing
+	tracingConfig, err := tracing.ConfigFromEnv(serverName)
+	if err != nil {
+		return fmt.Errorf("invalid tracing configuration: %w", err)
+	}
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingConfig)
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing: %w", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	if *repeat == 1 {
+		return invokeOnce(fn, input)
+	}
+	return invokeRepeated(fn, input, *repeat, *concurrency)
+}
+
+// invokeOnce prints the output as indented JSON, or fails with the function's error.
+func invokeOnce(fn workerfunctions.Definition, input []byte) error {
+	start := time.Now()
+	output, err := fn.Invoke(input)
+	elapsed := time.Since(start)
+	if err != nil {
+		return fmt.Errorf("%s failed after %s: %w", fn.Name, elapsed.Round(time.Microsecond), err)
+	}
+
+	var pretty bytes.Buffer
+	if json.Indent(&pretty, output, "", "  ") != nil {
+		pretty.Reset()
+		pretty.Write(output)
+	}
+	fmt.Println(pretty.String())
+	fmt.Fprintf(os.Stderr, "✅ %s v%s in %s\n", fn.Name, fn.Version, elapsed.Round(time.Microsecond))
+	return nil
+}
+
+// invokeRepeated calls fn repeat times from concurrency goroutines and prints
+// throughput, latency percentiles and the distinct errors.
+func invokeRepeated(fn workerfunctions.Definition, input []byte, repeat, concurrency int) error {
+	concurrency = min(concurrency, repeat)
+	fmt.Fprintf(os.Stderr, "🔁 Calling %s %d times (%d concurrent)...\n", fn.Name, repeat, concurrency)
+
+	var (
+		mu        sync.Mutex
+		durations = make([]time.Duration, 0, repeat)
+		errs      = make(map[string]int)
+		wg        sync.WaitGroup
+	)
+	calls := make(chan struct{}, repeat)
+	for range repeat {
+		calls <- struct{}{}
+	}
+	close(calls)
+
+	start := time.Now()
+	for range concurrency {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range calls {
+				callStart := time.Now()
+				_, err := fn.Invoke(input)
+				d := time.Since(callStart)
+
+				mu.Lock()
+				durations = append(durations, d)
+				if err != nil {
+					errs[err.Error()]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	failed := 0
+	for _, n := range errs {
+		failed += n
+	}
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	percentile := func(p float64) time.Duration {
+		return durations[int(p*float64(len(durations)-1))].Round(time.Microsecond)
+	}
+
+	fmt.Fprintf(os.Stderr, "📊 %d calls in %s (%.1f/s): %d ok, %d failed\n",
+		repeat, elapsed.Round(time.Millisecond), float64(repeat)/elapsed.Seconds(), repeat-failed, failed)
+	fmt.Fprintf(os.Stderr, "⏱️  p50 %s  p95 %s  p99 %s  max %s\n",
+		percentile(0.50), percentile(0.95), percentile(0.99), percentile(1))
+	for msg, n := range errs {
+		fmt.Fprintf(os.Stderr, "   ❌ %dx %s\n", n, msg)
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d calls failed", failed, repeat)
+	}
+	return nil
+}
+
+// readInput returns the JSON input from -i, -f or piped stdin, or {} when
+// none is given.
+func readInput(inline, file string) ([]byte, error) {
+	var input []byte
+	switch {
+	case inline != "" && file != "":
+		return nil, fmt.Errorf("use either -i or -f, not both")
+	case inline != "":
+		input = []byte(inline)
+	case file == "-" || (file == "" && stdinPiped()):
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read stdin: %w", err)
+		}
+		input = data
+	case file != "":
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read input: %w", err)
+		}
+		input = data
+	}
+
+	input = bytes.TrimSpace(input)
+	if len(input) == 0 {
+		return []byte("{}"), nil
+	}
+	if !json.Valid(input) {
+		return nil, errors.New("input is not valid JSON")
+	}
+	return input, nil
+}
+
+// stdinPiped reports whether stdin is a pipe or file rather than a terminal.
+func stdinPiped() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice == 0
+}
+
+func findFunction(functions []workerfunctions.Definition, name string) (workerfunctions.Definition, bool) {
+	for _, fn := range functions {
+		if fn.Name == name {
+			return fn, true
+		}
+	}
+	return workerfunctions.Definition{}, false
+}
+
+// printFunctions lists the functions invoke can run.
+func printFunctions(functions []workerfunctions.Definition) {
+	fmt.Fprintln(os.Stderr, "Functions:")
+	for _, fn := range functions {
+		fmt.Fprintf(os.Stderr, "  %-20s v%-8s %s\n", fn.Name, fn.Version, fn.Description)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"log"
 	"net/http"
 	"os"
@@ -9,20 +10,14 @@ import (
 	sdk "github.com/dibbla-agents/sdk-go"
 	"github.com/joho/godotenv"
 
-	// Built-in functions
-	"github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions/greeting"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tracing"
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
 
-	// Frontend and HTTP handlers (optional - remove if not using frontend)
-	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
-	httpgreeting "github.com/dibbla-agents/go-worker-starter-template/internal/http_handlers/greeting"
-
-	// TODO: Import your worker functions here
-	// Example:
-	// myfunction "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions/my_function"
+	// Worker functions are listed in functions.go
 
 	// Advanced: For functions needing shared state (database, cache, etc.)
 	// "github.com/dibbla-agents/go-worker-starter-template/internal/state"
-	// workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
 )
 
 // loadEnvFile loads environment variables from .env file, handling Windows UTF-8 BOM
@@ -49,8 +44,6 @@ func loadEnvFile() error {
 }
 
 func main() {
-	log.Println("🚀 Starting Worker...")
-
 	// Load environment variables from .env file
 	if err := loadEnvFile(); err != nil {
 		log.Println("⚠️  Warning: .env file not found, using system environment variables")
@@ -62,20 +55,32 @@ func main() {
 		serverName = "worker-starter"
 	}
 
-	serverApiToken := os.Getenv("SERVER_API_TOKEN")
-	if serverApiToken == "" {
-		log.Fatal("❌ SERVER_API_TOKEN environment variable is required")
+	// Subcommands (e.g. "worker openapi") run instead of the worker
+	if len(os.Args) > 1 {
+		if err := runCommand(serverName, os.Args[1:]); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		return
 	}
 
-	// gRPC server config (for self-hosted servers)
-	grpcServerAddress := os.Getenv("GRPC_SERVER_ADDRESS")
-	if grpcServerAddress == "" {
-		grpcServerAddress = "grpc.dibbla.com:443" // default Dibbla cloud
+	log.Println("🚀 Starting Worker...")
+
+	// Tracing (TRACING_* and OTEL_* environment variables; off by default)
+	tracingConfig, err := tracing.ConfigFromEnv(serverName)
+	if err != nil {
+		log.Fatalf("❌ Invalid tracing configuration: %v", err)
 	}
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingConfig)
+	if err != nil {
+		log.Fatalf("❌ Failed to set up tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
 
-	// TLS defaults to true (required for grpc.dibbla.com:443)
-	// Set GRPC_USE_TLS=false for local/dev servers without TLS
-	grpcUseTLS := os.Getenv("GRPC_USE_TLS") != "false"
+	// Worker mode: connected to the Dibbla gRPC server, or offline (WORKER_MODE)
+	mode, err := workerMode()
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
 
 	// HTTP server config (localhost only by default - no firewall prompt)
 	httpHost := os.Getenv("HTTP_HOST")
@@ -88,40 +93,97 @@ func main() {
 	}
 	httpAddr := httpHost + ":" + httpPort
 
-	// Create SDK server
-	log.Println("🔧 Creating SDK server...")
-	log.Printf("📡 Connecting to gRPC server: %s (TLS: %v)", grpcServerAddress, grpcUseTLS)
-	server, err := sdk.New(
-		sdk.WithServerName(serverName),
-		sdk.WithServerApiToken(serverApiToken),
-		sdk.WithGrpcServerAddress(grpcServerAddress),
-		sdk.WithGrpcTLS(grpcUseTLS),
-	)
+	// Functions register with the SDK server, or run in-process when offline.
+	// The HTTP API invokes them through the local server in both modes.
+	local := workerfunctions.NewLocalServer()
+	var server workerfunctions.Server = local
+	if mode == modeOffline {
+		log.Println("🔌 Offline mode (WORKER_MODE=offline): no Dibbla connection, functions run in-process")
+	} else {
+		serverApiToken := os.Getenv("SERVER_API_TOKEN")
+		if serverApiToken == "" {
+			log.Fatal("❌ SERVER_API_TOKEN environment variable is required (or set WORKER_MODE=offline)")
+		}
+
+		// gRPC server config (for self-hosted servers)
+		grpcServerAddress := os.Getenv("GRPC_SERVER_ADDRESS")
+		if grpcServerAddress == "" {
+			grpcServerAddress = "grpc.dibbla.com:443" // default Dibbla cloud
+		}
+
+		// TLS defaults to true (required for grpc.dibbla.com:443)
+		// Set GRPC_USE_TLS=false for local/dev servers without TLS
+		grpcUseTLS := os.Getenv("GRPC_USE_TLS") != "false"
+
+		// Create SDK server
+		log.Println("🔧 Creating SDK server...")
+		log.Printf("📡 Connecting to gRPC server: %s (TLS: %v)", grpcServerAddress, grpcUseTLS)
+		sdkServer, err := sdk.New(
+			sdk.WithServerName(serverName),
+			sdk.WithServerApiToken(serverApiToken),
+			sdk.WithGrpcServerAddress(grpcServerAddress),
+			sdk.WithGrpcTLS(grpcUseTLS),
+		)
+		if err != nil {
+			log.Fatalf("❌ Failed to create SDK server: %v", err)
+		}
+		server = sdkServer
+	}
+
+	// Health checks served at /healthz and /readyz (HEALTH_* environment variables)
+	if err := registerHealthChecks(server); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	// Access policy for functions and HTTP routes (RBAC_* environment variables)
+	policy, err := loadPolicy()
 	if err != nil {
-		log.Fatalf("❌ Failed to create SDK server: %v", err)
+		log.Fatalf("❌ %v", err)
 	}
+	defer policy.Log().Close()
 
 	// Register worker functions
 	log.Println("📝 Registering worker functions...")
 
-	// Register the greeting function (simple example)
-	greeting.Register(server)
-	log.Println("   ✅ Registered: greeting")
-
-	// TODO: Register your functions here
-	// myfunction.Register(server)
+	// Functions listed in workerFunctions (functions.go)
+	// Functions the policy denies to the gRPC subject are not exposed
+	for _, fn := range workerFunctions() {
+		if !policy.CanInvoke(policy.GRPCIdentity(), fn.Name).Allowed {
+			log.Printf("   🚫 Skipped: %s (not allowed by policy)", fn.Name)
+			continue
+		}
+		if mode == modeConnected {
+			server.RegisterFunction(fn.Function)
+		}
+		local.Register(fn)
+		metrics.InitFunction(fn.Name, fn.Version)
+		log.Printf("   ✅ Registered: %s", fn.Name)
+	}
 
 	// Advanced: For functions needing shared state (database, etc.)
 	// Uncomment the imports above and use:
 	// ags, err := state.NewAsyncGlobalState()
 	// registry := workerfunctions.NewRegistry()
 	// registry.Register(examplefunction.NewExampleFunction())
-	// registry.RegisterAll(server, ags)
+	// registry.SetPolicy(policy)
+	// if mode == modeConnected {
+	// 	registry.RegisterAll(server, ags)
+	// }
+	// registry.RegisterAll(local, ags) // Invoked by the HTTP API
+
+	// Job queue limit and scheduled jobs (schedules.go)
+	if err := startJobs(); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
 
 	// Start HTTP server with frontend (optional - remove if not using frontend)
-	router := frontend.NewRouter()
-	httpgreeting.Register(router.Mux())
-	log.Println("   ✅ HTTP: POST /api/greeting")
+	router, err := newHTTPRouter(serverName, httpHost, mode, policy, local)
+	if err != nil {
+		log.Fatalf("❌ Failed to set up HTTP routes: %v", err)
+	}
+	for _, route := range router.Routes() {
+		log.Printf("   ✅ HTTP: %s", route.Pattern)
+	}
 
 	go func() {
 		log.Printf("🌐 Starting HTTP server on %s", httpAddr)
@@ -130,8 +192,8 @@ func main() {
 		}
 	}()
 
-	// Start the server (blocks forever)
-	log.Printf("🎯 Starting worker server '%s'...", serverName)
+	// Start the server (blocks forever; offline until interrupted)
+	log.Printf("🎯 Starting worker server '%s' (%s mode)...", serverName, mode)
 	if err := server.Start(); err != nil {
 		log.Fatalf("❌ Failed to start server: %v", err)
 	}
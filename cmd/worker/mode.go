@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Worker modes (WORKER_MODE)
+const (
+	// The SDK server connects to the Dibbla gRPC server and serves functions
+	modeConnected = "connected"
+	// No Dibbla connection: functions run in-process (workerfunctions.LocalServer)
+	// and only the HTTP API is served. SERVER_API_TOKEN is not needed.
+	modeOffline = "offline"
+)
+
+// workerMode reads WORKER_MODE (default connected).
+func workerMode() (string, error) {
+	mode := strings.ToLower(strings.TrimSpace(os.Getenv("WORKER_MODE")))
+	switch mode {
+	case "":
+		return modeConnected, nil
+	case modeConnected, modeOffline:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("invalid WORKER_MODE %q (want %s or %s)", mode, modeConnected, modeOffline)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/jobs"
+)
+
+// workerSchedules lists jobs that run at a fixed interval. They start with the
+// worker and are listed at GET /api/admin/schedules.
+func workerSchedules() []jobs.Schedule {
+	return []jobs.Schedule{
+		// TODO: Add your schedules here
+		// {
+		// 	Name:  "cleanup",
+		// 	Every: time.Hour,
+		// 	Run: func(ctx context.Context) error {
+		// 		return jobs.NewSimpleJob("cleanup", 10).ExecuteContext(ctx).Error
+		// 	},
+		// },
+	}
+}
+
+// startJobs applies the job concurrency limit (JOBS_MAX_CONCURRENT) and starts
+// the schedules from workerSchedules.
+func startJobs() error {
+	concurrency, err := jobs.ConcurrencyFromEnv()
+	if err != nil {
+		return err
+	}
+	jobs.DefaultTracker.SetConcurrency(concurrency)
+	if concurrency > 0 {
+		log.Printf("🧵 Jobs limited to %d at a time; the rest are queued", concurrency)
+	}
+
+	for _, schedule := range workerSchedules() {
+		if err := jobs.DefaultScheduler.Add(schedule); err != nil {
+			return fmt.Errorf("invalid schedule: %w", err)
+		}
+		log.Printf("   ⏰ Scheduled: %s every %s", schedule.Name, schedule.Every)
+	}
+	jobs.DefaultScheduler.Start(context.Background())
+	return nil
+}
@@ -1,32 +1,57 @@
-import { useState } from 'react'
+import { useEffect, useState } from 'react'
+import { getAuthMe } from './api.gen'
+import { config } from './config'
+import Login from './Login'
+import Functions from './pages/Functions'
+import Greeting from './pages/Greeting'
+import Invocations from './pages/Invocations'
+import Jobs from './pages/Jobs'
+import Overview from './pages/Overview'
+
+// Pages by URL hash, e.g. #/jobs. The dashboard pages use /api/admin (admin scope).
+const pages = [
+  { path: '', title: 'Greeting' },
+  { path: 'overview', title: 'Overview' },
+  { path: 'functions', title: 'Functions' },
+  { path: 'jobs', title: 'Jobs' },
+  { path: 'invocations', title: 'Invocations' },
+]
+
+const currentPath = () => window.location.hash.replace(/^#\/?/, '')
 
 function App() {
-  const [name, setName] = useState('')
-  const [response, setResponse] = useState('')
-  const [loading, setLoading] = useState(false)
-  const [isError, setIsError] = useState(false)
+  // null until GET /api/auth/me answers
+  const [needsLogin, setNeedsLogin] = useState<boolean | null>(null)
+  const [path, setPath] = useState(currentPath)
 
-  const callGreeting = async () => {
-    setLoading(true)
-    setIsError(false)
+  const checkAuth = async () => {
     try {
-      const res = await fetch('/api/greeting', {
-        method: 'POST',
-        headers: { 'Content-Type': 'application/json' },
-        body: JSON.stringify({ name }),
-      })
-      const data = await res.json()
-      setResponse(data.message || JSON.stringify(data, null, 2))
-    } catch (err) {
-      setIsError(true)
-      setResponse(`Error: ${err}`)
+      const me = await getAuthMe()
+      setNeedsLogin(me.auth_enabled && !me.authenticated)
+    } catch {
+      setNeedsLogin(false)
     }
-    setLoading(false)
   }
 
-  const handleKeyDown = (e: React.KeyboardEvent) => {
-    if (e.key === 'Enter' && name && !loading) {
-      callGreeting()
+  useEffect(() => {
+    checkAuth()
+    const onHashChange = () => setPath(currentPath())
+    window.addEventListener('hashchange', onHashChange)
+    return () => window.removeEventListener('hashchange', onHashChange)
+  }, [])
+
+  const page = () => {
+    switch (path) {
+      case 'overview':
+        return <Overview />
+      case 'functions':
+        return <Functions />
+      case 'jobs':
+        return <Jobs />
+      case 'invocations':
+        return <Invocations />
+      default:
+        return <Greeting onUnauthorized={() => setNeedsLogin(true)} />
     }
   }
 
@@ -37,36 +62,22 @@ function App() {
         <p className="subtitle">Test your dibbla worker functions</p>
       </header>
 
+      <nav>
+        {pages.map((p) => (
+          <a key={p.path} href={`#/${p.path}`} className={path === p.path ? 'active' : ''}>
+            {p.title}
+          </a>
+        ))}
+      </nav>
+
       <main>
-        <section className="card">
-          <h2>Greeting Function</h2>
-          <div className="input-group">
-            <input
-              type="text"
-              placeholder="Enter your name..."
-              value={name}
-              onChange={(e) => setName(e.target.value)}
-              onKeyDown={handleKeyDown}
-            />
-            <button onClick={callGreeting} disabled={loading || !name}>
-              {loading ? (
-                <span className="loading-dots">Calling</span>
-              ) : (
-                'Call Function'
-              )}
-            </button>
-          </div>
-          {response && (
-            <div className={`response ${isError ? 'error' : ''}`}>
-              <strong>Response</strong>
-              <pre>{response}</pre>
-            </div>
-          )}
-        </section>
+        {needsLogin && <Login onLogin={() => setNeedsLogin(false)} />}
+        {/* Remount after login so pages refetch with the new session */}
+        {needsLogin === false && page()}
       </main>
 
       <footer>
-        <p>Worker Starter Template</p>
+        <p>{config.server_name} · {config.version}</p>
       </footer>
     </div>
   )
@@ -0,0 +1,30 @@
+import { ApiError } from './api.gen'
+
+// ErrorNotice explains why an admin endpoint failed
+function ErrorNotice({ error }: { error: unknown }) {
+  if (!error) return null
+
+  let message = `Error: ${error}`
+  if (error instanceof ApiError) {
+    switch (error.status) {
+      case 401:
+        message = 'Sign in to see this page.'
+        break
+      case 403:
+        message = 'This page needs a token with the admin scope.'
+        break
+      case 404:
+        message = 'The admin API is not enabled on this worker (it needs AUTH_TOKENS when not on localhost).'
+        break
+      default:
+        message = error.message
+    }
+  }
+  return (
+    <div className="response error">
+      <pre>{message}</pre>
+    </div>
+  )
+}
+
+export default ErrorNotice
@@ -0,0 +1,47 @@
+import { useState } from 'react'
+import { ApiError, postAuthLogin } from './api.gen'
+
+// Exchanges an API token for a session cookie via POST /api/auth/login
+function Login({ onLogin }: { onLogin: () => void }) {
+  const [token, setToken] = useState('')
+  const [error, setError] = useState('')
+  const [loading, setLoading] = useState(false)
+
+  const login = async () => {
+    setLoading(true)
+    setError('')
+    try {
+      await postAuthLogin({ token })
+      setToken('')
+      onLogin()
+    } catch (err) {
+      setError(err instanceof ApiError ? err.message : `Error: ${err}`)
+    }
+    setLoading(false)
+  }
+
+  return (
+    <section className="card">
+      <h2>Sign In</h2>
+      <div className="input-group">
+        <input
+          type="password"
+          placeholder="API token..."
+          value={token}
+          onChange={(e) => setToken(e.target.value)}
+          onKeyDown={(e) => e.key === 'Enter' && token && !loading && login()}
+        />
+        <button onClick={login} disabled={loading || !token}>
+          {loading ? <span className="loading-dots">Signing in</span> : 'Sign In'}
+        </button>
+      </div>
+      {error && (
+        <div className="response error">
+          <pre>{error}</pre>
+        </div>
+      )}
+    </section>
+  )
+}
+
+export default Login
@@ -0,0 +1,423 @@
+// Code generated by "worker tsclient"; DO NOT EDIT.
+// Client for worker-starter API 1.0.0. Regenerate with: go generate ./cmd/worker
+
+export interface AdminBuild {
+  go_version: string
+  module: string
+  vcs_modified: boolean
+  vcs_revision?: string
+  vcs_time?: string
+  version: string
+}
+
+export interface AdminConfigOutput {
+  settings: AdminSetting[]
+}
+
+export interface AdminFunction {
+  description: string
+  exposed: boolean
+  input_schema?: OpenapiSchema
+  invocations: MetricsFunctionStats
+  name: string
+  output_schema?: OpenapiSchema
+  tags: string[]
+  version: string
+}
+
+export interface AdminFunctionsOutput {
+  functions: AdminFunction[]
+  schemas: Record<string, OpenapiSchema>
+}
+
+export interface AdminInfoOutput {
+  build: AdminBuild
+  mode: string
+  runtime: AdminRuntime
+  server_name: string
+  started_at: string
+  uptime_seconds: number
+}
+
+export interface AdminInvocationsOutput {
+  invocations: WorkerFunctionsInvocation[]
+}
+
+export interface AdminInvokeOutput {
+  duration_ms: number
+  error?: string
+  output: unknown
+}
+
+export interface AdminJobsOutput {
+  jobs: JobsJob[]
+}
+
+export interface AdminRuntime {
+  gc_cycles: number
+  gc_pause_total_ms: number
+  gomaxprocs: number
+  goroutines: number
+  heap_alloc_bytes: number
+  heap_objects: number
+  heap_sys_bytes: number
+}
+
+export interface AdminSchedulesOutput {
+  schedules: JobsScheduleStatus[]
+}
+
+export interface AdminSetting {
+  name: string
+  redacted: boolean
+  value: string
+}
+
+export interface AdminSummaryOutput {
+  errors: number
+  goroutines: number
+  health: HealthReport
+  heap_bytes: number
+  in_flight: number
+  invocations: number
+  mode: string
+  queued_jobs: number
+  running_jobs: number
+  uptime_seconds: number
+}
+
+export interface AuthIdentity {
+  expires_at?: string | null
+  method: string
+  roles?: string[]
+  scopes?: string[]
+  subject: string
+}
+
+export interface AuthLoginInput {
+  token: string
+}
+
+export interface AuthLoginOutput {
+  expires_at: string
+  scopes: string[]
+  subject: string
+}
+
+export interface AuthMeOutput {
+  auth_enabled: boolean
+  authenticated: boolean
+  identity?: AuthIdentity
+}
+
+export interface BreakersOutput {
+  breakers: CircuitbreakerStatus[]
+  status: string
+}
+
+export interface CircuitbreakerStatus {
+  consecutive_failures: number
+  failures_total: number
+  last_error?: string
+  name: string
+  opened_at?: string | null
+  rejected_total: number
+  retry_at?: string | null
+  state: string
+  successes_total: number
+}
+
+export interface FieldError {
+  field: string
+  message: string
+}
+
+export interface FrontendRuntimeConfig {
+  api_base: string
+  features: Record<string, boolean>
+  server_name: string
+  version: string
+}
+
+export interface FunctionsListOutput {
+  functions: string[]
+}
+
+export interface GreetingInput {
+  name: string
+}
+
+export interface GreetingOutput {
+  message: string
+}
+
+export interface HealthReport {
+  checks: HealthResult[]
+  status: string
+}
+
+export interface HealthResult {
+  cached: boolean
+  checked_at: string
+  critical: boolean
+  duration_ms: number
+  error?: string
+  name: string
+  status: string
+}
+
+export interface JobsJob {
+  done: number
+  error?: string
+  finished_at?: string | null
+  id: string
+  name?: string
+  queued_at: string
+  started_at?: string | null
+  status: string
+  total: number
+  type: string
+}
+
+export interface JobsScheduleStatus {
+  interval_seconds: number
+  last_error?: string
+  last_run?: string | null
+  name: string
+  next_run?: string | null
+  running: boolean
+  runs: number
+  skipped: number
+}
+
+export interface MetricsFunctionStats {
+  avg_duration_ms: number
+  errors: number
+  in_flight: number
+  panics: number
+  success: number
+}
+
+export interface OpenapiSchema {
+  $ref?: string
+  additionalProperties?: OpenapiSchema
+  description?: string
+  enum?: unknown[]
+  format?: string
+  items?: OpenapiSchema
+  properties?: Record<string, OpenapiSchema>
+  required?: string[]
+  type?: unknown
+}
+
+export interface Problem {
+  code?: string
+  detail?: string
+  errors?: FieldError[]
+  instance?: string
+  request_id?: string
+  status: number
+  title: string
+  type: string
+}
+
+export interface ProcessBatchInput {
+  batch_name: string
+  item_count: number
+}
+
+export interface ProcessBatchOutput {
+  duration_ms: number
+  error?: string
+  items_processed: number
+  success: boolean
+}
+
+export interface WorkerFunctionsGreetingInput {
+  name: string
+}
+
+export interface WorkerFunctionsGreetingOutput {
+  message: string
+}
+
+export interface WorkerFunctionsInvocation {
+  duration_ms: number
+  error?: string
+  function: string
+  outcome: string
+  started_at: string
+  trace_id?: string
+  version: string
+}
+
+/** Error thrown for non-2xx responses; problem holds the RFC 9457 body. */
+export class ApiError extends Error {
+  readonly status: number
+  readonly problem: Problem
+
+  constructor(status: number, problem: Problem) {
+    super(problem.detail || problem.title || `Request failed (${status})`)
+    this.name = 'ApiError'
+    this.status = status
+    this.problem = problem
+  }
+}
+
+/** Options applied to every request. */
+export interface ClientOptions {
+  baseUrl?: string
+  headers?: Record<string, string>
+}
+
+let clientOptions: ClientOptions = {}
+
+/** Sets the base URL (default same origin) and extra headers, e.g. Authorization. */
+export function configureClient(options: ClientOptions): void {
+  clientOptions = { ...clientOptions, ...options }
+}
+
+async function send(method: string, path: string, body?: unknown): Promise<Response> {
+  const headers: Record<string, string> = { Accept: 'application/json', ...clientOptions.headers }
+  if (body !== undefined) headers['Content-Type'] = 'application/json'
+  const res = await fetch((clientOptions.baseUrl ?? '') + path, {
+    method,
+    headers,
+    body: body === undefined ? undefined : JSON.stringify(body),
+    credentials: 'same-origin',
+  })
+  if (!res.ok) {
+    const problem = await res.json().catch(() => ({ type: 'about:blank', title: res.statusText, status: res.status }))
+    throw new ApiError(res.status, problem as Problem)
+  }
+  return res
+}
+
+async function request<T>(method: string, path: string, body?: unknown): Promise<T> {
+  const res = await send(method, path, body)
+  if (res.status === 204) return undefined as T
+  return (await res.json()) as T
+}
+
+/** Worker settings from the environment, with secrets redacted */
+export function getAdminConfig(): Promise<AdminConfigOutput> {
+  return request('GET', '/api/admin/config')
+}
+
+/** Worker functions with schemas and invocation counts since startup */
+export function getAdminFunctions(): Promise<AdminFunctionsOutput> {
+  return request('GET', '/api/admin/functions')
+}
+
+/** Run a worker function in this process */
+export function postAdminFunctionsByNameInvoke(name: string, body: unknown): Promise<AdminInvokeOutput> {
+  return request('POST', `/api/admin/functions/${encodeURIComponent(name)}/invoke`, body)
+}
+
+/** Build info, uptime and Go runtime stats */
+export function getAdminInfo(): Promise<AdminInfoOutput> {
+  return request('GET', '/api/admin/info')
+}
+
+/** Recent worker function invocations, newest first */
+export function getAdminInvocations(): Promise<AdminInvocationsOutput> {
+  return request('GET', '/api/admin/invocations')
+}
+
+/** Queued, running and recently finished jobs */
+export function getAdminJobs(): Promise<AdminJobsOutput> {
+  return request('GET', '/api/admin/jobs')
+}
+
+/** Cancel a queued or running job */
+export function postAdminJobsByIdCancel(id: string): Promise<JobsJob> {
+  return request('POST', `/api/admin/jobs/${encodeURIComponent(id)}/cancel`)
+}
+
+/** Scheduled jobs with their last and next run */
+export function getAdminSchedules(): Promise<AdminSchedulesOutput> {
+  return request('GET', '/api/admin/schedules')
+}
+
+/** Health, invocation totals and running and queued jobs for the dashboard */
+export function getAdminSummary(): Promise<AdminSummaryOutput> {
+  return request('GET', '/api/admin/summary')
+}
+
+/** Exchange an API token for a session cookie */
+export function postAuthLogin(body: AuthLoginInput): Promise<AuthLoginOutput> {
+  return request('POST', '/api/auth/login', body)
+}
+
+/** Clear the session cookie */
+export function postAuthLogout(): Promise<void> {
+  return request('POST', '/api/auth/logout')
+}
+
+/** Describe the current caller */
+export function getAuthMe(): Promise<AuthMeOutput> {
+  return request('GET', '/api/auth/me')
+}
+
+/** API explorer page (HTML) */
+export function getDocs(): Promise<Response> {
+  return send('GET', '/api/docs')
+}
+
+/** Worker functions that can be invoked over HTTP */
+export function getFunctions(): Promise<FunctionsListOutput> {
+  return request('GET', '/api/functions')
+}
+
+/** Run a worker function in this process */
+export function postFunctionsByNameInvoke(name: string, body: unknown): Promise<unknown> {
+  return request('POST', `/api/functions/${encodeURIComponent(name)}/invoke`, body)
+}
+
+/** Greet someone by name */
+export function postGreeting(body: GreetingInput): Promise<GreetingOutput> {
+  return request('POST', '/api/greeting', body)
+}
+
+/** Circuit breaker state for external dependencies */
+export function getHealthBreakers(): Promise<BreakersOutput> {
+  return request('GET', '/api/health/breakers')
+}
+
+/** OpenAPI document for this API */
+export function getOpenapiJson(): Promise<Response> {
+  return send('GET', '/api/openapi.json')
+}
+
+/** Liveness probe */
+export function getHealthz(): Promise<HealthReport> {
+  return request('GET', '/healthz')
+}
+
+/** Prometheus metrics (text exposition format) */
+export function getMetrics(): Promise<Response> {
+  return send('GET', '/metrics')
+}
+
+/** Readiness probe with per-check detail */
+export function getReadyz(): Promise<HealthReport> {
+  return request('GET', '/readyz')
+}
+
+/** Input and output types of the worker functions. */
+export interface WorkerFunctionTypes {
+  /** Generate a greeting message (v1.0.0) */
+  greeting: { input: WorkerFunctionsGreetingInput; output: WorkerFunctionsGreetingOutput }
+  /** Process a batch of items using a job (v1.0.0) */
+  process_batch: { input: ProcessBatchInput; output: ProcessBatchOutput }
+}
+
+export type WorkerFunctionName = keyof WorkerFunctionTypes
+export type WorkerFunctionInput<N extends WorkerFunctionName> = WorkerFunctionTypes[N]['input']
+export type WorkerFunctionOutput<N extends WorkerFunctionName> = WorkerFunctionTypes[N]['output']
+
+/** Metadata of the worker functions registered by the worker. */
+export const workerFunctions: Record<WorkerFunctionName, { version: string; description: string; tags: string[] }> = {
+  greeting: { version: '1.0.0', description: 'Generate a greeting message', tags: [] },
+  process_batch: { version: '1.0.0', description: 'Process a batch of items using a job', tags: [] },
+}
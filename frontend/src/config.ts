@@ -0,0 +1,19 @@
+import type { FrontendRuntimeConfig } from './api.gen'
+
+declare global {
+  interface Window {
+    // Set by /config.js, which the worker generates from frontend.RuntimeConfig
+    __APP_CONFIG__?: FrontendRuntimeConfig
+  }
+}
+
+// Defaults apply when the page isn't served through the worker (e.g. vite preview)
+export const config: FrontendRuntimeConfig = window.__APP_CONFIG__ ?? {
+  server_name: 'worker',
+  api_base: '/api',
+  version: 'dev',
+  features: {},
+}
+
+// feature reports whether a FRONTEND_FEATURES flag is on
+export const feature = (name: string): boolean => config.features[name] === true
@@ -0,0 +1,137 @@
+import { useState } from 'react'
+import {
+  type AdminFunction,
+  type AdminInvokeOutput,
+  ApiError,
+  getAdminFunctions,
+  type OpenapiSchema,
+  postAdminFunctionsByNameInvoke,
+} from '../api.gen'
+import ErrorNotice from '../ErrorNotice'
+import { usePolling } from '../usePolling'
+
+type Schemas = Record<string, OpenapiSchema>
+
+// resolve follows a "#/components/schemas/<name>" reference
+const resolve = (schema: OpenapiSchema | undefined, schemas: Schemas): OpenapiSchema | undefined =>
+  schema?.$ref ? schemas[schema.$ref.split('/').pop()!] : schema
+
+// example builds a starting input from a schema: empty strings, zeros, etc.
+const example = (schema: OpenapiSchema | undefined, schemas: Schemas, depth = 0): unknown => {
+  const s = resolve(schema, schemas)
+  if (!s || depth > 5) return null
+  const type = Array.isArray(s.type) ? s.type[0] : s.type
+  switch (type) {
+    case 'object': {
+      const value: Record<string, unknown> = {}
+      for (const [name, prop] of Object.entries(s.properties ?? {})) {
+        value[name] = example(prop, schemas, depth + 1)
+      }
+      return value
+    }
+    case 'array':
+      return []
+    case 'string':
+      return s.enum?.[0] ?? ''
+    case 'integer':
+    case 'number':
+      return 0
+    case 'boolean':
+      return false
+  }
+  return null
+}
+
+// TryIt edits a function input as JSON, prefilled from the input schema
+function TryIt({ fn, schemas }: { fn: AdminFunction; schemas: Schemas }) {
+  const [input, setInput] = useState(() => JSON.stringify(example(fn.input_schema, schemas), null, 2))
+  const [result, setResult] = useState<AdminInvokeOutput | null>(null)
+  const [error, setError] = useState('')
+  const [loading, setLoading] = useState(false)
+  const fields = resolve(fn.input_schema, schemas)
+
+  const invoke = async () => {
+    setLoading(true)
+    setError('')
+    setResult(null)
+    try {
+      setResult(await postAdminFunctionsByNameInvoke(fn.name, JSON.parse(input)))
+    } catch (err) {
+      setError(err instanceof ApiError ? err.message : `${err}`)
+    }
+    setLoading(false)
+  }
+
+  return (
+    <div className="try-it">
+      {fields?.properties && (
+        <p className="hint">
+          Fields:{' '}
+          {Object.entries(fields.properties).map(([name, prop]) => (
+            <code key={name}>
+              {name}
+              {fields.required?.includes(name) ? '' : '?'}: {String(resolve(prop, schemas)?.type ?? 'any')}
+            </code>
+          ))}
+        </p>
+      )}
+      <textarea value={input} onChange={(e) => setInput(e.target.value)} rows={6} spellCheck={false} />
+      <button onClick={invoke} disabled={loading}>
+        {loading ? <span className="loading-dots">Calling</span> : 'Call Function'}
+      </button>
+      {error && (
+        <div className="response error">
+          <pre>{error}</pre>
+        </div>
+      )}
+      {result && (
+        <div className={`response ${result.error ? 'error' : ''}`}>
+          <strong>Response · {result.duration_ms.toFixed(1)} ms</strong>
+          <pre>{result.error ?? JSON.stringify(result.output, null, 2)}</pre>
+        </div>
+      )}
+    </div>
+  )
+}
+
+// Functions lists the registered worker functions with a "try it" form
+function Functions() {
+  const { data, error } = usePolling(getAdminFunctions, 10000)
+  const [open, setOpen] = useState('')
+
+  return (
+    <section className="card">
+      <h2>Worker Functions</h2>
+      <ErrorNotice error={error} />
+      {data?.functions.map((fn) => (
+        <div key={fn.name} className="function">
+          <div className="function-header" onClick={() => setOpen(open === fn.name ? '' : fn.name)}>
+            <div>
+              <strong>{fn.name}</strong> <span className="muted">v{fn.version}</span>
+              {!fn.exposed && <span className="badge fail">not exposed</span>}
+              {fn.tags.map((tag) => (
+                <span key={tag} className="tag">{tag}</span>
+              ))}
+              <p className="muted">{fn.description}</p>
+            </div>
+            <div className="counts">
+              <span>{fn.invocations.success} ok</span>
+              <span className={fn.invocations.errors + fn.invocations.panics > 0 ? 'error-text' : ''}>
+                {fn.invocations.errors + fn.invocations.panics} failed
+              </span>
+              <span>{fn.invocations.avg_duration_ms.toFixed(1)} ms avg</span>
+            </div>
+          </div>
+          {open === fn.name &&
+            (fn.exposed ? (
+              <TryIt fn={fn} schemas={data.schemas} />
+            ) : (
+              <p className="muted">The access policy keeps this function from the worker, so it can't be run.</p>
+            ))}
+        </div>
+      ))}
+    </section>
+  )
+}
+
+export default Functions
@@ -0,0 +1,67 @@
+import { useState } from 'react'
+import { ApiError, postGreeting } from '../api.gen'
+
+// Greeting calls POST /api/greeting, which needs no admin scope
+function Greeting({ onUnauthorized }: { onUnauthorized: () => void }) {
+  const [name, setName] = useState('')
+  const [response, setResponse] = useState('')
+  const [loading, setLoading] = useState(false)
+  const [isError, setIsError] = useState(false)
+
+  const callGreeting = async () => {
+    setLoading(true)
+    setIsError(false)
+    try {
+      const data = await postGreeting({ name })
+      setResponse(data.message)
+    } catch (err) {
+      setIsError(true)
+      if (err instanceof ApiError) {
+        // Errors are problem+json: { title, status, detail, errors }
+        if (err.status === 401) {
+          onUnauthorized()
+        }
+        setResponse(err.message)
+      } else {
+        setResponse(`Error: ${err}`)
+      }
+    }
+    setLoading(false)
+  }
+
+  const handleKeyDown = (e: React.KeyboardEvent) => {
+    if (e.key === 'Enter' && name && !loading) {
+      callGreeting()
+    }
+  }
+
+  return (
+    <section className="card">
+      <h2>Greeting Function</h2>
+      <div className="input-group">
+        <input
+          type="text"
+          placeholder="Enter your name..."
+          value={name}
+          onChange={(e) => setName(e.target.value)}
+          onKeyDown={handleKeyDown}
+        />
+        <button onClick={callGreeting} disabled={loading || !name}>
+          {loading ? (
+            <span className="loading-dots">Calling</span>
+          ) : (
+            'Call Function'
+          )}
+        </button>
+      </div>
+      {response && (
+        <div className={`response ${isError ? 'error' : ''}`}>
+          <strong>Response</strong>
+          <pre>{response}</pre>
+        </div>
+      )}
+    </section>
+  )
+}
+
+export default Greeting
@@ -0,0 +1,46 @@
+import { useState } from 'react'
+import { getAdminInvocations } from '../api.gen'
+import ErrorNotice from '../ErrorNotice'
+import { usePolling } from '../usePolling'
+
+// Invocations shows the recent worker function calls, newest first
+function Invocations() {
+  const { data, error } = usePolling(getAdminInvocations, 5000)
+  const [errorsOnly, setErrorsOnly] = useState(false)
+  const invocations = (data?.invocations ?? []).filter((inv) => !errorsOnly || inv.outcome !== 'success')
+
+  return (
+    <section className="card">
+      <h2>Recent Invocations</h2>
+      <label className="toggle">
+        <input type="checkbox" checked={errorsOnly} onChange={(e) => setErrorsOnly(e.target.checked)} />
+        Errors only
+      </label>
+      <ErrorNotice error={error} />
+      {data && invocations.length === 0 && <p className="muted">No invocations yet.</p>}
+      {invocations.length > 0 && (
+        <table>
+          <thead>
+            <tr><th>Time</th><th>Function</th><th>Outcome</th><th>Duration</th><th>Trace</th></tr>
+          </thead>
+          <tbody>
+            {invocations.map((inv, i) => (
+              <tr key={`${inv.started_at}-${i}`}>
+                <td>{new Date(inv.started_at).toLocaleTimeString()}</td>
+                <td>
+                  {inv.function} <span className="muted">v{inv.version}</span>
+                  {inv.error && <div className="error-text">{inv.error}</div>}
+                </td>
+                <td><span className={`badge ${inv.outcome}`}>{inv.outcome}</span></td>
+                <td>{inv.duration_ms.toFixed(1)} ms</td>
+                <td><code>{inv.trace_id?.slice(0, 8)}</code></td>
+              </tr>
+            ))}
+          </tbody>
+        </table>
+      )}
+    </section>
+  )
+}
+
+export default Invocations
@@ -0,0 +1,113 @@
+import { ApiError, getAdminJobs, getAdminSchedules, postAdminJobsByIdCancel } from '../api.gen'
+import ErrorNotice from '../ErrorNotice'
+import { usePolling } from '../usePolling'
+
+const formatTime = (iso?: string | null) => (iso ? new Date(iso).toLocaleTimeString() : '—')
+
+const formatInterval = (seconds: number) => {
+  if (seconds % 3600 === 0) return `${seconds / 3600}h`
+  if (seconds % 60 === 0) return `${seconds / 60}m`
+  return `${seconds}s`
+}
+
+// Jobs lists queued, running and recently finished jobs, refreshing every
+// 2 seconds, and the schedules that start jobs
+function Jobs() {
+  const { data, error, reload } = usePolling(getAdminJobs, 2000)
+  const schedules = usePolling(getAdminSchedules, 5000)
+
+  const cancel = async (id: string) => {
+    try {
+      await postAdminJobsByIdCancel(id)
+    } catch (err) {
+      // 409: it finished in the meantime
+      if (!(err instanceof ApiError && err.status === 409)) alert(`${err}`)
+    }
+    reload()
+  }
+
+  return (
+    <>
+    <section className="card">
+      <h2>Jobs</h2>
+      <ErrorNotice error={error} />
+      {data && data.jobs.length === 0 && <p className="muted">No jobs have run since startup.</p>}
+      {data && data.jobs.length > 0 && (
+        <table>
+          <thead>
+            <tr><th>Job</th><th>Status</th><th>Progress</th><th>Started</th><th></th></tr>
+          </thead>
+          <tbody>
+            {data.jobs.map((job) => (
+              <tr key={job.id}>
+                <td>
+                  {job.type}
+                  {job.name && <span className="muted"> · {job.name}</span>}
+                  {job.error && <div className="error-text">{job.error}</div>}
+                </td>
+                <td><span className={`badge ${job.status}`}>{job.status}</span></td>
+                <td>
+                  {job.total > 0 ? (
+                    <div className="progress" title={`${job.done} / ${job.total}`}>
+                      <div style={{ width: `${(100 * job.done) / job.total}%` }} />
+                    </div>
+                  ) : (
+                    job.done
+                  )}
+                </td>
+                <td>
+                  {job.started_at ? (
+                    formatTime(job.started_at)
+                  ) : (
+                    <span className="muted">queued {formatTime(job.queued_at)}</span>
+                  )}
+                </td>
+                <td>
+                  {(job.status === 'running' || job.status === 'queued') && (
+                    <button className="small" onClick={() => cancel(job.id)}>Cancel</button>
+                  )}
+                </td>
+              </tr>
+            ))}
+          </tbody>
+        </table>
+      )}
+    </section>
+
+    <section className="card">
+      <h2>Schedules</h2>
+      <ErrorNotice error={schedules.error} />
+      {schedules.data && schedules.data.schedules.length === 0 && (
+        <p className="muted">No schedules. Add them in cmd/worker/schedules.go.</p>
+      )}
+      {schedules.data && schedules.data.schedules.length > 0 && (
+        <table>
+          <thead>
+            <tr><th>Schedule</th><th>Every</th><th>Runs</th><th>Last run</th><th>Next run</th></tr>
+          </thead>
+          <tbody>
+            {schedules.data.schedules.map((sch) => (
+              <tr key={sch.name}>
+                <td>
+                  {sch.name}
+                  {sch.running && <span className="badge running">running</span>}
+                  {sch.last_error && <div className="error-text">{sch.last_error}</div>}
+                </td>
+                <td>{formatInterval(sch.interval_seconds)}</td>
+                <td>
+                  {sch.runs}
+                  {sch.skipped > 0 && <span className="muted"> · {sch.skipped} skipped</span>}
+                </td>
+                <td>{formatTime(sch.last_run)}</td>
+                <td>{formatTime(sch.next_run)}</td>
+              </tr>
+            ))}
+          </tbody>
+        </table>
+      )}
+    </section>
+    </>
+  )
+}
+
+export default Jobs
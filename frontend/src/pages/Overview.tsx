@@ -0,0 +1,115 @@
+import { getAdminSummary, getHealthBreakers } from '../api.gen'
+import ErrorNotice from '../ErrorNotice'
+import { usePolling } from '../usePolling'
+
+const formatBytes = (n: number) => `${(n / 1024 / 1024).toFixed(1)} MB`
+
+const formatUptime = (seconds: number) => {
+  const h = Math.floor(seconds / 3600)
+  const m = Math.floor((seconds % 3600) / 60)
+  return h > 0 ? `${h}h ${m}m` : `${m}m ${Math.floor(seconds % 60)}s`
+}
+
+// Overview shows health checks, breakers and invocation totals
+function Overview() {
+  const summary = usePolling(getAdminSummary, 5000)
+  const breakers = usePolling(getHealthBreakers, 5000)
+  const s = summary.data
+
+  return (
+    <>
+      <section className="card">
+        <h2>Overview</h2>
+        <ErrorNotice error={summary.error} />
+        {s && (
+          <div className="stats">
+            <div className="stat">
+              <span className={`badge ${s.health.status}`}>{s.health.status}</span>
+              <label>Health</label>
+            </div>
+            <div className="stat">
+              <strong>{s.mode}</strong>
+              <label>Mode</label>
+            </div>
+            <div className="stat">
+              <strong>{s.invocations}</strong>
+              <label>Invocations</label>
+            </div>
+            <div className="stat">
+              <strong className={s.errors > 0 ? 'error-text' : ''}>{s.errors}</strong>
+              <label>Errors</label>
+            </div>
+            <div className="stat">
+              <strong>{s.in_flight}</strong>
+              <label>In flight</label>
+            </div>
+            <div className="stat">
+              <strong>{s.running_jobs}</strong>
+              <label>Running jobs</label>
+            </div>
+            <div className="stat">
+              <strong>{s.queued_jobs}</strong>
+              <label>Queued jobs</label>
+            </div>
+            <div className="stat">
+              <strong>{s.goroutines}</strong>
+              <label>Goroutines</label>
+            </div>
+            <div className="stat">
+              <strong>{formatBytes(s.heap_bytes)}</strong>
+              <label>Heap</label>
+            </div>
+            <div className="stat">
+              <strong>{formatUptime(s.uptime_seconds)}</strong>
+              <label>Uptime</label>
+            </div>
+          </div>
+        )}
+      </section>
+
+      {s && s.health.checks.length > 0 && (
+        <section className="card">
+          <h2>Health Checks</h2>
+          <table>
+            <thead>
+              <tr><th>Check</th><th>Status</th><th>Time</th><th>Error</th></tr>
+            </thead>
+            <tbody>
+              {s.health.checks.map((check) => (
+                <tr key={check.name}>
+                  <td>{check.name}{check.critical && ' *'}</td>
+                  <td><span className={`badge ${check.status}`}>{check.status}</span></td>
+                  <td>{check.duration_ms.toFixed(1)} ms</td>
+                  <td className="error-text">{check.error}</td>
+                </tr>
+              ))}
+            </tbody>
+          </table>
+        </section>
+      )}
+
+      {breakers.data && breakers.data.breakers.length > 0 && (
+        <section className="card">
+          <h2>Circuit Breakers</h2>
+          <table>
+            <thead>
+              <tr><th>Breaker</th><th>State</th><th>Failures</th><th>Last error</th></tr>
+            </thead>
+            <tbody>
+              {breakers.data.breakers.map((b) => (
+                <tr key={b.name}>
+                  <td>{b.name}</td>
+                  <td><span className={`badge ${b.state}`}>{b.state}</span></td>
+                  <td>{b.failures_total}</td>
+                  <td className="error-text">{b.last_error}</td>
+                </tr>
+              ))}
+            </tbody>
+          </table>
+        </section>
+      )}
+    </>
+  )
+}
+
+export default Overview
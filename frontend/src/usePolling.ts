@@ -0,0 +1,25 @@
+import { useCallback, useEffect, useState } from 'react'
+
+// usePolling calls fetcher now and every intervalMs (0 = once) until unmounted
+export function usePolling<T>(fetcher: () => Promise<T>, intervalMs = 0) {
+  const [data, setData] = useState<T | null>(null)
+  const [error, setError] = useState<unknown>(null)
+
+  const reload = useCallback(async () => {
+    try {
+      setData(await fetcher())
+      setError(null)
+    } catch (err) {
+      setError(err)
+    }
+  }, [fetcher])
+
+  useEffect(() => {
+    reload()
+    if (intervalMs <= 0) return
+    const timer = setInterval(reload, intervalMs)
+    return () => clearInterval(timer)
+  }, [reload, intervalMs])
+
+  return { data, error, reload }
+}
@@ -19,6 +19,11 @@ export default defineConfig({
         target: `http://localhost:${BACKEND_PORT}`,
         changeOrigin: true,
       },
+      // Runtime config generated by the worker
+      '/config.js': {
+        target: `http://localhost:${BACKEND_PORT}`,
+        changeOrigin: true,
+      },
     },
   },
 })
@@ -0,0 +1,226 @@
+// Package auth authenticates HTTP API requests for frontend.Router.
+//
+// Three credential types are supported, tried in order:
+//
+//   - Static bearer tokens / API keys from AUTH_TOKENS (Authorization: Bearer or X-API-Key)
+//   - HMAC-signed session cookies for the embedded SPA, issued by the login endpoint
+//   - OIDC JWTs verified against the issuer's JWKS
+//
+// Protect routes with Require, optionally listing the scopes a route needs:
+//
+//	authn, err := auth.New(auth.ConfigFromEnv())
+//	api := router.Group("/api", authn.Middleware())
+//	protected := api.Group("", authn.Require())
+//	admin := api.Group("/admin", authn.Require("admin"))
+package auth
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+)
+
+var (
+	// ErrNoCredentials means the request carried no credentials for an authenticator.
+	ErrNoCredentials = errors.New("no credentials")
+	// ErrInvalidCredentials means credentials were present but rejected.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Method names the credential type an identity was authenticated with.
+const (
+	MethodToken   = "token"
+	MethodSession = "session"
+	MethodOIDC    = "oidc"
+)
+
+// Identity is an authenticated caller.
+type Identity struct {
+	Subject   string                 `json:"subject"`              // Token name, session subject or JWT sub
+	Method    string                 `json:"method"`               // token, session or oidc
+	Scopes    []string               `json:"scopes,omitempty"`     // Granted scopes ("*" grants all)
+	Roles     []string               `json:"roles,omitempty"`      // Roles from the credential (OIDC roles claim)
+	ExpiresAt *time.Time             `json:"expires_at,omitempty"` // Nil for static tokens
+	Claims    map[string]interface{} `json:"-"`                    // Raw JWT claims (OIDC only)
+}
+
+// HasScope reports whether the identity was granted scope.
+func (id *Identity) HasScope(scope string) bool {
+	for _, s := range id.Scopes {
+		if s == scope || s == "*" {
+			return true
+		}
+		// "jobs:*" grants "jobs:read"
+		if prefix, ok := strings.CutSuffix(s, "*"); ok && strings.HasPrefix(scope, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a context carrying id.
+func WithIdentity(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+// FromContext returns the identity set by Middleware or Require, or nil.
+func FromContext(ctx context.Context) *Identity {
+	id, _ := ctx.Value(identityKey{}).(*Identity)
+	return id
+}
+
+// Authenticator checks one kind of credential. It returns ErrNoCredentials when
+// the request does not carry that kind, so the next authenticator is tried.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Identity, error)
+}
+
+// Auth combines the configured authenticators.
+type Auth struct {
+	authenticators []Authenticator
+	tokens         *TokenAuthenticator
+	sessions       *SessionManager
+	oidc           *OIDCAuthenticator
+}
+
+// New creates an Auth from configuration. With no credentials configured auth is
+// disabled and Require lets every request through (see Enabled).
+func New(cfg Config) (*Auth, error) {
+	a := &Auth{}
+
+	if len(cfg.Tokens) > 0 {
+		a.tokens = NewTokenAuthenticator(cfg.Tokens)
+		a.authenticators = append(a.authenticators, a.tokens)
+	}
+
+	if cfg.Session.Secret != "" {
+		// Sessions are only issued in exchange for a static token (POST /api/auth/login)
+		if len(cfg.Tokens) == 0 {
+			return nil, errors.New("AUTH_SESSION_SECRET requires AUTH_TOKENS: sessions are issued in exchange for a static token")
+		}
+		sessions, err := NewSessionManager(cfg.Session)
+		if err != nil {
+			return nil, err
+		}
+		a.sessions = sessions
+		a.authenticators = append(a.authenticators, sessions)
+	}
+
+	if cfg.OIDC.Issuer != "" || cfg.OIDC.JWKSURL != "" {
+		oidc, err := NewOIDCAuthenticator(cfg.OIDC)
+		if err != nil {
+			return nil, err
+		}
+		a.oidc = oidc
+		a.authenticators = append(a.authenticators, oidc)
+	}
+
+	return a, nil
+}
+
+// Enabled reports whether any credential type is configured.
+func (a *Auth) Enabled() bool {
+	return len(a.authenticators) > 0
+}
+
+// Methods lists the enabled credential types, for startup logging.
+func (a *Auth) Methods() []string {
+	var methods []string
+	if a.tokens != nil {
+		methods = append(methods, MethodToken)
+	}
+	if a.sessions != nil {
+		methods = append(methods, MethodSession)
+	}
+	if a.oidc != nil {
+		methods = append(methods, MethodOIDC)
+	}
+	return methods
+}
+
+// Tokens returns the static token authenticator (nil if none configured).
+func (a *Auth) Tokens() *TokenAuthenticator {
+	return a.tokens
+}
+
+// Sessions returns the session manager (nil if AUTH_SESSION_SECRET is not set).
+func (a *Auth) Sessions() *SessionManager {
+	return a.sessions
+}
+
+// Authenticate tries each authenticator in order. It returns ErrNoCredentials
+// if the request carries no credentials at all.
+func (a *Auth) Authenticate(r *http.Request) (*Identity, error) {
+	for _, authenticator := range a.authenticators {
+		id, err := authenticator.Authenticate(r)
+		if errors.Is(err, ErrNoCredentials) {
+			continue
+		}
+		return id, err
+	}
+	return nil, ErrNoCredentials
+}
+
+// Middleware attaches the caller's identity to the request context when valid
+// credentials are present, without rejecting anonymous requests.
+func (a *Auth) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if FromContext(r.Context()) == nil {
+				if id, err := a.Authenticate(r); err == nil {
+					r = r.WithContext(WithIdentity(r.Context(), id))
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Require rejects requests without valid credentials (401) or without every
+// listed scope (403). When auth is disabled it lets all requests through.
+func (a *Auth) Require(scopes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !a.Enabled() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			id := FromContext(r.Context())
+			if id == nil {
+				var err error
+				id, err = a.Authenticate(r)
+				if err != nil {
+					if !errors.Is(err, ErrNoCredentials) {
+						log.Printf("🔒 Rejected credentials for %s %s: %v", r.Method, r.URL.Path, err)
+					}
+					unauthorized(w, r, "authentication required")
+					return
+				}
+				r = r.WithContext(WithIdentity(r.Context(), id))
+			}
+
+			for _, scope := range scopes {
+				if !id.HasScope(scope) {
+					httpapi.WriteError(w, r, httpapi.Forbidden("missing required scope: %s", scope))
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// unauthorized writes a 401 problem with a WWW-Authenticate challenge.
+func unauthorized(w http.ResponseWriter, r *http.Request, message string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+	httpapi.WriteError(w, r, httpapi.Unauthorized("%s", message))
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/config"
+)
+
+// Config selects which credential types are accepted.
+type Config struct {
+	Tokens  []StaticToken
+	Session SessionConfig
+	OIDC    OIDCConfig
+}
+
+// StaticToken is a bearer token / API key from configuration.
+type StaticToken struct {
+	Name   string   // Identity subject, e.g. "ci" or "dashboard"
+	Token  string   // Secret value
+	Scopes []string // Granted scopes ("*" for all)
+}
+
+// ConfigFromEnv reads auth settings from environment variables:
+//
+//	AUTH_TOKENS             name=token[:scope scope...] entries, comma separated
+//	AUTH_SESSION_SECRET     HMAC key for session cookies (32+ bytes, enables the login endpoint)
+//	AUTH_SESSION_TTL        session lifetime (default 12h)
+//	AUTH_SESSION_COOKIE     cookie name (default worker_session)
+//	AUTH_COOKIE_INSECURE    true to drop the Secure flag (plain-HTTP deployments)
+//	AUTH_OIDC_ISSUER        issuer URL; the JWKS is discovered from it
+//	AUTH_OIDC_JWKS_URL      JWKS URL (overrides discovery)
+//	AUTH_OIDC_AUDIENCE      required audience
+//	AUTH_OIDC_ROLES_CLAIM   claim holding roles (default roles)
+func ConfigFromEnv() (Config, error) {
+	tokens, err := ParseTokens(os.Getenv("AUTH_TOKENS"))
+	if err != nil {
+		return Config{}, fmt.Errorf("invalid AUTH_TOKENS: %w", err)
+	}
+
+	cfg := Config{
+		Tokens: tokens,
+		Session: SessionConfig{
+			Secret:     os.Getenv("AUTH_SESSION_SECRET"),
+			CookieName: config.GetEnvOrDefault("AUTH_SESSION_COOKIE", "worker_session"),
+			Insecure:   os.Getenv("AUTH_COOKIE_INSECURE") == "true",
+		},
+		OIDC: OIDCConfig{
+			Issuer:     os.Getenv("AUTH_OIDC_ISSUER"),
+			JWKSURL:    os.Getenv("AUTH_OIDC_JWKS_URL"),
+			Audience:   os.Getenv("AUTH_OIDC_AUDIENCE"),
+			RolesClaim: config.GetEnvOrDefault("AUTH_OIDC_ROLES_CLAIM", "roles"),
+		},
+	}
+
+	if v := os.Getenv("AUTH_SESSION_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid AUTH_SESSION_TTL %q: %w", v, err)
+		}
+		cfg.Session.TTL = ttl
+	}
+
+	return cfg, nil
+}
+
+// ParseTokens parses "name=token[:scope scope...]" entries separated by commas.
+// Tokens without scopes get no scopes, so they only pass routes that require none.
+func ParseTokens(spec string) ([]StaticToken, error) {
+	var tokens []StaticToken
+	seen := make(map[string]bool)
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, rest, ok := strings.Cut(entry, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("entry %q: expected name=token[:scopes]", entry)
+		}
+		token, scopes, _ := strings.Cut(rest, ":")
+		token = strings.TrimSpace(token)
+		if len(token) < 16 {
+			return nil, fmt.Errorf("token %q is shorter than 16 characters", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate token name %q", name)
+		}
+		seen[name] = true
+
+		tokens = append(tokens, StaticToken{Name: name, Token: token, Scopes: strings.Fields(scopes)})
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,174 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// jwtHeader is the JOSE header of a JWT.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ"`
+}
+
+// parsedJWT is a decoded but not yet verified token.
+type parsedJWT struct {
+	header    jwtHeader
+	claims    map[string]interface{}
+	signed    []byte // header.payload
+	signature []byte
+}
+
+// parseJWT decodes a compact JWS without verifying it.
+func parseJWT(raw string) (*parsedJWT, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("token is not a JWT")
+	}
+
+	var t parsedJWT
+	if err := decodeSegment(parts[0], &t.header); err != nil {
+		return nil, fmt.Errorf("malformed JWT header: %w", err)
+	}
+	if err := decodeSegment(parts[1], &t.claims); err != nil {
+		return nil, fmt.Errorf("malformed JWT claims: %w", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("malformed JWT signature: %w", err)
+	}
+
+	t.signed = []byte(parts[0] + "." + parts[1])
+	t.signature = signature
+	return &t, nil
+}
+
+// decodeSegment base64url-decodes and unmarshals a JWT segment.
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// verifySignature checks the token signature with key for the header's algorithm.
+func (t *parsedJWT) verifySignature(key crypto.PublicKey) error {
+	hash, err := algHash(t.header.Alg)
+	if err != nil {
+		return err
+	}
+	h := hash.New()
+	h.Write(t.signed)
+	digest := h.Sum(nil)
+
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		switch t.header.Alg[:2] {
+		case "RS":
+			return rsa.VerifyPKCS1v15(k, hash, digest, t.signature)
+		case "PS":
+			return rsa.VerifyPSS(k, hash, digest, t.signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		}
+	case *ecdsa.PublicKey:
+		if t.header.Alg[:2] != "ES" {
+			break
+		}
+		size := (k.Curve.Params().BitSize + 7) / 8
+		if len(t.signature) != 2*size {
+			return fmt.Errorf("invalid ECDSA signature length")
+		}
+		r := new(big.Int).SetBytes(t.signature[:size])
+		s := new(big.Int).SetBytes(t.signature[size:])
+		if !ecdsa.Verify(k, digest, r, s) {
+			return fmt.Errorf("invalid ECDSA signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("key type %T does not match algorithm %s", key, t.header.Alg)
+}
+
+// algHash maps supported JWS algorithms to their hash. "none" and HMAC
+// algorithms are deliberately unsupported.
+func algHash(alg string) (crypto.Hash, error) {
+	switch alg {
+	case "RS256", "PS256", "ES256":
+		return crypto.SHA256, nil
+	case "RS384", "PS384", "ES384":
+		return crypto.SHA384, nil
+	case "RS512", "PS512", "ES512":
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("unsupported JWT algorithm %q", alg)
+	}
+}
+
+// validateClaims checks iss, aud, exp and nbf with the given clock leeway.
+func validateClaims(claims map[string]interface{}, issuer, audience string, now time.Time, leeway time.Duration) error {
+	if issuer != "" {
+		if iss, _ := claims["iss"].(string); strings.TrimRight(iss, "/") != strings.TrimRight(issuer, "/") {
+			return fmt.Errorf("unexpected issuer %q", iss)
+		}
+	}
+
+	if audience != "" && !containsString(stringList(claims["aud"]), audience) {
+		return fmt.Errorf("token audience does not include %q", audience)
+	}
+
+	exp, ok := numericDate(claims["exp"])
+	if !ok {
+		return fmt.Errorf("token has no expiry")
+	}
+	if now.After(exp.Add(leeway)) {
+		return fmt.Errorf("token expired at %s", exp.Format(time.RFC3339))
+	}
+	if nbf, ok := numericDate(claims["nbf"]); ok && now.Add(leeway).Before(nbf) {
+		return fmt.Errorf("token not valid before %s", nbf.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// numericDate reads a JWT NumericDate claim.
+func numericDate(v interface{}) (time.Time, bool) {
+	f, ok := v.(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(f), 0), true
+}
+
+// stringList reads a claim that may be a string, a space-separated string or a list.
+func stringList(v interface{}) []string {
+	switch val := v.(type) {
+	case string:
+		return strings.Fields(val)
+	case []interface{}:
+		out := make([]string, 0, len(val))
+		for _, item := range val {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// containsString reports whether list contains s.
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
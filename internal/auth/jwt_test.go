@@ -0,0 +1,454 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var (
+	rsaKeyOnce sync.Once
+	rsaKey     *rsa.PrivateKey
+)
+
+// testRSAKey returns a shared RSA key; generating one per test is slow.
+func testRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	rsaKeyOnce.Do(func() {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			panic(err)
+		}
+		rsaKey = key
+	})
+	return rsaKey
+}
+
+func testECKey(t *testing.T, curve elliptic.Curve) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// signJWT builds a compact JWS. key is an *rsa.PrivateKey, *ecdsa.PrivateKey,
+// an HMAC secret ([]byte) or nil for alg "none".
+func signJWT(t *testing.T, alg, kid string, key interface{}, claims map[string]interface{}) string {
+	t.Helper()
+	header, _ := json.Marshal(jwtHeader{Alg: alg, Kid: kid, Typ: "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	var signature []byte
+	switch k := key.(type) {
+	case nil:
+	case []byte:
+		mac := hmac.New(sha256.New, k)
+		mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	default:
+		hash, err := algHash(alg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		h := hash.New()
+		h.Write([]byte(signed))
+		digest := h.Sum(nil)
+
+		switch k := key.(type) {
+		case *rsa.PrivateKey:
+			if strings.HasPrefix(alg, "PS") {
+				signature, err = rsa.SignPSS(rand.Reader, k, hash, digest, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+			} else {
+				signature, err = rsa.SignPKCS1v15(rand.Reader, k, hash, digest)
+			}
+		case *ecdsa.PrivateKey:
+			var r, s *big.Int
+			r, s, err = ecdsa.Sign(rand.Reader, k, digest)
+			size := (k.Curve.Params().BitSize + 7) / 8
+			signature = make([]byte, 2*size)
+			r.FillBytes(signature[:size])
+			s.FillBytes(signature[size:])
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// toJWK encodes a public key as a JWK.
+func toJWK(kid string, key crypto.PublicKey) jwk {
+	enc := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	switch k := key.(type) {
+	case *rsa.PublicKey:
+		return jwk{Kty: "RSA", Kid: kid, Use: "sig", N: enc(k.N.Bytes()), E: enc(big.NewInt(int64(k.E)).Bytes())}
+	case *ecdsa.PublicKey:
+		size := (k.Curve.Params().BitSize + 7) / 8
+		return jwk{Kty: "EC", Kid: kid, Crv: k.Curve.Params().Name, X: enc(k.X.FillBytes(make([]byte, size))), Y: enc(k.Y.FillBytes(make([]byte, size)))}
+	}
+	panic("unsupported key type")
+}
+
+// jwksServer serves a JWKS and OIDC discovery document and counts JWKS fetches.
+type jwksServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	keys    []jwk
+	fetches atomic.Int32
+	block   chan struct{} // When set, JWKS requests wait for it to close
+}
+
+func newJWKSServer(t *testing.T, keys ...jwk) *jwksServer {
+	s := &jwksServer{keys: keys}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"jwks_uri": s.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		s.fetches.Add(1)
+		s.mu.Lock()
+		keys, block := s.keys, s.block
+		s.mu.Unlock()
+		if block != nil {
+			<-block
+		}
+		if keys == nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+	})
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) setKeys(keys ...jwk) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *jwksServer) newAuthenticator(t *testing.T) *OIDCAuthenticator {
+	t.Helper()
+	o, err := NewOIDCAuthenticator(OIDCConfig{Issuer: s.URL, Audience: "worker", HTTPClient: s.Client()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return o
+}
+
+// validClaims returns claims that pass validation for srv.
+func validClaims(srv *jwksServer) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":   srv.URL,
+		"aud":   "worker",
+		"sub":   "user-1",
+		"exp":   float64(time.Now().Add(time.Hour).Unix()),
+		"scope": "greeting admin",
+		"roles": []string{"operator"},
+	}
+}
+
+func TestVerifyAllowedAlgorithms(t *testing.T) {
+	rsaPriv := testRSAKey(t)
+	p256 := testECKey(t, elliptic.P256())
+	p384 := testECKey(t, elliptic.P384())
+	p521 := testECKey(t, elliptic.P521())
+
+	srv := newJWKSServer(t,
+		toJWK("rsa", &rsaPriv.PublicKey),
+		toJWK("p256", &p256.PublicKey),
+		toJWK("p384", &p384.PublicKey),
+		toJWK("p521", &p521.PublicKey),
+	)
+	o := srv.newAuthenticator(t)
+
+	tests := []struct {
+		alg string
+		kid string
+		key interface{}
+	}{
+		{"RS256", "rsa", rsaPriv},
+		{"RS384", "rsa", rsaPriv},
+		{"RS512", "rsa", rsaPriv},
+		{"PS256", "rsa", rsaPriv},
+		{"PS512", "rsa", rsaPriv},
+		{"ES256", "p256", p256},
+		{"ES384", "p384", p384},
+		{"ES512", "p521", p521},
+	}
+	for _, tt := range tests {
+		t.Run(tt.alg, func(t *testing.T) {
+			id, err := o.Verify(context.Background(), signJWT(t, tt.alg, tt.kid, tt.key, validClaims(srv)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id.Subject != "user-1" || id.Method != MethodOIDC {
+				t.Errorf("identity = %+v", id)
+			}
+			if !id.HasScope("admin") || len(id.Roles) != 1 || id.Roles[0] != "operator" {
+				t.Errorf("scopes %v, roles %v", id.Scopes, id.Roles)
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsUnsafeTokens(t *testing.T) {
+	rsaPriv := testRSAKey(t)
+	p256 := testECKey(t, elliptic.P256())
+	srv := newJWKSServer(t, toJWK("rsa", &rsaPriv.PublicKey), toJWK("p256", &p256.PublicKey))
+	o := srv.newAuthenticator(t)
+
+	// HS256 keyed with the public key is the classic algorithm confusion attack
+	pubDER, err := x509.MarshalPKIXPublicKey(&rsaPriv.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := signJWT(t, "RS256", "rsa", rsaPriv, validClaims(srv))
+	parts := strings.Split(valid, ".")
+	tamperedClaims := validClaims(srv)
+	tamperedClaims["sub"] = "admin"
+	tampered := strings.Split(signJWT(t, "RS256", "rsa", rsaPriv, tamperedClaims), ".")
+
+	tests := []struct {
+		name  string
+		token string
+		want  string
+	}{
+		{"alg none", signJWT(t, "none", "rsa", nil, validClaims(srv)), "unsupported JWT algorithm"},
+		{"alg none without kid", signJWT(t, "none", "", nil, validClaims(srv)), "unsupported JWT algorithm"},
+		{"HS256 with the public key", signJWT(t, "HS256", "rsa", pubDER, validClaims(srv)), "unsupported JWT algorithm"},
+		{"lowercase alg", signJWT(t, "rs256", "rsa", nil, validClaims(srv)), "unsupported JWT algorithm"},
+		{"ES256 header on an RSA key", signJWT(t, "ES256", "rsa", p256, validClaims(srv)), "does not match algorithm"},
+		{"RS256 header on an EC key", signJWT(t, "RS256", "p256", rsaPriv, validClaims(srv)), "does not match algorithm"},
+		{"tampered claims", parts[0] + "." + tampered[1] + "." + parts[2], "signature verification failed"},
+		{"unknown kid", signJWT(t, "RS256", "other", rsaPriv, validClaims(srv)), "unknown signing key"},
+		{"not a JWT", "abc.def", "not a JWT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := o.Verify(context.Background(), tt.token)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("err = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyRequiresSubject(t *testing.T) {
+	rsaPriv := testRSAKey(t)
+	srv := newJWKSServer(t, toJWK("rsa", &rsaPriv.PublicKey))
+	claims := validClaims(srv)
+	delete(claims, "sub")
+
+	if _, err := srv.newAuthenticator(t).Verify(context.Background(), signJWT(t, "RS256", "rsa", rsaPriv, claims)); err == nil {
+		t.Fatal("expected an error for a token without sub")
+	}
+}
+
+func TestValidateClaims(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	date := func(d time.Duration) float64 { return float64(now.Add(d).Unix()) }
+	const leeway = time.Minute
+
+	tests := []struct {
+		name     string
+		claims   map[string]interface{}
+		issuer   string
+		audience string
+		wantErr  string
+	}{
+		{"valid", map[string]interface{}{"exp": date(time.Hour)}, "", "", ""},
+		{"missing exp", map[string]interface{}{}, "", "", "no expiry"},
+		{"exp as string", map[string]interface{}{"exp": "tomorrow"}, "", "", "no expiry"},
+		{"expired", map[string]interface{}{"exp": date(-2 * time.Minute)}, "", "", "token expired"},
+		{"expired within leeway", map[string]interface{}{"exp": date(-30 * time.Second)}, "", "", ""},
+		{"not yet valid", map[string]interface{}{"exp": date(time.Hour), "nbf": date(2 * time.Minute)}, "", "", "not valid before"},
+		{"nbf within leeway", map[string]interface{}{"exp": date(time.Hour), "nbf": date(30 * time.Second)}, "", "", ""},
+		{"issuer matches", map[string]interface{}{"exp": date(time.Hour), "iss": "https://id.example.com"}, "https://id.example.com", "", ""},
+		{"issuer trailing slash", map[string]interface{}{"exp": date(time.Hour), "iss": "https://id.example.com/"}, "https://id.example.com", "", ""},
+		{"wrong issuer", map[string]interface{}{"exp": date(time.Hour), "iss": "https://evil.example.com"}, "https://id.example.com", "", "unexpected issuer"},
+		{"missing issuer", map[string]interface{}{"exp": date(time.Hour)}, "https://id.example.com", "", "unexpected issuer"},
+		{"audience string", map[string]interface{}{"exp": date(time.Hour), "aud": "worker"}, "", "worker", ""},
+		{"audience list", map[string]interface{}{"exp": date(time.Hour), "aud": []interface{}{"other", "worker"}}, "", "worker", ""},
+		{"wrong audience", map[string]interface{}{"exp": date(time.Hour), "aud": []interface{}{"other"}}, "", "worker", "audience"},
+		{"missing audience", map[string]interface{}{"exp": date(time.Hour)}, "", "worker", "audience"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateClaims(tt.claims, tt.issuer, tt.audience, now, leeway)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyLookup(t *testing.T) {
+	rsaPriv := testRSAKey(t)
+	p256 := testECKey(t, elliptic.P256())
+	ctx := context.Background()
+
+	t.Run("empty kid matches a single key", func(t *testing.T) {
+		srv := newJWKSServer(t, toJWK("only", &rsaPriv.PublicKey))
+		if _, err := srv.newAuthenticator(t).Verify(ctx, signJWT(t, "RS256", "", rsaPriv, validClaims(srv))); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("empty kid is ambiguous with several keys", func(t *testing.T) {
+		srv := newJWKSServer(t, toJWK("a", &rsaPriv.PublicKey), toJWK("b", &p256.PublicKey))
+		if _, err := srv.newAuthenticator(t).Verify(ctx, signJWT(t, "RS256", "", rsaPriv, validClaims(srv))); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("rotated key is fetched once the rate limit passes", func(t *testing.T) {
+		srv := newJWKSServer(t, toJWK("old", &p256.PublicKey))
+		o := srv.newAuthenticator(t)
+		if _, err := o.Verify(ctx, signJWT(t, "ES256", "old", p256, validClaims(srv))); err != nil {
+			t.Fatal(err)
+		}
+
+		srv.setKeys(toJWK("new", &rsaPriv.PublicKey))
+		rotated := signJWT(t, "RS256", "new", rsaPriv, validClaims(srv))
+
+		// Unknown kids refetch at most every 30 seconds
+		if _, err := o.Verify(ctx, rotated); err == nil {
+			t.Fatal("expected the new key to be unknown within the rate limit")
+		}
+		if n := srv.fetches.Load(); n != 1 {
+			t.Fatalf("JWKS fetched %d times, want 1", n)
+		}
+
+		o.mu.Lock()
+		o.fetchedAt = time.Now().Add(-time.Minute)
+		o.mu.Unlock()
+		if _, err := o.Verify(ctx, rotated); err != nil {
+			t.Fatal(err)
+		}
+		if n := srv.fetches.Load(); n != 2 {
+			t.Fatalf("JWKS fetched %d times, want 2", n)
+		}
+	})
+
+	t.Run("cached keys are served while the provider is down", func(t *testing.T) {
+		srv := newJWKSServer(t, toJWK("rsa", &rsaPriv.PublicKey))
+		o := srv.newAuthenticator(t)
+		token := signJWT(t, "RS256", "rsa", rsaPriv, validClaims(srv))
+		if _, err := o.Verify(ctx, token); err != nil {
+			t.Fatal(err)
+		}
+
+		srv.setKeys() // 503
+		o.mu.Lock()
+		o.fetchedAt = time.Now().Add(-2 * time.Hour) // Past CacheTTL
+		o.mu.Unlock()
+		if _, err := o.Verify(ctx, token); err != nil {
+			t.Fatal(err)
+		}
+		if n := srv.fetches.Load(); n != 2 {
+			t.Fatalf("JWKS fetched %d times, want 2", n)
+		}
+	})
+
+	t.Run("provider errors surface without cached keys", func(t *testing.T) {
+		srv := newJWKSServer(t)
+		srv.setKeys()
+		_, err := srv.newAuthenticator(t).Verify(ctx, signJWT(t, "RS256", "rsa", rsaPriv, validClaims(srv)))
+		if err == nil || !strings.Contains(err.Error(), "failed to fetch JWKS") {
+			t.Fatalf("err = %v", err)
+		}
+	})
+}
+
+func TestRefreshDoesNotBlockVerification(t *testing.T) {
+	rsaPriv := testRSAKey(t)
+	p256 := testECKey(t, elliptic.P256())
+	srv := newJWKSServer(t, toJWK("rsa", &rsaPriv.PublicKey))
+	o := srv.newAuthenticator(t)
+	ctx := context.Background()
+
+	known := signJWT(t, "RS256", "rsa", rsaPriv, validClaims(srv))
+	if _, err := o.Verify(ctx, known); err != nil {
+		t.Fatal(err)
+	}
+
+	// Hold the next JWKS fetch open and trigger it from several requests
+	block := make(chan struct{})
+	srv.mu.Lock()
+	srv.block = block
+	srv.keys = []jwk{toJWK("rsa", &rsaPriv.PublicKey), toJWK("ec", &p256.PublicKey)}
+	srv.mu.Unlock()
+	o.mu.Lock()
+	o.fetchedAt = time.Now().Add(-time.Minute)
+	o.mu.Unlock()
+
+	rotated := signJWT(t, "ES256", "ec", p256, validClaims(srv))
+	var wg sync.WaitGroup
+	errs := make(chan error, 5)
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := o.Verify(ctx, rotated)
+			errs <- err
+		}()
+	}
+	for srv.fetches.Load() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// The refresh is in flight; cached keys must still verify
+	done := make(chan error, 1)
+	go func() {
+		_, err := o.Verify(ctx, known)
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("verification with a cached key blocked on the JWKS refresh")
+	}
+
+	close(block)
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := srv.fetches.Load(); n != 2 {
+		t.Errorf("JWKS fetched %d times, want 2 (concurrent refreshes are shared)", n)
+	}
+}
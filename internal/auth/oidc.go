@@ -0,0 +1,327 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// OIDCConfig configures JWT verification.
+type OIDCConfig struct {
+	Issuer     string        // Expected iss; also used to discover the JWKS URL
+	JWKSURL    string        // Overrides discovery
+	Audience   string        // Required aud (empty skips the check)
+	RolesClaim string        // Claim holding roles (default "roles")
+	CacheTTL   time.Duration // How long fetched keys are trusted (default 1h)
+	Leeway     time.Duration // Allowed clock skew (default 1m)
+	HTTPClient *http.Client  // Client for discovery and JWKS requests
+}
+
+// OIDCAuthenticator verifies bearer JWTs against the issuer's JWKS.
+type OIDCAuthenticator struct {
+	cfg    OIDCConfig
+	client *http.Client
+
+	// refreshes collapses concurrent JWKS fetches into one; mu is never held
+	// while fetching so cached keys keep verifying during a slow refresh.
+	refreshes singleflight.Group
+
+	mu        sync.Mutex
+	jwksURL   string
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewOIDCAuthenticator creates a verifier. Keys are fetched lazily on first use.
+func NewOIDCAuthenticator(cfg OIDCConfig) (*OIDCAuthenticator, error) {
+	if cfg.Issuer == "" && cfg.JWKSURL == "" {
+		return nil, fmt.Errorf("OIDC requires AUTH_OIDC_ISSUER or AUTH_OIDC_JWKS_URL")
+	}
+	if cfg.RolesClaim == "" {
+		cfg.RolesClaim = "roles"
+	}
+	if cfg.CacheTTL <= 0 {
+		cfg.CacheTTL = time.Hour
+	}
+	if cfg.Leeway <= 0 {
+		cfg.Leeway = time.Minute
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &OIDCAuthenticator{cfg: cfg, client: client, jwksURL: cfg.JWKSURL}, nil
+}
+
+// Authenticate implements Authenticator.
+func (o *OIDCAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	raw, ok := bearerToken(r)
+	if !ok || strings.Count(raw, ".") != 2 {
+		return nil, ErrNoCredentials
+	}
+
+	id, err := o.Verify(r.Context(), raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+	return id, nil
+}
+
+// Verify checks a raw JWT and returns its identity.
+func (o *OIDCAuthenticator) Verify(ctx context.Context, raw string) (*Identity, error) {
+	token, err := parseJWT(raw)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := algHash(token.header.Alg); err != nil {
+		return nil, err
+	}
+
+	key, err := o.key(ctx, token.header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err := token.verifySignature(key); err != nil {
+		return nil, fmt.Errorf("signature verification failed: %w", err)
+	}
+	if err := validateClaims(token.claims, o.cfg.Issuer, o.cfg.Audience, time.Now(), o.cfg.Leeway); err != nil {
+		return nil, err
+	}
+
+	sub, _ := token.claims["sub"].(string)
+	if sub == "" {
+		return nil, fmt.Errorf("token has no subject")
+	}
+
+	// "scope" is a space-separated string (RFC 8693); some providers use an "scp" list
+	scopes := stringList(token.claims["scope"])
+	if len(scopes) == 0 {
+		scopes = stringList(token.claims["scp"])
+	}
+	exp, _ := numericDate(token.claims["exp"])
+
+	return &Identity{
+		Subject:   sub,
+		Method:    MethodOIDC,
+		Scopes:    scopes,
+		Roles:     stringList(token.claims[o.cfg.RolesClaim]),
+		ExpiresAt: &exp,
+		Claims:    token.claims,
+	}, nil
+}
+
+// key returns the public key for kid, refreshing the JWKS when the cache is stale
+// or the key is unknown (providers rotate keys). Unknown-key refreshes are limited
+// to one every 30 seconds so bogus kids cannot hammer the provider.
+func (o *OIDCAuthenticator) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	o.mu.Lock()
+	key, ok := o.lookup(kid)
+	fetchedAt := o.fetchedAt
+	o.mu.Unlock()
+
+	sinceFetch := time.Since(fetchedAt)
+	stale := sinceFetch > o.cfg.CacheTTL
+	if ok && !stale {
+		return key, nil
+	}
+	if !stale && sinceFetch < 30*time.Second {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	// The fetch is shared with other callers, so one canceled request must not fail it
+	_, err, _ := o.refreshes.Do("jwks", func() (interface{}, error) {
+		o.mu.Lock()
+		refreshed := o.fetchedAt.After(fetchedAt)
+		o.mu.Unlock()
+		if refreshed {
+			return nil, nil // Another caller fetched since we looked
+		}
+		return nil, o.refresh(context.WithoutCancel(ctx))
+	})
+
+	o.mu.Lock()
+	key, ok = o.lookup(kid)
+	o.mu.Unlock()
+	// Keep serving cached keys if the provider is briefly unreachable
+	if ok {
+		return key, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookup finds a cached key. An empty kid matches when there is a single key.
+// The caller must hold o.mu.
+func (o *OIDCAuthenticator) lookup(kid string) (crypto.PublicKey, bool) {
+	if key, ok := o.keys[kid]; ok {
+		return key, true
+	}
+	if kid == "" && len(o.keys) == 1 {
+		for _, key := range o.keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+// refresh discovers the JWKS URL if needed and reloads the key set. The HTTP
+// requests run without o.mu; the new keys are swapped in at the end.
+func (o *OIDCAuthenticator) refresh(ctx context.Context) error {
+	// Record the attempt even on failure so errors are rate limited too. Setting
+	// it afterwards lets callers arriving mid-fetch join the fetch in flight.
+	defer func() {
+		o.mu.Lock()
+		o.fetchedAt = time.Now()
+		o.mu.Unlock()
+	}()
+
+	o.mu.Lock()
+	jwksURL := o.jwksURL
+	o.mu.Unlock()
+
+	if jwksURL == "" {
+		var discovery struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		url := strings.TrimRight(o.cfg.Issuer, "/") + "/.well-known/openid-configuration"
+		if err := o.getJSON(ctx, url, &discovery); err != nil {
+			return fmt.Errorf("OIDC discovery failed: %w", err)
+		}
+		if discovery.JWKSURI == "" {
+			return fmt.Errorf("OIDC discovery document has no jwks_uri")
+		}
+		jwksURL = discovery.JWKSURI
+
+		o.mu.Lock()
+		o.jwksURL = jwksURL
+		o.mu.Unlock()
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := o.getJSON(ctx, jwksURL, &set); err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			continue // Skip key types we don't support
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("JWKS at %s contains no usable signing keys", jwksURL)
+	}
+
+	o.mu.Lock()
+	o.keys = keys
+	o.mu.Unlock()
+	return nil
+}
+
+// getJSON fetches and decodes a JSON document.
+func (o *OIDCAuthenticator) getJSON(ctx context.Context, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned status %d", url, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// jwk is a JSON Web Key (RFC 7517) for RSA or EC public keys.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// publicKey converts the JWK to a Go public key.
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("RSA exponent too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("EC key is not on curve %s", k.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// decodeBigInt decodes a base64url big-endian integer.
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
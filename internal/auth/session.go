@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// SessionConfig configures HMAC-signed session cookies.
+type SessionConfig struct {
+	Secret     string        // HMAC-SHA256 key (at least 32 bytes)
+	CookieName string        // Default "worker_session"
+	TTL        time.Duration // Default 12h
+	Insecure   bool          // Omit the Secure cookie flag (plain HTTP only)
+}
+
+// session is the signed cookie payload.
+type session struct {
+	Subject string   `json:"sub"`
+	Scopes  []string `json:"scp,omitempty"`
+	Roles   []string `json:"rol,omitempty"`
+	Expires int64    `json:"exp"`
+}
+
+// SessionManager issues and verifies session cookies. Cookies are
+// base64(payload) + "." + base64(HMAC-SHA256(payload)), HttpOnly and SameSite=Strict,
+// so they cannot be read by scripts or sent by other sites.
+type SessionManager struct {
+	secret     []byte
+	cookieName string
+	ttl        time.Duration
+	secure     bool
+}
+
+// NewSessionManager creates a session manager.
+func NewSessionManager(cfg SessionConfig) (*SessionManager, error) {
+	if len(cfg.Secret) < 32 {
+		return nil, fmt.Errorf("AUTH_SESSION_SECRET must be at least 32 bytes")
+	}
+	if cfg.CookieName == "" {
+		cfg.CookieName = "worker_session"
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = 12 * time.Hour
+	}
+	return &SessionManager{
+		secret:     []byte(cfg.Secret),
+		cookieName: cfg.CookieName,
+		ttl:        cfg.TTL,
+		secure:     !cfg.Insecure,
+	}, nil
+}
+
+// Issue sets a session cookie for id and returns the session's expiry.
+func (m *SessionManager) Issue(w http.ResponseWriter, id *Identity) (time.Time, error) {
+	expires := time.Now().Add(m.ttl).Truncate(time.Second)
+	payload, err := json.Marshal(session{Subject: id.Subject, Scopes: id.Scopes, Roles: id.Roles, Expires: expires.Unix()})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to encode session: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	http.SetCookie(w, &http.Cookie{
+		Name:     m.cookieName,
+		Value:    encoded + "." + m.sign(encoded),
+		Path:     "/",
+		Expires:  expires,
+		MaxAge:   int(m.ttl.Seconds()),
+		HttpOnly: true,
+		Secure:   m.secure,
+		SameSite: http.SameSiteStrictMode,
+	})
+	return expires, nil
+}
+
+// Clear removes the session cookie.
+func (m *SessionManager) Clear(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     m.cookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   m.secure,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// Authenticate implements Authenticator.
+func (m *SessionManager) Authenticate(r *http.Request) (*Identity, error) {
+	cookie, err := r.Cookie(m.cookieName)
+	if err != nil || cookie.Value == "" {
+		return nil, ErrNoCredentials
+	}
+
+	encoded, signature, ok := strings.Cut(cookie.Value, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(m.sign(encoded))) {
+		return nil, fmt.Errorf("%w: bad session signature", ErrInvalidCredentials)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed session", ErrInvalidCredentials)
+	}
+	var s session
+	if err := json.Unmarshal(payload, &s); err != nil {
+		return nil, fmt.Errorf("%w: malformed session", ErrInvalidCredentials)
+	}
+
+	expires := time.Unix(s.Expires, 0)
+	if time.Now().After(expires) {
+		return nil, fmt.Errorf("%w: session expired", ErrInvalidCredentials)
+	}
+
+	return &Identity{Subject: s.Subject, Method: MethodSession, Scopes: s.Scopes, Roles: s.Roles, ExpiresAt: &expires}, nil
+}
+
+// sign returns the base64 HMAC of value.
+func (m *SessionManager) sign(value string) string {
+	mac := hmac.New(sha256.New, m.secret)
+	mac.Write([]byte(value))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
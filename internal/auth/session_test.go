@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func newTestSessions(t *testing.T, cfg SessionConfig) *SessionManager {
+	t.Helper()
+	if cfg.Secret == "" {
+		cfg.Secret = testSecret
+	}
+	m, err := NewSessionManager(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// issueCookie returns the session cookie m issues for id.
+func issueCookie(t *testing.T, m *SessionManager, id *Identity) *http.Cookie {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	if _, err := m.Issue(rec, id); err != nil {
+		t.Fatal(err)
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("got %d cookies, want 1", len(cookies))
+	}
+	return cookies[0]
+}
+
+// requestWithCookie builds a request carrying the named cookie value.
+func requestWithCookie(name, value string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/api/auth/me", nil)
+	r.AddCookie(&http.Cookie{Name: name, Value: value})
+	return r
+}
+
+func TestSessionRoundTrip(t *testing.T) {
+	m := newTestSessions(t, SessionConfig{TTL: time.Hour})
+	cookie := issueCookie(t, m, &Identity{Subject: "dashboard", Scopes: []string{"greeting"}, Roles: []string{"viewer"}})
+
+	if cookie.Name != "worker_session" || !cookie.HttpOnly || !cookie.Secure || cookie.SameSite != http.SameSiteStrictMode {
+		t.Errorf("cookie attributes = %+v", cookie)
+	}
+	if cookie.MaxAge != 3600 {
+		t.Errorf("MaxAge = %d, want 3600", cookie.MaxAge)
+	}
+
+	id, err := m.Authenticate(requestWithCookie(cookie.Name, cookie.Value))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id.Subject != "dashboard" || id.Method != MethodSession || !id.HasScope("greeting") || id.Roles[0] != "viewer" {
+		t.Errorf("identity = %+v", id)
+	}
+	if until := time.Until(*id.ExpiresAt); until < 59*time.Minute || until > time.Hour {
+		t.Errorf("session expires in %s, want about 1h", until)
+	}
+}
+
+func TestSessionRejectsTamperedCookies(t *testing.T) {
+	m := newTestSessions(t, SessionConfig{})
+	cookie := issueCookie(t, m, &Identity{Subject: "dashboard", Scopes: []string{"greeting"}})
+	payload, mac, _ := strings.Cut(cookie.Value, ".")
+
+	// Same MAC, escalated payload
+	raw, _ := base64.RawURLEncoding.DecodeString(payload)
+	var s session
+	if err := json.Unmarshal(raw, &s); err != nil {
+		t.Fatal(err)
+	}
+	s.Scopes = []string{"*"}
+	escalated, _ := json.Marshal(s)
+
+	// Flip one character of the MAC
+	flipped := []byte(mac)
+	if flipped[0] == 'A' {
+		flipped[0] = 'B'
+	} else {
+		flipped[0] = 'A'
+	}
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"modified payload", base64.RawURLEncoding.EncodeToString(escalated) + "." + mac},
+		{"modified MAC", payload + "." + string(flipped)},
+		{"truncated MAC", payload + "." + mac[:len(mac)-1]},
+		{"missing MAC", payload},
+		{"empty MAC", payload + "."},
+		{"MAC only", "." + mac},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := m.Authenticate(requestWithCookie(cookie.Name, tt.value))
+			if !errors.Is(err, ErrInvalidCredentials) {
+				t.Fatalf("err = %v, want ErrInvalidCredentials", err)
+			}
+		})
+	}
+}
+
+func TestSessionRejectsWrongSecret(t *testing.T) {
+	issuer := newTestSessions(t, SessionConfig{})
+	cookie := issueCookie(t, issuer, &Identity{Subject: "dashboard"})
+
+	other := newTestSessions(t, SessionConfig{Secret: strings.Repeat("x", 32)})
+	_, err := other.Authenticate(requestWithCookie(cookie.Name, cookie.Value))
+	if !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("err = %v, want ErrInvalidCredentials", err)
+	}
+}
+
+func TestSessionExpiry(t *testing.T) {
+	m := newTestSessions(t, SessionConfig{})
+
+	// Correctly signed, but past its expiry
+	forge := func(expires time.Time) string {
+		payload, _ := json.Marshal(session{Subject: "dashboard", Expires: expires.Unix()})
+		encoded := base64.RawURLEncoding.EncodeToString(payload)
+		return encoded + "." + m.sign(encoded)
+	}
+
+	_, err := m.Authenticate(requestWithCookie("worker_session", forge(time.Now().Add(-time.Second))))
+	if !errors.Is(err, ErrInvalidCredentials) || !strings.Contains(err.Error(), "expired") {
+		t.Fatalf("err = %v, want an expired session error", err)
+	}
+	if _, err := m.Authenticate(requestWithCookie("worker_session", forge(time.Now().Add(time.Minute)))); err != nil {
+		t.Fatalf("unexpired session rejected: %v", err)
+	}
+}
+
+func TestSessionNoCookie(t *testing.T) {
+	m := newTestSessions(t, SessionConfig{CookieName: "custom"})
+
+	// Another cookie name is not this manager's credential
+	_, err := m.Authenticate(requestWithCookie("worker_session", "anything"))
+	if !errors.Is(err, ErrNoCredentials) {
+		t.Fatalf("err = %v, want ErrNoCredentials", err)
+	}
+}
+
+func TestSessionCookieFlags(t *testing.T) {
+	m := newTestSessions(t, SessionConfig{Insecure: true})
+	if cookie := issueCookie(t, m, &Identity{Subject: "dashboard"}); cookie.Secure {
+		t.Error("Insecure sessions must not set the Secure flag")
+	}
+
+	rec := httptest.NewRecorder()
+	m.Clear(rec)
+	cleared := rec.Result().Cookies()
+	if len(cleared) != 1 || cleared[0].MaxAge != -1 || cleared[0].Value != "" {
+		t.Errorf("Clear set %+v", cleared)
+	}
+}
+
+func TestNewSessionManagerRejectsShortSecret(t *testing.T) {
+	if _, err := NewSessionManager(SessionConfig{Secret: "too-short"}); err == nil {
+		t.Fatal("expected an error for a secret under 32 bytes")
+	}
+}
+
+func TestNewRequiresTokensForSessions(t *testing.T) {
+	_, err := New(Config{Session: SessionConfig{Secret: testSecret}})
+	if err == nil || !strings.Contains(err.Error(), "AUTH_TOKENS") {
+		t.Fatalf("err = %v, want an error naming AUTH_TOKENS", err)
+	}
+
+	a, err := New(Config{
+		Tokens:  []StaticToken{{Name: "dashboard", Token: "7f3c9a1e5b2d4f6a8c0e"}},
+		Session: SessionConfig{Secret: testSecret},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Tokens() == nil || a.Sessions() == nil {
+		t.Error("tokens and sessions should both be enabled")
+	}
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+// TokenAuthenticator accepts static tokens sent as "Authorization: Bearer <token>"
+// or "X-API-Key: <token>".
+type TokenAuthenticator struct {
+	tokens []hashedToken
+}
+
+// hashedToken stores a digest so comparisons take constant time regardless of length.
+type hashedToken struct {
+	digest [sha256.Size]byte
+	token  StaticToken
+}
+
+// NewTokenAuthenticator creates an authenticator for the given tokens.
+func NewTokenAuthenticator(tokens []StaticToken) *TokenAuthenticator {
+	t := &TokenAuthenticator{}
+	for _, token := range tokens {
+		t.tokens = append(t.tokens, hashedToken{digest: sha256.Sum256([]byte(token.Token)), token: token})
+	}
+	return t
+}
+
+// Authenticate implements Authenticator.
+func (t *TokenAuthenticator) Authenticate(r *http.Request) (*Identity, error) {
+	raw := r.Header.Get("X-API-Key")
+	if raw == "" {
+		var ok bool
+		raw, ok = bearerToken(r)
+		// JWTs are left to the OIDC authenticator
+		if !ok || strings.Count(raw, ".") == 2 {
+			return nil, ErrNoCredentials
+		}
+	}
+
+	if token, ok := t.Lookup(raw); ok {
+		return &Identity{Subject: token.Name, Method: MethodToken, Scopes: token.Scopes}, nil
+	}
+	return nil, ErrInvalidCredentials
+}
+
+// Lookup returns the configured token matching raw.
+func (t *TokenAuthenticator) Lookup(raw string) (StaticToken, bool) {
+	digest := sha256.Sum256([]byte(raw))
+
+	var match StaticToken
+	found := false
+	for _, candidate := range t.tokens {
+		if subtle.ConstantTimeCompare(digest[:], candidate.digest[:]) == 1 {
+			match, found = candidate.token, true
+		}
+	}
+	return match, found
+}
+
+// bearerToken extracts the token from an Authorization: Bearer header.
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
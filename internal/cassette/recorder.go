@@ -0,0 +1,246 @@
+package cassette
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Mode selects whether a Recorder talks to the real server.
+type Mode string
+
+const (
+	// ModeReplay serves responses from the cassette and fails on unknown requests
+	ModeReplay Mode = "replay"
+	// ModeRecord sends requests to the real server and overwrites the cassette
+	ModeRecord Mode = "record"
+	// ModeReplayOrRecord replays known requests and records new ones
+	ModeReplayOrRecord Mode = "replay_or_record"
+	// ModePassthrough sends requests to the real server without recording
+	ModePassthrough Mode = "passthrough"
+)
+
+// ModeFromEnv reads CASSETTE_MODE (replay, record, replay_or_record, passthrough),
+// defaulting to replay so tests never hit the network by accident.
+func ModeFromEnv() Mode {
+	switch mode := Mode(strings.ToLower(os.Getenv("CASSETTE_MODE"))); mode {
+	case ModeRecord, ModeReplayOrRecord, ModePassthrough:
+		return mode
+	default:
+		return ModeReplay
+	}
+}
+
+// Matching controls how replayed requests are matched to recorded ones.
+type Matching string
+
+const (
+	// MatchStrict requires the same method, URL and body (JSON bodies are compared semantically)
+	MatchStrict Matching = "strict"
+	// MatchLenient requires only the same method and URL path; interactions replay in order
+	MatchLenient Matching = "lenient"
+)
+
+// NoMatchError is returned in replay mode when no recorded interaction matches.
+type NoMatchError struct {
+	Method string
+	URL    string
+	Path   string // Cassette file
+}
+
+func (e *NoMatchError) Error() string {
+	return fmt.Sprintf("cassette %s has no unused interaction matching %s %s (re-record with CASSETTE_MODE=record)",
+		e.Path, e.Method, e.URL)
+}
+
+// Recorder is an http.RoundTripper that records or replays interactions.
+type Recorder struct {
+	path      string
+	mode      Mode
+	matching  Matching
+	transport http.RoundTripper
+	scrubber  *Scrubber
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+	dirty        bool
+}
+
+// Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithMode sets the record/replay mode (default ModeReplay).
+func WithMode(mode Mode) Option {
+	return func(r *Recorder) { r.mode = mode }
+}
+
+// WithMatching sets the request matching mode (default MatchStrict).
+func WithMatching(matching Matching) Option {
+	return func(r *Recorder) { r.matching = matching }
+}
+
+// WithTransport sets the real transport used when recording (default http.DefaultTransport).
+func WithTransport(transport http.RoundTripper) Option {
+	return func(r *Recorder) { r.transport = transport }
+}
+
+// WithScrubber replaces the default secret scrubber.
+func WithScrubber(s *Scrubber) Option {
+	return func(r *Recorder) { r.scrubber = s }
+}
+
+// New creates a recorder for the cassette at path.
+// In replay modes the cassette is loaded immediately; a missing file is an error
+// in ModeReplay and an empty cassette otherwise.
+func New(path string, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      ModeReplay,
+		matching:  MatchStrict,
+		transport: http.DefaultTransport,
+		scrubber:  DefaultScrubber(),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	switch r.mode {
+	case ModeReplay, ModeReplayOrRecord:
+		interactions, err := load(path)
+		if err != nil && !(r.mode == ModeReplayOrRecord && errors.Is(err, os.ErrNotExist)) {
+			return nil, fmt.Errorf("failed to load cassette: %w", err)
+		}
+		r.interactions = interactions
+		r.used = make([]bool, len(interactions))
+	case ModeRecord, ModePassthrough:
+	default:
+		return nil, fmt.Errorf("unknown cassette mode %q", r.mode)
+	}
+
+	return r, nil
+}
+
+// Client returns an http.Client that uses the recorder as its transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Mode returns the recorder's mode.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Stop saves newly recorded interactions to the cassette file.
+func (r *Recorder) Stop() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.dirty {
+		return nil
+	}
+	r.dirty = false
+	return save(r.path, r.interactions)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := r.scrubber.request(req, body)
+
+	if r.mode == ModeReplay || r.mode == ModeReplayOrRecord {
+		if resp, ok := r.replay(req, recorded); ok {
+			return resp, nil
+		}
+		if r.mode == ModeReplay {
+			return nil, &NoMatchError{Method: recorded.Method, URL: recorded.URL, Path: r.path}
+		}
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil || r.mode == ModePassthrough {
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response for recording: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, Interaction{
+		Request:  recorded,
+		Response: r.scrubber.response(resp, respBody),
+	})
+	r.used = append(r.used, true)
+	r.dirty = true
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay finds the first unused matching interaction and builds its response.
+func (r *Recorder) replay(req *http.Request, recorded Request) (*http.Response, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, in := range r.interactions {
+		if r.used[i] || !r.matches(in.Request, recorded) {
+			continue
+		}
+
+		body, err := decodeBody(in.Response.Body, in.Response.BodyEncoding)
+		if err != nil {
+			continue
+		}
+		r.used[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			StatusCode:    in.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Headers.Clone(),
+			Body:          io.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, true
+	}
+	return nil, false
+}
+
+// matches compares a recorded request with an incoming one.
+func (r *Recorder) matches(recorded, incoming Request) bool {
+	if recorded.Method != incoming.Method {
+		return false
+	}
+	if r.matching == MatchLenient {
+		return urlPath(recorded.URL) == urlPath(incoming.URL)
+	}
+	return recorded.URL == incoming.URL && equalBodies(recorded.Body, incoming.Body)
+}
+
+// readBody reads and restores a request body.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
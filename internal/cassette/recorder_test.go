@@ -0,0 +1,256 @@
+package cassette_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/cassette"
+)
+
+// newServer echoes the request body back and counts the requests it serves.
+func newServer(t *testing.T) (*httptest.Server, *int32) {
+	t.Helper()
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=abc123")
+		w.Write([]byte(`{"echo":` + string(body) + `,"path":"` + r.URL.Path + `"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &calls
+}
+
+// do sends a POST through client with the given body and headers and returns the response body.
+func do(t *testing.T, client *http.Client, url, body string, headers map[string]string) (string, error) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	out, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(out), nil
+}
+
+// record records one interaction per body into a new cassette and returns its path.
+func record(t *testing.T, srv *httptest.Server, bodies ...string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "cassettes", "test.json")
+	rec, err := cassette.New(path, cassette.WithMode(cassette.ModeRecord), cassette.WithTransport(srv.Client().Transport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, body := range bodies {
+		if _, err := do(t, rec.Client(), srv.URL+"/v1/embeddings", body, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRecordThenReplay(t *testing.T) {
+	srv, calls := newServer(t)
+	path := record(t, srv, `{"input":"a","model":"m"}`, `{"input":"b","model":"m"}`)
+	if *calls != 2 {
+		t.Fatalf("server got %d requests while recording, want 2", *calls)
+	}
+
+	rec, err := cassette.New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Mode() != cassette.ModeReplay {
+		t.Errorf("default mode = %s, want replay", rec.Mode())
+	}
+
+	// Out of order, with JSON keys reordered
+	got, err := do(t, rec.Client(), srv.URL+"/v1/embeddings", `{"model":"m","input":"b"}`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"echo":{"input":"b","model":"m"},"path":"/v1/embeddings"}`; got != want {
+		t.Errorf("replayed %s, want %s", got, want)
+	}
+	if _, err := do(t, rec.Client(), srv.URL+"/v1/embeddings", `{"input":"a","model":"m"}`, nil); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 2 {
+		t.Errorf("replay reached the server (%d requests)", *calls)
+	}
+
+	// Each interaction replays once
+	_, err = do(t, rec.Client(), srv.URL+"/v1/embeddings", `{"input":"a","model":"m"}`, nil)
+	var miss *cassette.NoMatchError
+	if !errors.As(err, &miss) {
+		t.Errorf("repeated request error = %v, want a *NoMatchError", err)
+	}
+}
+
+func TestStrictReplayMisses(t *testing.T) {
+	srv, calls := newServer(t)
+	path := record(t, srv, `{"input":"a"}`)
+
+	tests := []struct {
+		name, url, body string
+	}{
+		{name: "different body", url: srv.URL + "/v1/embeddings", body: `{"input":"changed"}`},
+		{name: "different path", url: srv.URL + "/v1/chat/completions", body: `{"input":"a"}`},
+		{name: "different query", url: srv.URL + "/v1/embeddings?page=2", body: `{"input":"a"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, err := cassette.New(path, cassette.WithMode(cassette.ModeReplay))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = do(t, rec.Client(), tt.url, tt.body, nil)
+			var miss *cassette.NoMatchError
+			if !errors.As(err, &miss) {
+				t.Fatalf("error = %v, want a *NoMatchError", err)
+			}
+			if miss.Path != path || miss.Method != http.MethodPost {
+				t.Errorf("NoMatchError = %+v", miss)
+			}
+		})
+	}
+	if *calls != 1 {
+		t.Errorf("strict replay reached the server (%d requests)", *calls)
+	}
+}
+
+func TestLenientReplayMatchesPathInOrder(t *testing.T) {
+	srv, _ := newServer(t)
+	path := record(t, srv, `{"input":"first"}`, `{"input":"second"}`)
+
+	rec, err := cassette.New(path, cassette.WithMatching(cassette.MatchLenient))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"first", "second"} {
+		got, err := do(t, rec.Client(), srv.URL+"/v1/embeddings?page=9", `{"input":"other"}`, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(got, want) {
+			t.Errorf("replayed %s, want the %q recording", got, want)
+		}
+	}
+}
+
+func TestScrubsSecrets(t *testing.T) {
+	srv, _ := newServer(t)
+	path := filepath.Join(t.TempDir(), "scrub.json")
+	rec, err := cassette.New(path, cassette.WithMode(cassette.ModeRecord), cassette.WithTransport(srv.Client().Transport))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := `{"input":"keep me","api_key":"k-123456","nested":{"password":"hunter2"},"note":"sk-abcdefghijklmnopqrstuvwx"}`
+	headers := map[string]string{
+		"Authorization": "Bearer real-server-token",
+		"api-key":       "azure-secret-key",
+		"X-Trace":       "visible",
+	}
+	if _, err := do(t, rec.Client(), srv.URL+"/v1/embeddings?api_key=query-secret", body, headers); err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := string(data)
+	for _, secret := range []string{"real-server-token", "azure-secret-key", "query-secret", "k-123456", "hunter2", "sk-abcdefghijklmnopqrstuvwx", "session=abc123"} {
+		if strings.Contains(saved, secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, saved)
+		}
+	}
+	for _, kept := range []string{"keep me", "visible"} {
+		if !strings.Contains(saved, kept) {
+			t.Errorf("cassette lost %q:\n%s", kept, saved)
+		}
+	}
+
+	// Scrubbed requests still match, with different secrets
+	replay, err := cassette.New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherBody := strings.Replace(body, "k-123456", "k-other", 1)
+	headers["Authorization"] = "Bearer another-token"
+	if _, err := do(t, replay.Client(), srv.URL+"/v1/embeddings?api_key=other", otherBody, headers); err != nil {
+		t.Errorf("replay with different secrets failed: %v", err)
+	}
+}
+
+func TestNewMissingCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.json")
+	if _, err := cassette.New(path); err == nil {
+		t.Error("expected an error for a missing cassette in replay mode")
+	}
+
+	srv, calls := newServer(t)
+	rec, err := cassette.New(path, cassette.WithMode(cassette.ModeReplayOrRecord), cassette.WithTransport(srv.Client().Transport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := do(t, rec.Client(), srv.URL+"/x", `{"n":1}`, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if *calls != 2 {
+		t.Errorf("server got %d requests, want 2 (recorded interactions are not replayed in the same session)", *calls)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("cassette not written: %v", err)
+	}
+
+	if _, err := cassette.New(path, cassette.WithMode("rewind")); err == nil {
+		t.Error("expected an error for an unknown mode")
+	}
+}
+
+func TestModeFromEnv(t *testing.T) {
+	for env, want := range map[string]cassette.Mode{
+		"":                 cassette.ModeReplay,
+		"RECORD":           cassette.ModeRecord,
+		"replay_or_record": cassette.ModeReplayOrRecord,
+		"passthrough":      cassette.ModePassthrough,
+		"bogus":            cassette.ModeReplay,
+	} {
+		t.Setenv("CASSETTE_MODE", env)
+		if got := cassette.ModeFromEnv(); got != want {
+			t.Errorf("CASSETTE_MODE=%q: mode = %s, want %s", env, got, want)
+		}
+	}
+}
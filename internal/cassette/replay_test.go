@@ -0,0 +1,82 @@
+package cassette_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sashabaranov/go-openai"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/cassette"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/embeddings"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/llm"
+)
+
+// The cassettes in testdata were recorded once and are replayed without credentials.
+
+func TestReplayEmbeddingsClient(t *testing.T) {
+	rec, err := cassette.New("testdata/embeddings.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := embeddings.NewClientWithConfig(embeddings.Config{
+		APIKey:     "test", // The recorded Authorization header is REDACTED
+		Model:      openai.SmallEmbedding3,
+		Dimensions: 4,
+		HTTPClient: rec.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vector, err := client.GenerateEmbedding(context.Background(), "How do I reset my password?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vector) != 4 || vector[0] != 0.6 || vector[1] != 0.8 {
+		t.Errorf("embedding = %v", vector)
+	}
+
+	results, err := client.GenerateEmbeddingsBatch(context.Background(), []string{"How do I reset my password?", "Where is my invoice?"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[1].Text != "Where is my invoice?" || results[1].Embedding[2] != 0.96 {
+		t.Errorf("batch = %+v", results)
+	}
+}
+
+func TestReplayLLMClient(t *testing.T) {
+	rec, err := cassette.New("testdata/llm.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := llm.NewClient(llm.Config{
+		BaseURL:    "https://llm.example.com",
+		Token:      "test",
+		HTTPClient: rec.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type decision struct {
+		Approved  bool   `yaml:"approved"`
+		Reasoning string `yaml:"reasoning"`
+	}
+	req := llm.NewRequest("ct_faq_dedup").
+		With("new_item", map[string]string{"question": "How can I change my password?"}).
+		With("similar_in_production", []map[string]string{{"id": "faq-12", "question": "How do I reset my password?"}})
+
+	got, resp, err := llm.ExecuteInto(context.Background(), client, req, llm.DecodeYAML[decision])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Approved || got.Reasoning != "Same question as faq-12" {
+		t.Errorf("decision = %+v", got)
+	}
+	if resp.Attempts != 1 {
+		t.Errorf("attempts = %d, want 1", resp.Attempts)
+	}
+}
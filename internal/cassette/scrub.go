@@ -0,0 +1,168 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces every scrubbed secret.
+const redacted = "REDACTED"
+
+// Scrubber removes secrets from interactions before they are written to disk.
+// Matching is done on scrubbed requests, so replays never need real secrets.
+type Scrubber struct {
+	Headers     []string         // Header names whose values are redacted (case-insensitive)
+	QueryParams []string         // URL query parameters whose values are redacted
+	JSONFields  []string         // JSON object keys whose values are redacted at any depth
+	Patterns    []*regexp.Regexp // Regexes redacted anywhere in bodies and URLs
+}
+
+// DefaultScrubber redacts auth headers, common credential fields and OpenAI-style keys.
+func DefaultScrubber() *Scrubber {
+	return &Scrubber{
+		Headers: []string{
+			"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie",
+			"X-Api-Key", "Api-Key", "OpenAI-Organization", "OpenAI-Project",
+		},
+		QueryParams: []string{"api_key", "key", "token", "access_token"},
+		JSONFields:  []string{"api_key", "apiKey", "token", "access_token", "refresh_token", "password", "secret"},
+		Patterns: []*regexp.Regexp{
+			regexp.MustCompile(`sk-[A-Za-z0-9_-]{16,}`),
+			regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9._~+/=-]{8,}`),
+		},
+	}
+}
+
+// request converts and scrubs a request.
+func (s *Scrubber) request(req *http.Request, body []byte) Request {
+	encoded, encoding := encodeBody(s.body(body))
+	return Request{
+		Method:       req.Method,
+		URL:          s.url(req.URL),
+		Headers:      s.headers(req.Header),
+		Body:         encoded,
+		BodyEncoding: encoding,
+	}
+}
+
+// response converts and scrubs a response.
+func (s *Scrubber) response(resp *http.Response, body []byte) Response {
+	encoded, encoding := encodeBody(s.body(body))
+	return Response{
+		StatusCode:   resp.StatusCode,
+		Headers:      s.headers(resp.Header),
+		Body:         encoded,
+		BodyEncoding: encoding,
+	}
+}
+
+// headers returns a copy with secret headers redacted.
+func (s *Scrubber) headers(h http.Header) http.Header {
+	out := h.Clone()
+	for _, name := range s.Headers {
+		if len(out.Values(name)) > 0 {
+			out.Set(name, redacted)
+		}
+	}
+	return out
+}
+
+// url returns the URL string with secret query parameters redacted.
+func (s *Scrubber) url(u *url.URL) string {
+	clone := *u
+	query := clone.Query()
+	changed := false
+	for _, param := range s.QueryParams {
+		if query.Has(param) {
+			query.Set(param, redacted)
+			changed = true
+		}
+	}
+	if changed {
+		clone.RawQuery = query.Encode()
+	}
+	return s.patterns(clone.String())
+}
+
+// body redacts JSON fields and patterns in a body.
+func (s *Scrubber) body(body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	var doc interface{}
+	if json.Unmarshal(body, &doc) == nil && s.redactJSON(doc) {
+		if out, err := json.Marshal(doc); err == nil {
+			body = out
+		}
+	}
+	return []byte(s.patterns(string(body)))
+}
+
+// redactJSON redacts configured fields in place and reports whether anything changed.
+func (s *Scrubber) redactJSON(v interface{}) bool {
+	changed := false
+	switch node := v.(type) {
+	case map[string]interface{}:
+		for k, child := range node {
+			if s.isSecretField(k) {
+				node[k] = redacted
+				changed = true
+				continue
+			}
+			changed = s.redactJSON(child) || changed
+		}
+	case []interface{}:
+		for _, child := range node {
+			changed = s.redactJSON(child) || changed
+		}
+	}
+	return changed
+}
+
+// isSecretField reports whether a JSON key is configured as secret.
+func (s *Scrubber) isSecretField(key string) bool {
+	for _, f := range s.JSONFields {
+		if strings.EqualFold(key, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// patterns redacts every regex match.
+func (s *Scrubber) patterns(text string) string {
+	for _, p := range s.Patterns {
+		text = p.ReplaceAllString(text, redacted)
+	}
+	return text
+}
+
+// equalBodies compares bodies, treating JSON documents as equal regardless of key order.
+func equalBodies(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var ja, jb interface{}
+	if json.Unmarshal([]byte(a), &ja) != nil || json.Unmarshal([]byte(b), &jb) != nil {
+		return false
+	}
+	ca, errA := json.Marshal(ja)
+	cb, errB := json.Marshal(jb)
+	return errA == nil && errB == nil && bytes.Equal(ca, cb)
+}
+
+// urlPath returns scheme, host and path of a URL string, without the query.
+func urlPath(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String()
+}
@@ -0,0 +1,388 @@
+// Package circuitbreaker stops calling an external dependency while it is failing.
+//
+// A Breaker starts closed and lets every call through. After FailureThreshold
+// consecutive failures it opens and rejects calls immediately with an *OpenError
+// instead of letting each caller wait through timeouts and retries. Once CoolDown
+// has passed it goes half-open and lets a few probe calls through: if they succeed
+// the breaker closes again, if one fails it re-opens for another cool-down.
+package circuitbreaker
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// State is the breaker state.
+type State int
+
+const (
+	// Closed lets all calls through
+	Closed State = iota
+	// Open rejects all calls until the cool-down expires
+	Open
+	// HalfOpen lets a limited number of probe calls through
+	HalfOpen
+)
+
+// String returns the state name used in logs and the health endpoint.
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half_open"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText encodes the state as its name.
+func (s State) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Outcome is the result of a call reported to a breaker.
+type Outcome int
+
+const (
+	// Success means the dependency answered
+	Success Outcome = iota
+	// Failure counts towards opening the breaker
+	Failure
+	// Neutral releases the call without counting it either way, e.g. when the
+	// caller cancelled before the dependency answered
+	Neutral
+)
+
+// ErrOpen is matched by every *OpenError: errors.Is(err, circuitbreaker.ErrOpen).
+var ErrOpen = errors.New("circuit breaker is open")
+
+// OpenError is returned when a call is rejected by an open (or saturated half-open) breaker.
+type OpenError struct {
+	Name       string
+	State      State
+	RetryAfter time.Duration // Time until the breaker lets a probe through
+}
+
+func (e *OpenError) Error() string {
+	return fmt.Sprintf("circuit breaker %q is %s, retry in %v", e.Name, e.State, e.RetryAfter.Round(time.Millisecond))
+}
+
+// Unwrap makes errors.Is(err, ErrOpen) work.
+func (e *OpenError) Unwrap() error {
+	return ErrOpen
+}
+
+// Config controls when a breaker trips and recovers.
+type Config struct {
+	FailureThreshold int           // Consecutive failures that open the breaker (default 5)
+	CoolDown         time.Duration // Time spent open before probing (default 30s)
+	HalfOpenRequests int           // Concurrent probes allowed while half-open (default 1)
+	SuccessThreshold int           // Probe successes needed to close again (default 1)
+
+	// OnStateChange is called after every transition (default: log it).
+	OnStateChange func(name string, from, to State)
+}
+
+// DefaultConfig returns the default breaker configuration.
+func DefaultConfig() Config {
+	return Config{
+		FailureThreshold: 5,
+		CoolDown:         30 * time.Second,
+		HalfOpenRequests: 1,
+		SuccessThreshold: 1,
+	}
+}
+
+// ConfigFromEnv reads breaker settings from environment variables:
+//
+//	CIRCUIT_BREAKER_FAILURE_THRESHOLD  consecutive failures before opening (default 5)
+//	CIRCUIT_BREAKER_COOLDOWN           time spent open, e.g. 30s (default 30s)
+//	CIRCUIT_BREAKER_HALF_OPEN_REQUESTS probe calls allowed while half-open (default 1)
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig()
+
+	if v := os.Getenv("CIRCUIT_BREAKER_FAILURE_THRESHOLD"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return Config{}, fmt.Errorf("invalid CIRCUIT_BREAKER_FAILURE_THRESHOLD %q: must be a positive integer", v)
+		}
+		cfg.FailureThreshold = n
+	}
+
+	if v := os.Getenv("CIRCUIT_BREAKER_COOLDOWN"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid CIRCUIT_BREAKER_COOLDOWN %q: %w", v, err)
+		}
+		cfg.CoolDown = d
+	}
+
+	if v := os.Getenv("CIRCUIT_BREAKER_HALF_OPEN_REQUESTS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return Config{}, fmt.Errorf("invalid CIRCUIT_BREAKER_HALF_OPEN_REQUESTS %q: must be a positive integer", v)
+		}
+		cfg.HalfOpenRequests = n
+	}
+
+	return cfg, nil
+}
+
+// withDefaults fills unset fields.
+func (c Config) withDefaults() Config {
+	def := DefaultConfig()
+	if c.FailureThreshold <= 0 {
+		c.FailureThreshold = def.FailureThreshold
+	}
+	if c.CoolDown <= 0 {
+		c.CoolDown = def.CoolDown
+	}
+	if c.HalfOpenRequests <= 0 {
+		c.HalfOpenRequests = def.HalfOpenRequests
+	}
+	if c.SuccessThreshold <= 0 {
+		c.SuccessThreshold = def.SuccessThreshold
+	}
+	if c.OnStateChange == nil {
+		c.OnStateChange = logStateChange
+	}
+	return c
+}
+
+// Status is a snapshot of a breaker for health reporting.
+type Status struct {
+	Name                string     `json:"name"`
+	State               State      `json:"state"`
+	ConsecutiveFailures int        `json:"consecutive_failures"`
+	Successes           int64      `json:"successes_total"`
+	Failures            int64      `json:"failures_total"`
+	Rejected            int64      `json:"rejected_total"`
+	LastError           string     `json:"last_error,omitempty"`
+	OpenedAt            *time.Time `json:"opened_at,omitempty"`
+	RetryAt             *time.Time `json:"retry_at,omitempty"`
+}
+
+// Breaker is a single circuit breaker. It is safe for concurrent use.
+type Breaker struct {
+	name string
+	cfg  Config
+
+	mu                  sync.Mutex
+	state               State
+	consecutiveFailures int
+	probes              int // In-flight half-open calls
+	probeSuccesses      int
+	openedAt            time.Time
+	successes           int64
+	failures            int64
+	rejected            int64
+	lastError           string
+
+	now func() time.Time
+}
+
+// New creates a closed breaker.
+func New(name string, cfg Config) *Breaker {
+	return &Breaker{name: name, cfg: cfg.withDefaults(), now: time.Now}
+}
+
+// Name returns the breaker's name.
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// State returns the current state, moving from open to half-open once the cool-down has passed.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.advance()
+	return b.state
+}
+
+// Allow reserves a call. It returns an *OpenError if the call must not be made;
+// otherwise the caller must call done with the outcome once the call finishes.
+// err is recorded as the last error for failures and may be nil otherwise.
+func (b *Breaker) Allow() (done func(outcome Outcome, err error), err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance()
+	switch b.state {
+	case Open:
+		b.rejected++
+		return nil, b.openError()
+	case HalfOpen:
+		if b.probes >= b.cfg.HalfOpenRequests {
+			b.rejected++
+			return nil, b.openError()
+		}
+		b.probes++
+	}
+
+	generation := b.openedAt
+	probe := b.state == HalfOpen
+	return func(outcome Outcome, err error) {
+		b.record(generation, probe, outcome, err)
+	}, nil
+}
+
+// Execute runs fn if the breaker allows it. Errors from fn count as failures
+// unless ignore reports them as the caller's fault (e.g. context.Canceled),
+// in which case the call is Neutral.
+func (b *Breaker) Execute(fn func() error, ignore ...func(error) bool) error {
+	done, err := b.Allow()
+	if err != nil {
+		return err
+	}
+
+	err = fn()
+	outcome := Success
+	if err != nil {
+		outcome = Failure
+		for _, ig := range ignore {
+			if ig(err) {
+				outcome = Neutral
+				break
+			}
+		}
+	}
+	done(outcome, err)
+	return err
+}
+
+// Status returns a snapshot of the breaker.
+func (b *Breaker) Status() Status {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance()
+	s := Status{
+		Name:                b.name,
+		State:               b.state,
+		ConsecutiveFailures: b.consecutiveFailures,
+		Successes:           b.successes,
+		Failures:            b.failures,
+		Rejected:            b.rejected,
+		LastError:           b.lastError,
+	}
+	if b.state != Closed {
+		openedAt, retryAt := b.openedAt, b.openedAt.Add(b.cfg.CoolDown)
+		s.OpenedAt, s.RetryAt = &openedAt, &retryAt
+	}
+	return s
+}
+
+// Reset forces the breaker closed.
+func (b *Breaker) Reset() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures = 0
+	b.transition(Closed)
+}
+
+// record applies the outcome of an allowed call.
+func (b *Breaker) record(generation time.Time, probe bool, outcome Outcome, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch outcome {
+	case Success:
+		b.successes++
+	case Failure:
+		b.failures++
+		if err != nil {
+			b.lastError = err.Error()
+		}
+	}
+
+	// Ignore late results from calls made before the breaker last changed state
+	if !generation.Equal(b.openedAt) {
+		return
+	}
+	if probe && b.state == HalfOpen && b.probes > 0 {
+		b.probes--
+	}
+	if outcome == Neutral {
+		return
+	}
+
+	switch b.state {
+	case Closed:
+		if outcome == Success {
+			b.consecutiveFailures = 0
+			return
+		}
+		b.consecutiveFailures++
+		if b.consecutiveFailures >= b.cfg.FailureThreshold {
+			b.trip()
+		}
+	case HalfOpen:
+		if outcome == Failure {
+			b.consecutiveFailures++
+			b.trip()
+			return
+		}
+		b.probeSuccesses++
+		if b.probeSuccesses >= b.cfg.SuccessThreshold {
+			b.consecutiveFailures = 0
+			b.transition(Closed)
+		}
+	}
+}
+
+// advance moves an open breaker to half-open once the cool-down has passed.
+func (b *Breaker) advance() {
+	if b.state == Open && !b.now().Before(b.openedAt.Add(b.cfg.CoolDown)) {
+		b.transition(HalfOpen)
+	}
+}
+
+// trip opens the breaker.
+func (b *Breaker) trip() {
+	b.openedAt = b.now()
+	b.transition(Open)
+}
+
+// transition changes state and notifies the state-change hook.
+func (b *Breaker) transition(to State) {
+	from := b.state
+	b.state = to
+	b.probes = 0
+	b.probeSuccesses = 0
+	if to == Closed {
+		b.openedAt = time.Time{}
+	}
+	if from != to {
+		b.cfg.OnStateChange(b.name, from, to)
+	}
+}
+
+// openError builds the rejection error.
+func (b *Breaker) openError() *OpenError {
+	retry := b.openedAt.Add(b.cfg.CoolDown).Sub(b.now())
+	if retry < 0 {
+		retry = 0
+	}
+	return &OpenError{Name: b.name, State: b.state, RetryAfter: retry}
+}
+
+// logStateChange is the default OnStateChange hook.
+func logStateChange(name string, from, to State) {
+	switch to {
+	case Open:
+		log.Printf("🔴 Circuit breaker %s opened (was %s)", name, from)
+	case HalfOpen:
+		log.Printf("🟡 Circuit breaker %s half-open, probing", name)
+	case Closed:
+		log.Printf("🟢 Circuit breaker %s closed", name)
+	}
+}
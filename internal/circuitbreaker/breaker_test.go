@@ -0,0 +1,288 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var errBoom = errors.New("boom")
+
+// newTestBreaker returns a breaker on a fake clock that records its transitions.
+func newTestBreaker(cfg Config) (*Breaker, *time.Time, *[]string) {
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var transitions []string
+	cfg.OnStateChange = func(name string, from, to State) {
+		transitions = append(transitions, from.String()+"->"+to.String())
+	}
+	b := New("test", cfg)
+	b.now = func() time.Time { return clock }
+	return b, &clock, &transitions
+}
+
+// fail runs n failing calls through b.
+func fail(t *testing.T, b *Breaker, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		if err := b.Execute(func() error { return errBoom }); !errors.Is(err, errBoom) {
+			t.Fatalf("call %d: err = %v, want errBoom", i, err)
+		}
+	}
+}
+
+func TestBreakerLifecycle(t *testing.T) {
+	b, clock, transitions := newTestBreaker(Config{FailureThreshold: 3, CoolDown: 30 * time.Second})
+
+	// Closed: failures below the threshold, reset by a success
+	fail(t, b, 2)
+	if err := b.Execute(func() error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	fail(t, b, 2)
+	if b.State() != Closed {
+		t.Fatalf("state = %s after 2 consecutive failures, want closed", b.State())
+	}
+
+	// Closed -> open
+	fail(t, b, 1)
+	if b.State() != Open {
+		t.Fatalf("state = %s, want open", b.State())
+	}
+	called := false
+	err := b.Execute(func() error { called = true; return nil })
+	var openErr *OpenError
+	if !errors.As(err, &openErr) || !errors.Is(err, ErrOpen) || called {
+		t.Fatalf("open breaker: err = %v, called = %v", err, called)
+	}
+	if openErr.RetryAfter != 30*time.Second || openErr.State != Open {
+		t.Errorf("OpenError = %+v", openErr)
+	}
+
+	// Open -> half-open after the cool-down
+	*clock = clock.Add(29 * time.Second)
+	if b.State() != Open {
+		t.Fatalf("state = %s before the cool-down ends, want open", b.State())
+	}
+	*clock = clock.Add(time.Second)
+	if b.State() != HalfOpen {
+		t.Fatalf("state = %s after the cool-down, want half_open", b.State())
+	}
+
+	// Half-open -> open on a failed probe, with a fresh cool-down
+	fail(t, b, 1)
+	if b.State() != Open {
+		t.Fatalf("state = %s after a failed probe, want open", b.State())
+	}
+	if s := b.Status(); !s.OpenedAt.Equal(*clock) || !s.RetryAt.Equal(clock.Add(30*time.Second)) {
+		t.Errorf("status = %+v, want reopened now", s)
+	}
+
+	// Half-open -> closed on a successful probe
+	*clock = clock.Add(30 * time.Second)
+	if err := b.Execute(func() error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if b.State() != Closed {
+		t.Fatalf("state = %s after a successful probe, want closed", b.State())
+	}
+
+	want := []string{"closed->open", "open->half_open", "half_open->open", "open->half_open", "half_open->closed"}
+	if len(*transitions) != len(want) {
+		t.Fatalf("transitions = %v, want %v", *transitions, want)
+	}
+	for i := range want {
+		if (*transitions)[i] != want[i] {
+			t.Fatalf("transitions = %v, want %v", *transitions, want)
+		}
+	}
+
+	s := b.Status()
+	if s.Successes != 2 || s.Failures != 6 || s.Rejected != 1 || s.LastError != "boom" || s.OpenedAt != nil {
+		t.Errorf("status = %+v", s)
+	}
+}
+
+func TestHalfOpenLimitsProbes(t *testing.T) {
+	b, clock, _ := newTestBreaker(Config{FailureThreshold: 1, CoolDown: time.Second, SuccessThreshold: 2})
+	fail(t, b, 1)
+	*clock = clock.Add(time.Second)
+
+	done, err := b.Allow()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.Allow(); !errors.Is(err, ErrOpen) {
+		t.Fatalf("second concurrent probe: err = %v, want ErrOpen", err)
+	}
+
+	// One success is not enough with SuccessThreshold 2
+	done(Success, nil)
+	if b.State() != HalfOpen {
+		t.Fatalf("state = %s after 1 of 2 probe successes, want half_open", b.State())
+	}
+	if err := b.Execute(func() error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if b.State() != Closed {
+		t.Fatalf("state = %s after 2 probe successes, want closed", b.State())
+	}
+}
+
+func TestNeutralOutcome(t *testing.T) {
+	b, clock, _ := newTestBreaker(Config{FailureThreshold: 2, CoolDown: time.Second})
+
+	// Closed: neutral calls neither reset nor add to the failure count
+	fail(t, b, 1)
+	canceled := func(err error) bool { return errors.Is(err, context.Canceled) }
+	if err := b.Execute(func() error { return context.Canceled }, canceled); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v", err)
+	}
+	if s := b.Status(); s.State != Closed || s.ConsecutiveFailures != 1 || s.Successes != 0 || s.Failures != 1 {
+		t.Fatalf("status after a neutral call = %+v", s)
+	}
+	fail(t, b, 1)
+	if b.State() != Open {
+		t.Fatalf("state = %s, want open", b.State())
+	}
+
+	// Half-open: a cancelled probe frees its slot and leaves the breaker half-open
+	*clock = clock.Add(time.Second)
+	if err := b.Execute(func() error { return context.Canceled }, canceled); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v", err)
+	}
+	if s := b.Status(); s.State != HalfOpen || s.Successes != 0 {
+		t.Fatalf("status after a cancelled probe = %+v, want half_open with no successes", s)
+	}
+	if err := b.Execute(func() error { return errBoom }); !errors.Is(err, errBoom) {
+		t.Fatalf("next probe was not let through: %v", err)
+	}
+	if b.State() != Open {
+		t.Fatalf("state = %s after the next probe failed, want open", b.State())
+	}
+}
+
+func TestLateResultsAreIgnored(t *testing.T) {
+	b, clock, _ := newTestBreaker(Config{FailureThreshold: 1, CoolDown: time.Second})
+
+	slow, err := b.Allow() // started while closed
+	if err != nil {
+		t.Fatal(err)
+	}
+	fail(t, b, 1)
+	*clock = clock.Add(time.Second)
+	if b.State() != HalfOpen {
+		t.Fatalf("state = %s, want half_open", b.State())
+	}
+
+	// The slow call's success predates the trip and must not close the breaker
+	slow(Success, nil)
+	if b.State() != HalfOpen {
+		t.Errorf("state = %s after a late success, want half_open", b.State())
+	}
+}
+
+func TestTransport(t *testing.T) {
+	var status atomic.Int32
+	arrived := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			close(arrived)
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(int(status.Load()))
+	}))
+	defer srv.Close()
+
+	b, clock, _ := newTestBreaker(Config{FailureThreshold: 2, CoolDown: time.Second})
+	client := Client(srv.Client(), b)
+	get := func(ctx context.Context, path string) error {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+path, nil)
+		resp, err := client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+
+	// 4xx means the dependency is up
+	status.Store(http.StatusTooManyRequests)
+	for i := 0; i < 3; i++ {
+		if err := get(context.Background(), "/"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s := b.Status(); s.State != Closed || s.Successes != 3 {
+		t.Fatalf("status after 429s = %+v", s)
+	}
+
+	// 5xx opens the breaker, after which requests fail fast
+	status.Store(http.StatusServiceUnavailable)
+	for i := 0; i < 2; i++ {
+		if err := get(context.Background(), "/"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := get(context.Background(), "/"); !errors.Is(err, ErrOpen) {
+		t.Fatalf("err = %v, want ErrOpen", err)
+	}
+	if s := b.Status(); s.LastError == "" {
+		t.Errorf("5xx not recorded as the last error: %+v", s)
+	}
+
+	// A probe the caller cancels is neutral
+	*clock = clock.Add(time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	errc := make(chan error, 1)
+	go func() { errc <- get(ctx, "/slow") }()
+	<-arrived
+	if _, err := b.Allow(); !errors.Is(err, ErrOpen) {
+		t.Fatalf("second probe while the first is in flight: err = %v, want ErrOpen", err)
+	}
+	cancel()
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if s := b.Status(); s.State != HalfOpen || s.Successes != 3 {
+		t.Fatalf("status after a cancelled probe = %+v, want half_open and no new successes", s)
+	}
+
+	// The freed slot lets the next probe close the breaker
+	status.Store(http.StatusOK)
+	if err := get(context.Background(), "/"); err != nil {
+		t.Fatal(err)
+	}
+	if b.State() != Closed {
+		t.Errorf("state = %s, want closed", b.State())
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("CIRCUIT_BREAKER_FAILURE_THRESHOLD", "3")
+	t.Setenv("CIRCUIT_BREAKER_COOLDOWN", "1m")
+	t.Setenv("CIRCUIT_BREAKER_HALF_OPEN_REQUESTS", "")
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.FailureThreshold != 3 || cfg.CoolDown != time.Minute || cfg.HalfOpenRequests != 1 {
+		t.Errorf("config = %+v", cfg)
+	}
+
+	for key, value := range map[string]string{
+		"CIRCUIT_BREAKER_FAILURE_THRESHOLD":  "0",
+		"CIRCUIT_BREAKER_COOLDOWN":           "soon",
+		"CIRCUIT_BREAKER_HALF_OPEN_REQUESTS": "-1",
+	} {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, value)
+			if _, err := ConfigFromEnv(); err == nil {
+				t.Errorf("%s=%s: expected an error", key, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package circuitbreaker
+
+import (
+	"sort"
+	"sync"
+)
+
+// Registry holds named breakers so their state can be reported together.
+type Registry struct {
+	cfg Config
+
+	mu       sync.Mutex
+	breakers map[string]*Breaker
+}
+
+// DefaultRegistry is shared by AsyncGlobalState and the breaker health endpoint.
+var DefaultRegistry = NewRegistry(DefaultConfig())
+
+// NewRegistry creates a registry whose breakers use cfg.
+func NewRegistry(cfg Config) *Registry {
+	return &Registry{cfg: cfg, breakers: make(map[string]*Breaker)}
+}
+
+// Configure changes the configuration used for breakers created from now on.
+func (r *Registry) Configure(cfg Config) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cfg = cfg
+}
+
+// Get returns the breaker with the given name, creating it on first use.
+func (r *Registry) Get(name string) *Breaker {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if b, ok := r.breakers[name]; ok {
+		return b
+	}
+	b := New(name, r.cfg)
+	r.breakers[name] = b
+	return b
+}
+
+// Statuses returns a snapshot of every breaker, sorted by name.
+func (r *Registry) Statuses() []Status {
+	r.mu.Lock()
+	breakers := make([]*Breaker, 0, len(r.breakers))
+	for _, b := range r.breakers {
+		breakers = append(breakers, b)
+	}
+	r.mu.Unlock()
+
+	statuses := make([]Status, len(breakers))
+	for i, b := range breakers {
+		statuses[i] = b.Status()
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// Healthy reports whether no breaker is open.
+func (r *Registry) Healthy() bool {
+	for _, s := range r.Statuses() {
+		if s.State == Open {
+			return false
+		}
+	}
+	return true
+}
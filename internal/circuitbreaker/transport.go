@@ -0,0 +1,58 @@
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Transport is an http.RoundTripper guarded by a breaker. Network errors and
+// 5xx responses count as failures; 4xx responses (including 429) count as
+// successes because the dependency is up. Calls cancelled by the caller are Neutral.
+type Transport struct {
+	Base    http.RoundTripper // Defaults to http.DefaultTransport
+	Breaker *Breaker
+}
+
+// NewTransport wraps base with a breaker.
+func NewTransport(base http.RoundTripper, breaker *Breaker) *Transport {
+	return &Transport{Base: base, Breaker: breaker}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	done, err := t.Breaker.Allow()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := base.RoundTrip(req)
+	switch {
+	case err != nil && errors.Is(err, context.Canceled) && req.Context().Err() != nil:
+		// The caller gave up; say nothing about the dependency
+		done(Neutral, err)
+	case err != nil:
+		done(Failure, err)
+	case resp.StatusCode >= 500:
+		done(Failure, fmt.Errorf("%s %s: %s", req.Method, req.URL.Host, resp.Status))
+	default:
+		done(Success, nil)
+	}
+	return resp, err
+}
+
+// Client returns a copy of client (or a new client) whose transport is guarded by breaker.
+func Client(client *http.Client, breaker *Breaker) *http.Client {
+	var c http.Client
+	if client != nil {
+		c = *client
+	}
+	c.Transport = NewTransport(c.Transport, breaker)
+	return &c
+}
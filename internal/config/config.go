@@ -11,11 +11,11 @@
 // 4. Create a .env file in your project root with the required values
 // 5. Update environment variable names and defaults as needed
 //
-// If you don't need configuration management, you can delete this entire package.
+// If you don't need configuration management, you can delete Config, Load and
+// Validate; keep GetEnvOrDefault, which other packages use.
 package config
 
 import (
-	"fmt"
 	"os"
 
 	"github.com/joho/godotenv"
@@ -57,19 +57,19 @@ func Load() (*Config, error) {
 		
 		// External API configuration
 		// APIKey:      os.Getenv("API_KEY"),
-		// APIBaseURL:  getEnvOrDefault("API_BASE_URL", "https://api.example.com/v1"),
+		// APIBaseURL:  GetEnvOrDefault("API_BASE_URL", "https://api.example.com/v1"),
 		
 		// Database configuration
 		// DatabaseURL: os.Getenv("DATABASE_URL"),
 		
 		// Worker configuration
-		// WorkerHost:  getEnvOrDefault("WORKER_HOST", "localhost"),
-		// WorkerPort:  getEnvOrDefault("WORKER_PORT", "50051"),
+		// WorkerHost:  GetEnvOrDefault("WORKER_HOST", "localhost"),
+		// WorkerPort:  GetEnvOrDefault("WORKER_PORT", "50051"),
 		
 		// Application settings
-		// AppEnv:      getEnvOrDefault("APP_ENV", "development"),
-		// LogLevel:    getEnvOrDefault("LOG_LEVEL", "info"),
-		// DataDir:     getEnvOrDefault("DATA_DIR", "./data"),
+		// AppEnv:      GetEnvOrDefault("APP_ENV", "development"),
+		// LogLevel:    GetEnvOrDefault("LOG_LEVEL", "info"),
+		// DataDir:     GetEnvOrDefault("DATA_DIR", "./data"),
 	}
 
 	// Validate the configuration before returning
@@ -84,6 +84,7 @@ func Load() (*Config, error) {
 // TODO: Uncomment and add validation rules based on your required fields.
 func (c *Config) Validate() error {
 	// Example validations - uncomment and modify based on your requirements
+	// (add "fmt" to the imports)
 	
 	// if c.APIKey == "" {
 	// 	return fmt.Errorf("API_KEY is required")
@@ -100,9 +101,9 @@ func (c *Config) Validate() error {
 	return nil
 }
 
-// getEnvOrDefault retrieves an environment variable or returns a default value.
-// This is a helper function to simplify config initialization.
-func getEnvOrDefault(key, defaultValue string) string {
+// GetEnvOrDefault retrieves an environment variable or returns a default value.
+// Packages that read their own settings (ConfigFromEnv) use it too.
+func GetEnvOrDefault(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
 	}
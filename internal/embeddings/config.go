@@ -0,0 +1,128 @@
+package embeddings
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/sashabaranov/go-openai"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/circuitbreaker"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/ratelimit"
+)
+
+// Config controls which model is used and how returned vectors are shaped.
+type Config struct {
+	APIKey string
+	Model  openai.EmbeddingModel
+
+	// Dimensions requests shortened embeddings from the API (text-embedding-3 models only).
+	// Zero uses the model's default dimension.
+	Dimensions int
+
+	// Normalize rescales every returned vector to unit length, so the dot
+	// product equals cosine similarity.
+	Normalize bool
+
+	// HTTPClient overrides the transport used for API calls, e.g. a cassette
+	// recorder in tests. Nil uses the go-openai default.
+	HTTPClient *http.Client
+
+	// Limiter enforces request/token budgets for this model (see internal/ratelimit).
+	// Nil disables client-side rate limiting.
+	Limiter *ratelimit.Limiter
+
+	// Breaker fails requests fast while the API is down (see internal/circuitbreaker).
+	// Nil disables it.
+	Breaker *circuitbreaker.Breaker
+}
+
+// DefaultConfig returns the configuration used by NewClient when no overrides are set.
+func DefaultConfig() Config {
+	return Config{
+		Model: openai.SmallEmbedding3, // text-embedding-3-small (1536 dimensions)
+	}
+}
+
+// ConfigFromEnv reads the embeddings configuration from environment variables:
+//
+//	OPENAI_API_KEY        API key (required)
+//	EMBEDDING_MODEL       model name (default text-embedding-3-small)
+//	EMBEDDING_DIMENSIONS  requested dimensions (default: model default)
+//	EMBEDDING_NORMALIZE   true to L2-normalize returned vectors
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig()
+	cfg.APIKey = os.Getenv("OPENAI_API_KEY")
+
+	if model := os.Getenv("EMBEDDING_MODEL"); model != "" {
+		cfg.Model = openai.EmbeddingModel(model)
+	}
+
+	if v := os.Getenv("EMBEDDING_DIMENSIONS"); v != "" {
+		dims, err := strconv.Atoi(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid EMBEDDING_DIMENSIONS %q: %w", v, err)
+		}
+		cfg.Dimensions = dims
+	}
+
+	if v := os.Getenv("EMBEDDING_NORMALIZE"); v != "" {
+		normalize, err := strconv.ParseBool(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid EMBEDDING_NORMALIZE %q: %w", v, err)
+		}
+		cfg.Normalize = normalize
+	}
+
+	return cfg, nil
+}
+
+// Validate checks that the configuration can be used to create a client.
+func (c Config) Validate() error {
+	if c.APIKey == "" {
+		return fmt.Errorf("OPENAI_API_KEY environment variable is not set")
+	}
+	if c.Model == "" {
+		return fmt.Errorf("embedding model is required")
+	}
+	if c.Dimensions < 0 {
+		return fmt.Errorf("dimensions cannot be negative")
+	}
+	if c.Dimensions > 0 {
+		if !supportsDimensions(c.Model) {
+			return fmt.Errorf("model %s does not support custom dimensions", c.Model)
+		}
+		if max := ModelDimensions(c.Model); max > 0 && c.Dimensions > max {
+			return fmt.Errorf("dimensions %d exceeds the %d supported by %s", c.Dimensions, max, c.Model)
+		}
+	}
+	return nil
+}
+
+// OutputDimensions returns the length of vectors produced with this configuration,
+// or 0 if it is unknown for the model.
+func (c Config) OutputDimensions() int {
+	if c.Dimensions > 0 {
+		return c.Dimensions
+	}
+	return ModelDimensions(c.Model)
+}
+
+// ModelDimensions returns the default output dimension of a known model, or 0 if unknown.
+func ModelDimensions(model openai.EmbeddingModel) int {
+	switch model {
+	case openai.SmallEmbedding3, openai.AdaEmbeddingV2:
+		return 1536
+	case openai.LargeEmbedding3:
+		return 3072
+	default:
+		return 0
+	}
+}
+
+// supportsDimensions reports whether the API accepts the dimensions parameter for a model.
+func supportsDimensions(model openai.EmbeddingModel) bool {
+	return strings.HasPrefix(string(model), "text-embedding-3")
+}
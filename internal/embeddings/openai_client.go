@@ -2,18 +2,30 @@ package embeddings
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
-	"os"
+	"net/http"
 	"time"
 
 	"github.com/sashabaranov/go-openai"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/circuitbreaker"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/ratelimit"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tracing"
 )
 
 // Client wraps the OpenAI API client for embedding generation
 type Client struct {
-	client *openai.Client
-	model  openai.EmbeddingModel
+	client     *openai.Client
+	model      openai.EmbeddingModel
+	dimensions int  // Requested dimensions (0 = model default)
+	normalize  bool // L2-normalize returned vectors
+	limiter    *ratelimit.Limiter
 }
 
 // EmbeddingResult represents the result of an embedding operation
@@ -23,59 +35,106 @@ type EmbeddingResult struct {
 	Error     error
 }
 
-// NewClient creates a new OpenAI embeddings client
+// NewClient creates a new OpenAI embeddings client configured from environment variables
+// (see ConfigFromEnv)
 func NewClient() (*Client, error) {
-	apiKey := os.Getenv("OPENAI_API_KEY")
-	if apiKey == "" {
-		return nil, fmt.Errorf("OPENAI_API_KEY environment variable is not set")
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		return nil, err
 	}
+	return NewClientWithConfig(cfg)
+}
 
-	client := openai.NewClient(apiKey)
+// NewClientWithConfig creates a new OpenAI embeddings client from an explicit configuration
+func NewClientWithConfig(cfg Config) (*Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	clientConfig := openai.DefaultConfig(cfg.APIKey)
+	if cfg.HTTPClient != nil {
+		clientConfig.HTTPClient = cfg.HTTPClient
+	}
+	httpClient := cfg.HTTPClient
+	if cfg.Limiter != nil {
+		httpClient = ratelimit.Client(httpClient, cfg.Limiter)
+	}
+	// The breaker wraps the limiter so rejected calls don't use up the rate budget
+	if cfg.Breaker != nil {
+		httpClient = circuitbreaker.Client(httpClient, cfg.Breaker)
+	}
+	// Outermost, so client spans include time waiting for the limiter
+	clientConfig.HTTPClient = tracing.Client(httpClient)
+	client := openai.NewClientWithConfig(clientConfig)
 
 	return &Client{
-		client: client,
-		model:  openai.SmallEmbedding3, // text-embedding-3-small (1536 dimensions)
+		client:     client,
+		model:      cfg.Model,
+		dimensions: cfg.Dimensions,
+		normalize:  cfg.Normalize,
+		limiter:    cfg.Limiter,
 	}, nil
 }
 
+// Model returns the embedding model used by the client
+func (c *Client) Model() openai.EmbeddingModel {
+	return c.model
+}
+
+// Dimensions returns the length of the vectors the client produces,
+// or 0 if the model's default dimension is unknown
+func (c *Client) Dimensions() int {
+	return Config{Model: c.model, Dimensions: c.dimensions}.OutputDimensions()
+}
+
 // GenerateEmbedding generates an embedding for a single text string
 func (c *Client) GenerateEmbedding(ctx context.Context, text string) ([]float32, error) {
 	if text == "" {
 		return nil, fmt.Errorf("text cannot be empty")
 	}
 
+	ctx, span := tracing.Start(ctx, "embeddings.create", attribute.String("gen_ai.request.model", string(c.model)))
+	defer span.End()
+
 	// Create embedding request with retry logic
 	var resp openai.EmbeddingResponse
 	var err error
 
+	estimated := EstimateTokens(text)
+	reqCtx := ratelimit.WithTokens(ctx, estimated)
+
 	maxRetries := 3
 	for attempt := 1; attempt <= maxRetries; attempt++ {
-		resp, err = c.client.CreateEmbeddings(ctx, openai.EmbeddingRequest{
-			Input: []string{text},
-			Model: c.model,
+		resp, err = c.client.CreateEmbeddings(reqCtx, openai.EmbeddingRequest{
+			Input:      []string{text},
+			Model:      c.model,
+			Dimensions: c.dimensions,
 		})
 
-		if err == nil {
+		// Stop on success, or fail fast while the breaker is open
+		if err == nil || errors.Is(err, circuitbreaker.ErrOpen) {
 			break
 		}
 
 		if attempt < maxRetries {
-			waitTime := time.Duration(attempt) * time.Second
+			waitTime := c.retryDelay(attempt, err)
 			log.Printf("⚠️  Embedding request failed (attempt %d/%d), retrying in %v: %v",
 				attempt, maxRetries, waitTime, err)
 			time.Sleep(waitTime)
 		}
 	}
 
+	c.observe(span, resp, err)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create embedding after %d attempts: %w", maxRetries, err)
+		return nil, fmt.Errorf("failed to create embedding: %w", err)
 	}
+	c.limiter.Report(estimated, resp.Usage.TotalTokens)
 
 	if len(resp.Data) == 0 {
 		return nil, fmt.Errorf("no embedding data returned from API")
 	}
 
-	return resp.Data[0].Embedding, nil
+	return c.postProcess(resp.Data[0].Embedding)
 }
 
 // GenerateEmbeddingsBatch generates embeddings for multiple texts in a single API call
@@ -106,32 +165,48 @@ func (c *Client) GenerateEmbeddingsBatch(ctx context.Context, texts []string) ([
 		return nil, fmt.Errorf("no valid texts to embed")
 	}
 
+	ctx, span := tracing.Start(ctx, "embeddings.create",
+		attribute.String("gen_ai.request.model", string(c.model)),
+		attribute.Int("embeddings.inputs", len(validTexts)),
+	)
+	defer span.End()
+
 	// Create embedding request with retry logic
 	var resp openai.EmbeddingResponse
 	var err error
 
+	estimated := 0
+	for _, text := range validTexts {
+		estimated += EstimateTokens(text)
+	}
+	reqCtx := ratelimit.WithTokens(ctx, estimated)
+
 	maxRetries := 3
 	for attempt := 1; attempt <= maxRetries; attempt++ {
-		resp, err = c.client.CreateEmbeddings(ctx, openai.EmbeddingRequest{
-			Input: validTexts,
-			Model: c.model,
+		resp, err = c.client.CreateEmbeddings(reqCtx, openai.EmbeddingRequest{
+			Input:      validTexts,
+			Model:      c.model,
+			Dimensions: c.dimensions,
 		})
 
-		if err == nil {
+		// Stop on success, or fail fast while the breaker is open
+		if err == nil || errors.Is(err, circuitbreaker.ErrOpen) {
 			break
 		}
 
 		if attempt < maxRetries {
-			waitTime := time.Duration(attempt) * time.Second
+			waitTime := c.retryDelay(attempt, err)
 			log.Printf("⚠️  Batch embedding request failed (attempt %d/%d), retrying in %v: %v",
 				attempt, maxRetries, waitTime, err)
 			time.Sleep(waitTime)
 		}
 	}
 
+	c.observe(span, resp, err)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create batch embeddings after %d attempts: %w", maxRetries, err)
+		return nil, fmt.Errorf("failed to create batch embeddings: %w", err)
 	}
+	c.limiter.Report(estimated, resp.Usage.TotalTokens)
 
 	if len(resp.Data) != len(validTexts) {
 		return nil, fmt.Errorf("expected %d embeddings, got %d", len(validTexts), len(resp.Data))
@@ -140,10 +215,11 @@ func (c *Client) GenerateEmbeddingsBatch(ctx context.Context, texts []string) ([
 	// Build results array maintaining original order
 	results := make([]EmbeddingResult, len(texts))
 	for i, validIdx := range textIndices {
+		embedding, err := c.postProcess(resp.Data[i].Embedding)
 		results[validIdx] = EmbeddingResult{
 			Text:      texts[validIdx],
-			Embedding: resp.Data[i].Embedding,
-			Error:     nil,
+			Embedding: embedding,
+			Error:     err,
 		}
 	}
 
@@ -161,11 +237,61 @@ func (c *Client) GenerateEmbeddingsBatch(ctx context.Context, texts []string) ([
 	return results, nil
 }
 
+// retryDelay returns how long to sleep before the next attempt. Rate-limited
+// requests are not slept on when a limiter is set: it already holds the next
+// attempt until the provider's Retry-After has passed.
+func (c *Client) retryDelay(attempt int, err error) time.Duration {
+	var apiErr *openai.APIError
+	if c.limiter != nil && errors.As(err, &apiErr) && apiErr.HTTPStatusCode == http.StatusTooManyRequests {
+		return 0
+	}
+	return time.Duration(attempt) * time.Second
+}
+
+// postProcess checks the returned dimension and applies normalization
+func (c *Client) postProcess(embedding []float32) ([]float32, error) {
+	if want := c.Dimensions(); want > 0 && len(embedding) != want {
+		return nil, fmt.Errorf("expected %d-dimensional embedding, got %d", want, len(embedding))
+	}
+	if c.normalize {
+		Normalize(embedding)
+	}
+	return embedding, nil
+}
+
+// observe records the call and the tokens it was billed for in the metrics and span
+func (c *Client) observe(span trace.Span, resp openai.EmbeddingResponse, err error) {
+	tokens := resp.Usage.TotalTokens
+	metrics.ObserveEmbedding(string(c.model), tokens, EstimateModelCost(c.model, tokens), err)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	span.SetAttributes(attribute.Int("gen_ai.usage.input_tokens", tokens))
+}
+
 // EstimateCost estimates the cost of embedding a given number of tokens
 // Based on OpenAI's pricing: text-embedding-3-small costs $0.020 per 1M tokens
 func EstimateCost(numTokens int) float64 {
-	costPer1MTokens := 0.020 // USD
-	return (float64(numTokens) / 1000000.0) * costPer1MTokens
+	return EstimateModelCost(openai.SmallEmbedding3, numTokens)
+}
+
+// costPer1MTokens is OpenAI's list price in USD per model
+var costPer1MTokens = map[openai.EmbeddingModel]float64{
+	openai.SmallEmbedding3: 0.020,
+	openai.LargeEmbedding3: 0.130,
+	openai.AdaEmbeddingV2:  0.100,
+}
+
+// EstimateModelCost estimates the cost of embedding numTokens with model.
+// Unknown models are priced like text-embedding-3-small.
+func EstimateModelCost(model openai.EmbeddingModel, numTokens int) float64 {
+	price, ok := costPer1MTokens[model]
+	if !ok {
+		price = costPer1MTokens[openai.SmallEmbedding3]
+	}
+	return (float64(numTokens) / 1000000.0) * price
 }
 
 // EstimateTokens roughly estimates the number of tokens in a text
@@ -0,0 +1,193 @@
+package embeddings
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Quantized vectors trade a little precision for storage size:
+//   - Float16Vector: 2 bytes per dimension (half of float32), near-lossless for embeddings
+//   - Int8Vector:    1 byte per dimension plus a scale (a quarter of float32)
+//
+// Quantize before writing to storage and dequantize (Float32) before computing distances.
+
+// Float16Vector is an embedding stored as IEEE 754 half-precision values.
+type Float16Vector []uint16
+
+// QuantizeFloat16 converts a float32 vector to half precision (round to nearest even).
+func QuantizeFloat16(v []float32) Float16Vector {
+	out := make(Float16Vector, len(v))
+	for i, x := range v {
+		out[i] = float32ToFloat16(x)
+	}
+	return out
+}
+
+// Float32 dequantizes the vector back to float32.
+func (v Float16Vector) Float32() []float32 {
+	out := make([]float32, len(v))
+	for i, h := range v {
+		out[i] = float16ToFloat32(h)
+	}
+	return out
+}
+
+// MarshalBinary encodes the vector as little-endian half-precision values.
+func (v Float16Vector) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 2*len(v))
+	for i, h := range v {
+		binary.LittleEndian.PutUint16(buf[2*i:], h)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a vector written by MarshalBinary.
+func (v *Float16Vector) UnmarshalBinary(data []byte) error {
+	if len(data)%2 != 0 {
+		return fmt.Errorf("float16 data has odd length %d", len(data))
+	}
+	out := make(Float16Vector, len(data)/2)
+	for i := range out {
+		out[i] = binary.LittleEndian.Uint16(data[2*i:])
+	}
+	*v = out
+	return nil
+}
+
+// Int8Vector is an embedding stored as symmetric int8 values with one scale factor:
+// value[i] ≈ Values[i] * Scale.
+type Int8Vector struct {
+	Values []int8
+	Scale  float32
+}
+
+// QuantizeInt8 maps v onto [-127, 127] using the largest finite absolute component
+// as the scale. ±Inf clamps to ±127 and NaN becomes 0.
+func QuantizeInt8(v []float32) Int8Vector {
+	var maxAbs float32
+	for _, x := range v {
+		if a := float32(math.Abs(float64(x))); a > maxAbs && !math.IsInf(float64(a), 1) {
+			maxAbs = a
+		}
+	}
+
+	q := Int8Vector{Values: make([]int8, len(v))}
+	if maxAbs == 0 {
+		return q
+	}
+
+	q.Scale = maxAbs / 127
+	for i, x := range v {
+		r := math.Round(float64(x / q.Scale))
+		if math.IsNaN(r) {
+			continue
+		}
+		q.Values[i] = int8(math.Max(-127, math.Min(127, r)))
+	}
+	return q
+}
+
+// Float32 dequantizes the vector back to float32.
+func (q Int8Vector) Float32() []float32 {
+	out := make([]float32, len(q.Values))
+	for i, x := range q.Values {
+		out[i] = float32(x) * q.Scale
+	}
+	return out
+}
+
+// MarshalBinary encodes the scale (little-endian float32) followed by the values.
+func (q Int8Vector) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 4+len(q.Values))
+	binary.LittleEndian.PutUint32(buf, math.Float32bits(q.Scale))
+	for i, x := range q.Values {
+		buf[4+i] = byte(x)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a vector written by MarshalBinary.
+func (q *Int8Vector) UnmarshalBinary(data []byte) error {
+	if len(data) < 4 {
+		return fmt.Errorf("int8 data too short: %d bytes", len(data))
+	}
+	q.Scale = math.Float32frombits(binary.LittleEndian.Uint32(data))
+	q.Values = make([]int8, len(data)-4)
+	for i := range q.Values {
+		q.Values[i] = int8(data[4+i])
+	}
+	return nil
+}
+
+// float32ToFloat16 converts with round-to-nearest-even, handling subnormals, infinities and NaN.
+func float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	// Infinity or NaN
+	if exp == 0xff {
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+
+	e := exp - 127 + 15
+
+	// Overflow: round to infinity
+	if e >= 0x1f {
+		return sign | 0x7c00
+	}
+
+	// Subnormal half (or underflow to zero)
+	if e <= 0 {
+		if e < -10 {
+			return sign
+		}
+		mant |= 0x800000
+		shift := uint32(14 - e)
+		half := mant >> shift
+		rem := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rem > halfway || (rem == halfway && half&1 == 1) {
+			half++
+		}
+		return sign | uint16(half)
+	}
+
+	half := uint32(sign) | uint32(e)<<10 | mant>>13
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		half++ // May carry into the exponent, which is the correct rounding
+	}
+	return uint16(half)
+}
+
+// float16ToFloat32 converts a half-precision value to float32 exactly.
+func float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := int32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch exp {
+	case 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// Subnormal: normalize the mantissa
+		e := int32(-14)
+		for mant&0x400 == 0 {
+			mant <<= 1
+			e--
+		}
+		mant &= 0x3ff
+		return math.Float32frombits(sign | uint32(e+127)<<23 | mant<<13)
+	case 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	default:
+		return math.Float32frombits(sign | uint32(exp-15+127)<<23 | mant<<13)
+	}
+}
@@ -0,0 +1,161 @@
+package embeddings
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestFloat16RoundTripsEveryHalfValue(t *testing.T) {
+	for h := 0; h <= 0xffff; h++ {
+		f := float16ToFloat32(uint16(h))
+		got := float32ToFloat16(f)
+		if isHalfNaN(uint16(h)) {
+			if !isHalfNaN(got) || got&0x8000 != uint16(h)&0x8000 {
+				t.Fatalf("NaN %#04x came back as %#04x", h, got)
+			}
+			continue
+		}
+		if got != uint16(h) {
+			t.Fatalf("%#04x -> %g -> %#04x", h, f, got)
+		}
+	}
+}
+
+func TestFloat32ToFloat16(t *testing.T) {
+	tests := []struct {
+		name string
+		in   float32
+		want uint16
+	}{
+		{"zero", 0, 0x0000},
+		{"negative zero", float32(math.Copysign(0, -1)), 0x8000},
+		{"one", 1, 0x3c00},
+		{"tie rounds to even", 1 + 1.0/2048, 0x3c00},
+		{"tie rounds up to even", 1 + 3.0/2048, 0x3c02},
+		{"above tie rounds up", 1 + 1.0/2048 + 1.0/65536, 0x3c01},
+
+		// Subnormal halves
+		{"smallest subnormal", 1.0 / (1 << 24), 0x0001},
+		{"largest subnormal", 1023.0 / (1 << 24), 0x03ff},
+		{"negative subnormal", -5.0 / (1 << 24), 0x8005},
+		{"half of smallest subnormal ties to zero", 1.0 / (1 << 25), 0x0000},
+		{"above half of smallest subnormal", 1.5 / (1 << 25), 0x0001},
+		{"subnormal rounds into normal", 1023.75 / (1 << 24), 0x0400},
+		{"float32 subnormal underflows", math.SmallestNonzeroFloat32, 0x0000},
+		{"negative underflow keeps sign", -math.SmallestNonzeroFloat32, 0x8000},
+
+		// Clamping at the top of the range
+		{"largest half", 65504, 0x7bff},
+		{"rounds down to largest half", 65519, 0x7bff},
+		{"rounds up to infinity", 65520, 0x7c00},
+		{"overflow", 1e10, 0x7c00},
+		{"negative overflow", -math.MaxFloat32, 0xfc00},
+
+		{"+Inf", float32(math.Inf(1)), 0x7c00},
+		{"-Inf", float32(math.Inf(-1)), 0xfc00},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := float32ToFloat16(tt.in); got != tt.want {
+				t.Errorf("float32ToFloat16(%g) = %#04x, want %#04x", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFloat16NaN(t *testing.T) {
+	v := QuantizeFloat16([]float32{float32(math.NaN()), -float32(math.NaN())})
+	for i, f := range v.Float32() {
+		if !math.IsNaN(float64(f)) {
+			t.Errorf("value %d: NaN dequantized to %g", i, f)
+		}
+	}
+}
+
+func TestFloat16VectorBinaryRoundTrip(t *testing.T) {
+	in := []float32{0, -1.5, 3.140625, 1.0 / (1 << 24), float32(math.Inf(-1)), 65504}
+	v := QuantizeFloat16(in)
+
+	data, err := v.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Float16Vector
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	// Every input is exactly representable in half precision
+	if !reflect.DeepEqual(got.Float32(), in) {
+		t.Errorf("round trip = %v, want %v", got.Float32(), in)
+	}
+
+	if err := got.UnmarshalBinary(data[:3]); err == nil {
+		t.Error("expected an error for odd-length data")
+	}
+}
+
+func TestQuantizeInt8(t *testing.T) {
+	in := []float32{0.5, -1, 0.25, 0.003, -0.999}
+	q := QuantizeInt8(in)
+
+	if q.Scale != 1.0/127 {
+		t.Errorf("scale = %g, want %g", q.Scale, 1.0/127)
+	}
+	for i, f := range q.Float32() {
+		if diff := math.Abs(float64(f - in[i])); diff > float64(q.Scale)/2+1e-7 {
+			t.Errorf("value %d: %g dequantized to %g (error %g > scale/2)", i, in[i], f, diff)
+		}
+	}
+	if q.Values[1] != -127 {
+		t.Errorf("largest component quantized to %d, want -127", q.Values[1])
+	}
+}
+
+func TestQuantizeInt8NonFinite(t *testing.T) {
+	inf := float32(math.Inf(1))
+	q := QuantizeInt8([]float32{2, -1, inf, -inf, float32(math.NaN())})
+
+	// The scale comes from the finite values; infinities clamp and NaN becomes 0
+	if q.Scale != 2.0/127 {
+		t.Errorf("scale = %g, want %g", q.Scale, 2.0/127)
+	}
+	want := []int8{127, -64, 127, -127, 0}
+	if !reflect.DeepEqual(q.Values, want) {
+		t.Errorf("values = %v, want %v", q.Values, want)
+	}
+}
+
+func TestQuantizeInt8Zero(t *testing.T) {
+	q := QuantizeInt8([]float32{0, 0, 0})
+	if q.Scale != 0 || !reflect.DeepEqual(q.Values, []int8{0, 0, 0}) {
+		t.Errorf("zero vector quantized to %+v", q)
+	}
+	if got := q.Float32(); !reflect.DeepEqual(got, []float32{0, 0, 0}) {
+		t.Errorf("zero vector dequantized to %v", got)
+	}
+}
+
+func TestInt8VectorBinaryRoundTrip(t *testing.T) {
+	q := QuantizeInt8([]float32{0.1, -0.7, 0.35, 1e-30})
+
+	data, err := q.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Int8Vector
+	if err := got.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, q) {
+		t.Errorf("round trip = %+v, want %+v", got, q)
+	}
+
+	if err := got.UnmarshalBinary(data[:3]); err == nil {
+		t.Error("expected an error for data shorter than the scale")
+	}
+}
+
+func isHalfNaN(h uint16) bool {
+	return h&0x7c00 == 0x7c00 && h&0x03ff != 0
+}
@@ -0,0 +1,37 @@
+package embeddings
+
+import (
+	"fmt"
+	"math"
+)
+
+// Normalize scales v to unit (L2) length in place and returns it.
+// Zero vectors are returned unchanged.
+func Normalize(v []float32) []float32 {
+	var sum float64
+	for _, x := range v {
+		sum += float64(x) * float64(x)
+	}
+	if sum == 0 {
+		return v
+	}
+
+	inv := float32(1 / math.Sqrt(sum))
+	for i := range v {
+		v[i] *= inv
+	}
+	return v
+}
+
+// Reduce shortens an embedding to dims dimensions and re-normalizes it.
+// text-embedding-3 vectors can be shortened this way, which is equivalent to
+// requesting fewer dimensions from the API; use it to shrink vectors already stored.
+// The input is not modified.
+func Reduce(v []float32, dims int) ([]float32, error) {
+	if dims <= 0 || dims > len(v) {
+		return nil, fmt.Errorf("cannot reduce %d-dimensional vector to %d dimensions", len(v), dims)
+	}
+	out := make([]float32, dims)
+	copy(out, v[:dims])
+	return Normalize(out), nil
+}
@@ -0,0 +1,47 @@
+package frontend
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"sync/atomic"
+)
+
+// DevServerFromEnv reads FRONTEND_DEV_SERVER, the URL of the Vite dev server
+// (e.g. http://localhost:5173). It is empty in production.
+func DevServerFromEnv() string {
+	return os.Getenv("FRONTEND_DEV_SERVER")
+}
+
+// devProxy forwards frontend requests, including the HMR websocket, to the dev
+// server. While the dev server is unreachable the embedded bundle is served.
+func devProxy(devServer string, fallback http.Handler) (http.Handler, error) {
+	target, err := url.Parse(devServer)
+	if err != nil || target.Scheme == "" || target.Host == "" {
+		return nil, fmt.Errorf("invalid FRONTEND_DEV_SERVER %q: want a URL like http://localhost:5173", devServer)
+	}
+
+	var down atomic.Bool // Log once per outage, not once per request
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.SetURL(target)
+			pr.SetXForwarded()
+		},
+		ModifyResponse: func(*http.Response) error {
+			if down.Swap(false) {
+				log.Printf("✅ Vite dev server at %s is back", target)
+			}
+			return nil
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			if !down.Swap(true) {
+				log.Printf("⚠️  Vite dev server at %s unreachable, serving the embedded frontend: %v", target, err)
+			}
+			fallback.ServeHTTP(w, r)
+		},
+	}
+	return proxy, nil
+}
@@ -37,4 +37,4 @@
 `+l[o].replace(" at new "," at ");return e.displayName&&s.includes("<anonymous>")&&(s=s.replace("<anonymous>",e.displayName)),s}while(1<=o&&0<=i);break}}}finally{kl=!1,Error.prepareStackTrace=n}return(e=e?e.displayName||e.name:"")?gn(e):""}function Rc(e){switch(e.tag){case 5:return gn(e.type);case 16:return gn("Lazy");case 13:return gn("Suspense");case 19:return gn("SuspenseList");case 0:case 2:case 15:return e=El(e.type,!1),e;case 11:return e=El(e.type.render,!1),e;case 1:return e=El(e.type,!0),e;default:return""}}function Zl(e){if(e==null)return null;if(typeof e=="function")return e.displayName||e.name||null;if(typeof e=="string")return e;switch(e){case Mt:return"Fragment";case Ot:return"Portal";case Yl:return"Profiler";case Qu:return"StrictMode";case Xl:return"Suspense";case Gl:return"SuspenseList"}if(typeof e=="object")switch(e.$$typeof){case us:return(e.displayName||"Context")+".Consumer";case ls:return(e._context.displayName||"Context")+".Provider";case Ku:var t=e.render;return e=e.displayName,e||(e=t.displayName||t.name||"",e=e!==""?"ForwardRef("+e+")":"ForwardRef"),e;case Yu:return t=e.displayName||null,t!==null?t:Zl(e.type)||"Memo";case Ze:t=e._payload,e=e._init;try{return Zl(e(t))}catch{}}return null}function Oc(e){var t=e.type;switch(e.tag){case 24:return"Cache";case 9:return(t.displayName||"Context")+".Consumer";case 10:return(t._context.displayName||"Context")+".Provider";case 18:return"DehydratedFragment";case 11:return e=t.render,e=e.displayName||e.name||"",t.displayName||(e!==""?"ForwardRef("+e+")":"ForwardRef");case 7:return"Fragment";case 5:return t;case 4:return"Portal";case 3:return"Root";case 6:return"Text";case 16:return Zl(t);case 8:return t===Qu?"StrictMode":"Mode";case 22:return"Offscreen";case 12:return"Profiler";case 21:return"Scope";case 13:return"Suspense";case 19:return"SuspenseList";case 25:return"TracingMarker";case 1:case 0:case 17:case 2:case 14:case 15:if(typeof t=="function")return t.displayName||t.name||null;if(typeof t=="string")return t}return null}function ct(e){switch(typeof e){case"boolean":case"number":case"string":case"undefined":return e;case"object":return e;default:return""}}function is(e){var t=e.type;return(e=e.nodeName)&&e.toLowerCase()==="input"&&(t==="checkbox"||t==="radio")}function Mc(e){var t=is(e)?"checked":"value",n=Object.getOwnPropertyDescriptor(e.constructor.prototype,t),r=""+e[t];if(!e.hasOwnProperty(t)&&typeof n<"u"&&typeof n.get=="function"&&typeof n.set=="function"){var l=n.get,u=n.set;return Object.defineProperty(e,t,{configurable:!0,get:function(){return l.call(this)},set:function(o){r=""+o,u.call(this,o)}}),Object.defineProperty(e,t,{enumerable:n.enumerable}),{getValue:function(){return r},setValue:function(o){r=""+o},stopTracking:function(){e._valueTracker=null,delete e[t]}}}}function rr(e){e._valueTracker||(e._valueTracker=Mc(e))}function ss(e){if(!e)return!1;var t=e._valueTracker;if(!t)return!0;var n=t.getValue(),r="";return e&&(r=is(e)?e.checked?"true":"false":e.value),e=r,e!==n?(t.setValue(e),!0):!1}function Tr(e){if(e=e||(typeof document<"u"?document:void 0),typeof e>"u")return null;try{return e.activeElement||e.body}catch{return e.body}}function Jl(e,t){var n=t.checked;return V({},t,{defaultChecked:void 0,defaultValue:void 0,value:void 0,checked:n??e._wrapperState.initialChecked})}function Vo(e,t){var n=t.defaultValue==null?"":t.defaultValue,r=t.checked!=null?t.checked:t.defaultChecked;n=ct(t.value!=null?t.value:n),e._wrapperState={initialChecked:r,initialValue:n,controlled:t.type==="checkbox"||t.type==="radio"?t.checked!=null:t.value!=null}}function as(e,t){t=t.checked,t!=null&&Wu(e,"checked",t,!1)}function ql(e,t){as(e,t);var n=ct(t.value),r=t.type;if(n!=null)r==="number"?(n===0&&e.value===""||e.value!=n)&&(e.value=""+n):e.value!==""+n&&(e.value=""+n);else if(r==="submit"||r==="reset"){e.removeAttribute("value");return}t.hasOwnProperty("value")?bl(e,t.type,n):t.hasOwnProperty("defaultValue")&&bl(e,t.type,ct(t.defaultValue)),t.checked==null&&t.defaultChecked!=null&&(e.defaultChecked=!!t.defaultChecked)}function $o(e,t,n){if(t.hasOwnProperty("value")||t.hasOwnProperty("defaultValue")){var r=t.type;if(!(r!=="submit"&&r!=="reset"||t.value!==void 0&&t.value!==null))return;t=""+e._wrapperState.initialValue,n||t===e.value||(e.value=t),e.defaultValue=t}n=e.name,n!==""&&(e.name=""),e.defaultChecked=!!e._wrapperState.initialChecked,n!==""&&(e.name=n)}function bl(e,t,n){(t!=="number"||Tr(e.ownerDocument)!==e)&&(n==null?e.defaultValue=""+e._wrapperState.initialValue:e.defaultValue!==""+n&&(e.defaultValue=""+n))}var wn=Array.isArray;function Qt(e,t,n,r){if(e=e.options,t){t={};for(var l=0;l<n.length;l++)t["$"+n[l]]=!0;for(n=0;n<e.length;n++)l=t.hasOwnProperty("$"+e[n].value),e[n].selected!==l&&(e[n].selected=l),l&&r&&(e[n].defaultSelected=!0)}else{for(n=""+ct(n),t=null,l=0;l<e.length;l++){if(e[l].value===n){e[l].selected=!0,r&&(e[l].defaultSelected=!0);return}t!==null||e[l].disabled||(t=e[l])}t!==null&&(t.selected=!0)}}function eu(e,t){if(t.dangerouslySetInnerHTML!=null)throw Error(y(91));return V({},t,{value:void 0,defaultValue:void 0,children:""+e._wrapperState.initialValue})}function Bo(e,t){var n=t.value;if(n==null){if(n=t.children,t=t.defaultValue,n!=null){if(t!=null)throw Error(y(92));if(wn(n)){if(1<n.length)throw Error(y(93));n=n[0]}t=n}t==null&&(t=""),n=t}e._wrapperState={initialValue:ct(n)}}function cs(e,t){var n=ct(t.value),r=ct(t.defaultValue);n!=null&&(n=""+n,n!==e.value&&(e.value=n),t.defaultValue==null&&e.defaultValue!==n&&(e.defaultValue=n)),r!=null&&(e.defaultValue=""+r)}function Ho(e){var t=e.textContent;t===e._wrapperState.initialValue&&t!==""&&t!==null&&(e.value=t)}function fs(e){switch(e){case"svg":return"http://www.w3.org/2000/svg";case"math":return"http://www.w3.org/1998/Math/MathML";default:return"http://www.w3.org/1999/xhtml"}}function tu(e,t){return e==null||e==="http://www.w3.org/1999/xhtml"?fs(t):e==="http://www.w3.org/2000/svg"&&t==="foreignObject"?"http://www.w3.org/1999/xhtml":e}var lr,ds=function(e){return typeof MSApp<"u"&&MSApp.execUnsafeLocalFunction?function(t,n,r,l){MSApp.execUnsafeLocalFunction(function(){return e(t,n,r,l)})}:e}(function(e,t){if(e.namespaceURI!=="http://www.w3.org/2000/svg"||"innerHTML"in e)e.innerHTML=t;else{for(lr=lr||document.createElement("div"),lr.innerHTML="<svg>"+t.valueOf().toString()+"</svg>",t=lr.firstChild;e.firstChild;)e.removeChild(e.firstChild);for(;t.firstChild;)e.appendChild(t.firstChild)}});function On(e,t){if(t){var n=e.firstChild;if(n&&n===e.lastChild&&n.nodeType===3){n.nodeValue=t;return}}e.textContent=t}var En={animationIterationCount:!0,aspectRatio:!0,borderImageOutset:!0,borderImageSlice:!0,borderImageWidth:!0,boxFlex:!0,boxFlexGroup:!0,boxOrdinalGroup:!0,columnCount:!0,columns:!0,flex:!0,flexGrow:!0,flexPositive:!0,flexShrink:!0,flexNegative:!0,flexOrder:!0,gridArea:!0,gridRow:!0,gridRowEnd:!0,gridRowSpan:!0,gridRowStart:!0,gridColumn:!0,gridColumnEnd:!0,gridColumnSpan:!0,gridColumnStart:!0,fontWeight:!0,lineClamp:!0,lineHeight:!0,opacity:!0,order:!0,orphans:!0,tabSize:!0,widows:!0,zIndex:!0,zoom:!0,fillOpacity:!0,floodOpacity:!0,stopOpacity:!0,strokeDasharray:!0,strokeDashoffset:!0,strokeMiterlimit:!0,strokeOpacity:!0,strokeWidth:!0},Dc=["Webkit","ms","Moz","O"];Object.keys(En).forEach(function(e){Dc.forEach(function(t){t=t+e.charAt(0).toUpperCase()+e.substring(1),En[t]=En[e]})});function ps(e,t,n){return t==null||typeof t=="boolean"||t===""?"":n||typeof t!="number"||t===0||En.hasOwnProperty(e)&&En[e]?(""+t).trim():t+"px"}function ms(e,t){e=e.style;for(var n in t)if(t.hasOwnProperty(n)){var r=n.indexOf("--")===0,l=ps(n,t[n],r);n==="float"&&(n="cssFloat"),r?e.setProperty(n,l):e[n]=l}}var jc=V({menuitem:!0},{area:!0,base:!0,br:!0,col:!0,embed:!0,hr:!0,img:!0,input:!0,keygen:!0,link:!0,meta:!0,param:!0,source:!0,track:!0,wbr:!0});function nu(e,t){if(t){if(jc[e]&&(t.children!=null||t.dangerouslySetInnerHTML!=null))throw Error(y(137,e));if(t.dangerouslySetInnerHTML!=null){if(t.children!=null)throw Error(y(60));if(typeof t.dangerouslySetInnerHTML!="object"||!("__html"in t.dangerouslySetInnerHTML))throw Error(y(61))}if(t.style!=null&&typeof t.style!="object")throw Error(y(62))}}function ru(e,t){if(e.indexOf("-")===-1)return typeof t.is=="string";switch(e){case"annotation-xml":case"color-profile":case"font-face":case"font-face-src":case"font-face-uri":case"font-face-format":case"font-face-name":case"missing-glyph":return!1;default:return!0}}var lu=null;function Xu(e){return e=e.target||e.srcElement||window,e.correspondingUseElement&&(e=e.correspondingUseElement),e.nodeType===3?e.parentNode:e}var uu=null,Kt=null,Yt=null;function Wo(e){if(e=Jn(e)){if(typeof uu!="function")throw Error(y(280));var t=e.stateNode;t&&(t=ul(t),uu(e.stateNode,e.type,t))}}function hs(e){Kt?Yt?Yt.push(e):Yt=[e]:Kt=e}function vs(){if(Kt){var e=Kt,t=Yt;if(Yt=Kt=null,Wo(e),t)for(e=0;e<t.length;e++)Wo(t[e])}}function ys(e,t){return e(t)}function gs(){}var Cl=!1;function ws(e,t,n){if(Cl)return e(t,n);Cl=!0;try{return ys(e,t,n)}finally{Cl=!1,(Kt!==null||Yt!==null)&&(gs(),vs())}}function Mn(e,t){var n=e.stateNode;if(n===null)return null;var r=ul(n);if(r===null)return null;n=r[t];e:switch(t){case"onClick":case"onClickCapture":case"onDoubleClick":case"onDoubleClickCapture":case"onMouseDown":case"onMouseDownCapture":case"onMouseMove":case"onMouseMoveCapture":case"onMouseUp":case"onMouseUpCapture":case"onMouseEnter":(r=!r.disabled)||(e=e.type,r=!(e==="button"||e==="input"||e==="select"||e==="textarea")),e=!r;break e;default:e=!1}if(e)return null;if(n&&typeof n!="function")throw Error(y(231,t,typeof n));return n}var ou=!1;if(We)try{var fn={};Object.defineProperty(fn,"passive",{get:function(){ou=!0}}),window.addEventListener("test",fn,fn),window.removeEventListener("test",fn,fn)}catch{ou=!1}function Ic(e,t,n,r,l,u,o,i,s){var c=Array.prototype.slice.call(arguments,3);try{t.apply(n,c)}catch(h){this.onError(h)}}var Cn=!1,Rr=null,Or=!1,iu=null,Fc={onError:function(e){Cn=!0,Rr=e}};function Uc(e,t,n,r,l,u,o,i,s){Cn=!1,Rr=null,Ic.apply(Fc,arguments)}function Ac(e,t,n,r,l,u,o,i,s){if(Uc.apply(this,arguments),Cn){if(Cn){var c=Rr;Cn=!1,Rr=null}else throw Error(y(198));Or||(Or=!0,iu=c)}}function Tt(e){var t=e,n=e;if(e.alternate)for(;t.return;)t=t.return;else{e=t;do t=e,t.flags&4098&&(n=t.return),e=t.return;while(e)}return t.tag===3?n:null}function Ss(e){if(e.tag===13){var t=e.memoizedState;if(t===null&&(e=e.alternate,e!==null&&(t=e.memoizedState)),t!==null)return t.dehydrated}return null}function Qo(e){if(Tt(e)!==e)throw Error(y(188))}function Vc(e){var t=e.alternate;if(!t){if(t=Tt(e),t===null)throw Error(y(188));return t!==e?null:e}for(var n=e,r=t;;){var l=n.return;if(l===null)break;var u=l.alternate;if(u===null){if(r=l.return,r!==null){n=r;continue}break}if(l.child===u.child){for(u=l.child;u;){if(u===n)return Qo(l),e;if(u===r)return Qo(l),t;u=u.sibling}throw Error(y(188))}if(n.return!==r.return)n=l,r=u;else{for(var o=!1,i=l.child;i;){if(i===n){o=!0,n=l,r=u;break}if(i===r){o=!0,r=l,n=u;break}i=i.sibling}if(!o){for(i=u.child;i;){if(i===n){o=!0,n=u,r=l;break}if(i===r){o=!0,r=u,n=l;break}i=i.sibling}if(!o)throw Error(y(189))}}if(n.alternate!==r)throw Error(y(190))}if(n.tag!==3)throw Error(y(188));return n.stateNode.current===n?e:t}function ks(e){return e=Vc(e),e!==null?Es(e):null}function Es(e){if(e.tag===5||e.tag===6)return e;for(e=e.child;e!==null;){var t=Es(e);if(t!==null)return t;e=e.sibling}return null}var Cs=ye.unstable_scheduleCallback,Ko=ye.unstable_cancelCallback,$c=ye.unstable_shouldYield,Bc=ye.unstable_requestPaint,W=ye.unstable_now,Hc=ye.unstable_getCurrentPriorityLevel,Gu=ye.unstable_ImmediatePriority,_s=ye.unstable_UserBlockingPriority,Mr=ye.unstable_NormalPriority,Wc=ye.unstable_LowPriority,xs=ye.unstable_IdlePriority,tl=null,Fe=null;function Qc(e){if(Fe&&typeof Fe.onCommitFiberRoot=="function")try{Fe.onCommitFiberRoot(tl,e,void 0,(e.current.flags&128)===128)}catch{}}var Re=Math.clz32?Math.clz32:Xc,Kc=Math.log,Yc=Math.LN2;function Xc(e){return e>>>=0,e===0?32:31-(Kc(e)/Yc|0)|0}var ur=64,or=4194304;function Sn(e){switch(e&-e){case 1:return 1;case 2:return 2;case 4:return 4;case 8:return 8;case 16:return 16;case 32:return 32;case 64:case 128:case 256:case 512:case 1024:case 2048:case 4096:case 8192:case 16384:case 32768:case 65536:case 131072:case 262144:case 524288:case 1048576:case 2097152:return e&4194240;case 4194304:case 8388608:case 16777216:case 33554432:case 67108864:return e&130023424;case 134217728:return 134217728;case 268435456:return 268435456;case 536870912:return 536870912;case 1073741824:return 1073741824;default:return e}}function Dr(e,t){var n=e.pendingLanes;if(n===0)return 0;var r=0,l=e.suspendedLanes,u=e.pingedLanes,o=n&268435455;if(o!==0){var i=o&~l;i!==0?r=Sn(i):(u&=o,u!==0&&(r=Sn(u)))}else o=n&~l,o!==0?r=Sn(o):u!==0&&(r=Sn(u));if(r===0)return 0;if(t!==0&&t!==r&&!(t&l)&&(l=r&-r,u=t&-t,l>=u||l===16&&(u&4194240)!==0))return t;if(r&4&&(r|=n&16),t=e.entangledLanes,t!==0)for(e=e.entanglements,t&=r;0<t;)n=31-Re(t),l=1<<n,r|=e[n],t&=~l;return r}function Gc(e,t){switch(e){case 1:case 2:case 4:return t+250;case 8:case 16:case 32:case 64:case 128:case 256:case 512:case 1024:case 2048:case 4096:case 8192:case 16384:case 32768:case 65536:case 131072:case 262144:case 524288:case 1048576:case 2097152:return t+5e3;case 4194304:case 8388608:case 16777216:case 33554432:case 67108864:return-1;case 134217728:case 268435456:case 536870912:case 1073741824:return-1;default:return-1}}function Zc(e,t){for(var n=e.suspendedLanes,r=e.pingedLanes,l=e.expirationTimes,u=e.pendingLanes;0<u;){var o=31-Re(u),i=1<<o,s=l[o];s===-1?(!(i&n)||i&r)&&(l[o]=Gc(i,t)):s<=t&&(e.expiredLanes|=i),u&=~i}}function su(e){return e=e.pendingLanes&-1073741825,e!==0?e:e&1073741824?1073741824:0}function Ns(){var e=ur;return ur<<=1,!(ur&4194240)&&(ur=64),e}function _l(e){for(var t=[],n=0;31>n;n++)t.push(e);return t}function Gn(e,t,n){e.pendingLanes|=t,t!==536870912&&(e.suspendedLanes=0,e.pingedLanes=0),e=e.eventTimes,t=31-Re(t),e[t]=n}function Jc(e,t){var n=e.pendingLanes&~t;e.pendingLanes=t,e.suspendedLanes=0,e.pingedLanes=0,e.expiredLanes&=t,e.mutableReadLanes&=t,e.entangledLanes&=t,t=e.entanglements;var r=e.eventTimes;for(e=e.expirationTimes;0<n;){var l=31-Re(n),u=1<<l;t[l]=0,r[l]=-1,e[l]=-1,n&=~u}}function Zu(e,t){var n=e.entangledLanes|=t;for(e=e.entanglements;n;){var r=31-Re(n),l=1<<r;l&t|e[r]&t&&(e[r]|=t),n&=~l}}var O=0;function Ps(e){return e&=-e,1<e?4<e?e&268435455?16:536870912:4:1}var zs,Ju,Ls,Ts,Rs,au=!1,ir=[],nt=null,rt=null,lt=null,Dn=new Map,jn=new Map,qe=[],qc="mousedown mouseup touchcancel touchend touchstart auxclick dblclick pointercancel pointerdown pointerup dragend dragstart drop compositionend compositionstart keydown keypress keyup input textInput copy cut paste click change contextmenu reset submit".split(" ");function Yo(e,t){switch(e){case"focusin":case"focusout":nt=null;break;case"dragenter":case"dragleave":rt=null;break;case"mouseover":case"mouseout":lt=null;break;case"pointerover":case"pointerout":Dn.delete(t.pointerId);break;case"gotpointercapture":case"lostpointercapture":jn.delete(t.pointerId)}}function dn(e,t,n,r,l,u){return e===null||e.nativeEvent!==u?(e={blockedOn:t,domEventName:n,eventSystemFlags:r,nativeEvent:u,targetContainers:[l]},t!==null&&(t=Jn(t),t!==null&&Ju(t)),e):(e.eventSystemFlags|=r,t=e.targetContainers,l!==null&&t.indexOf(l)===-1&&t.push(l),e)}function bc(e,t,n,r,l){switch(t){case"focusin":return nt=dn(nt,e,t,n,r,l),!0;case"dragenter":return rt=dn(rt,e,t,n,r,l),!0;case"mouseover":return lt=dn(lt,e,t,n,r,l),!0;case"pointerover":var u=l.pointerId;return Dn.set(u,dn(Dn.get(u)||null,e,t,n,r,l)),!0;case"gotpointercapture":return u=l.pointerId,jn.set(u,dn(jn.get(u)||null,e,t,n,r,l)),!0}return!1}function Os(e){var t=wt(e.target);if(t!==null){var n=Tt(t);if(n!==null){if(t=n.tag,t===13){if(t=Ss(n),t!==null){e.blockedOn=t,Rs(e.priority,function(){Ls(n)});return}}else if(t===3&&n.stateNode.current.memoizedState.isDehydrated){e.blockedOn=n.tag===3?n.stateNode.containerInfo:null;return}}}e.blockedOn=null}function Sr(e){if(e.blockedOn!==null)return!1;for(var t=e.targetContainers;0<t.length;){var n=cu(e.domEventName,e.eventSystemFlags,t[0],e.nativeEvent);if(n===null){n=e.nativeEvent;var r=new n.constructor(n.type,n);lu=r,n.target.dispatchEvent(r),lu=null}else return t=Jn(n),t!==null&&Ju(t),e.blockedOn=n,!1;t.shift()}return!0}function Xo(e,t,n){Sr(e)&&n.delete(t)}function ef(){au=!1,nt!==null&&Sr(nt)&&(nt=null),rt!==null&&Sr(rt)&&(rt=null),lt!==null&&Sr(lt)&&(lt=null),Dn.forEach(Xo),jn.forEach(Xo)}function pn(e,t){e.blockedOn===t&&(e.blockedOn=null,au||(au=!0,ye.unstable_scheduleCallback(ye.unstable_NormalPriority,ef)))}function In(e){function t(l){return pn(l,e)}if(0<ir.length){pn(ir[0],e);for(var n=1;n<ir.length;n++){var r=ir[n];r.blockedOn===e&&(r.blockedOn=null)}}for(nt!==null&&pn(nt,e),rt!==null&&pn(rt,e),lt!==null&&pn(lt,e),Dn.forEach(t),jn.forEach(t),n=0;n<qe.length;n++)r=qe[n],r.blockedOn===e&&(r.blockedOn=null);for(;0<qe.length&&(n=qe[0],n.blockedOn===null);)Os(n),n.blockedOn===null&&qe.shift()}var Xt=Xe.ReactCurrentBatchConfig,jr=!0;function tf(e,t,n,r){var l=O,u=Xt.transition;Xt.transition=null;try{O=1,qu(e,t,n,r)}finally{O=l,Xt.transition=u}}function nf(e,t,n,r){var l=O,u=Xt.transition;Xt.transition=null;try{O=4,qu(e,t,n,r)}finally{O=l,Xt.transition=u}}function qu(e,t,n,r){if(jr){var l=cu(e,t,n,r);if(l===null)Dl(e,t,r,Ir,n),Yo(e,r);else if(bc(l,e,t,n,r))r.stopPropagation();else if(Yo(e,r),t&4&&-1<qc.indexOf(e)){for(;l!==null;){var u=Jn(l);if(u!==null&&zs(u),u=cu(e,t,n,r),u===null&&Dl(e,t,r,Ir,n),u===l)break;l=u}l!==null&&r.stopPropagation()}else Dl(e,t,r,null,n)}}var Ir=null;function cu(e,t,n,r){if(Ir=null,e=Xu(r),e=wt(e),e!==null)if(t=Tt(e),t===null)e=null;else if(n=t.tag,n===13){if(e=Ss(t),e!==null)return e;e=null}else if(n===3){if(t.stateNode.current.memoizedState.isDehydrated)return t.tag===3?t.stateNode.containerInfo:null;e=null}else t!==e&&(e=null);return Ir=e,null}function Ms(e){switch(e){case"cancel":case"click":case"close":case"contextmenu":case"copy":case"cut":case"auxclick":case"dblclick":case"dragend":case"dragstart":case"drop":case"focusin":case"focusout":case"input":case"invalid":case"keydown":case"keypress":case"keyup":case"mousedown":case"mouseup":case"paste":case"pause":case"play":case"pointercancel":case"pointerdown":case"pointerup":case"ratechange":case"reset":case"resize":case"seeked":case"submit":case"touchcancel":case"touchend":case"touchstart":case"volumechange":case"change":case"selectionchange":case"textInput":case"compositionstart":case"compositionend":case"compositionupdate":case"beforeblur":case"afterblur":case"beforeinput":case"blur":case"fullscreenchange":case"focus":case"hashchange":case"popstate":case"select":case"selectstart":return 1;case"drag":case"dragenter":case"dragexit":case"dragleave":case"dragover":case"mousemove":case"mouseout":case"mouseover":case"pointermove":case"pointerout":case"pointerover":case"scroll":case"toggle":case"touchmove":case"wheel":case"mouseenter":case"mouseleave":case"pointerenter":case"pointerleave":return 4;case"message":switch(Hc()){case Gu:return 1;case _s:return 4;case Mr:case Wc:return 16;case xs:return 536870912;default:return 16}default:return 16}}var et=null,bu=null,kr=null;function Ds(){if(kr)return kr;var e,t=bu,n=t.length,r,l="value"in et?et.value:et.textContent,u=l.length;for(e=0;e<n&&t[e]===l[e];e++);var o=n-e;for(r=1;r<=o&&t[n-r]===l[u-r];r++);return kr=l.slice(e,1<r?1-r:void 0)}function Er(e){var t=e.keyCode;return"charCode"in e?(e=e.charCode,e===0&&t===13&&(e=13)):e=t,e===10&&(e=13),32<=e||e===13?e:0}function sr(){return!0}function Go(){return!1}function we(e){function t(n,r,l,u,o){this._reactName=n,this._targetInst=l,this.type=r,this.nativeEvent=u,this.target=o,this.currentTarget=null;for(var i in e)e.hasOwnProperty(i)&&(n=e[i],this[i]=n?n(u):u[i]);return this.isDefaultPrevented=(u.defaultPrevented!=null?u.defaultPrevented:u.returnValue===!1)?sr:Go,this.isPropagationStopped=Go,this}return V(t.prototype,{preventDefault:function(){this.defaultPrevented=!0;var n=this.nativeEvent;n&&(n.preventDefault?n.preventDefault():typeof n.returnValue!="unknown"&&(n.returnValue=!1),this.isDefaultPrevented=sr)},stopPropagation:function(){var n=this.nativeEvent;n&&(n.stopPropagation?n.stopPropagation():typeof n.cancelBubble!="unknown"&&(n.cancelBubble=!0),this.isPropagationStopped=sr)},persist:function(){},isPersistent:sr}),t}var un={eventPhase:0,bubbles:0,cancelable:0,timeStamp:function(e){return e.timeStamp||Date.now()},defaultPrevented:0,isTrusted:0},eo=we(un),Zn=V({},un,{view:0,detail:0}),rf=we(Zn),xl,Nl,mn,nl=V({},Zn,{screenX:0,screenY:0,clientX:0,clientY:0,pageX:0,pageY:0,ctrlKey:0,shiftKey:0,altKey:0,metaKey:0,getModifierState:to,button:0,buttons:0,relatedTarget:function(e){return e.relatedTarget===void 0?e.fromElement===e.srcElement?e.toElement:e.fromElement:e.relatedTarget},movementX:function(e){return"movementX"in e?e.movementX:(e!==mn&&(mn&&e.type==="mousemove"?(xl=e.screenX-mn.screenX,Nl=e.screenY-mn.screenY):Nl=xl=0,mn=e),xl)},movementY:function(e){return"movementY"in e?e.movementY:Nl}}),Zo=we(nl),lf=V({},nl,{dataTransfer:0}),uf=we(lf),of=V({},Zn,{relatedTarget:0}),Pl=we(of),sf=V({},un,{animationName:0,elapsedTime:0,pseudoElement:0}),af=we(sf),cf=V({},un,{clipboardData:function(e){return"clipboardData"in e?e.clipboardData:window.clipboardData}}),ff=we(cf),df=V({},un,{data:0}),Jo=we(df),pf={Esc:"Escape",Spacebar:" ",Left:"ArrowLeft",Up:"ArrowUp",Right:"ArrowRight",Down:"ArrowDown",Del:"Delete",Win:"OS",Menu:"ContextMenu",Apps:"ContextMenu",Scroll:"ScrollLock",MozPrintableKey:"Unidentified"},mf={8:"Backspace",9:"Tab",12:"Clear",13:"Enter",16:"Shift",17:"Control",18:"Alt",19:"Pause",20:"CapsLock",27:"Escape",32:" ",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"ArrowLeft",38:"ArrowUp",39:"ArrowRight",40:"ArrowDown",45:"Insert",46:"Delete",112:"F1",113:"F2",114:"F3",115:"F4",116:"F5",117:"F6",118:"F7",119:"F8",120:"F9",121:"F10",122:"F11",123:"F12",144:"NumLock",145:"ScrollLock",224:"Meta"},hf={Alt:"altKey",Control:"ctrlKey",Meta:"metaKey",Shift:"shiftKey"};function vf(e){var t=this.nativeEvent;return t.getModifierState?t.getModifierState(e):(e=hf[e])?!!t[e]:!1}function to(){return vf}var yf=V({},Zn,{key:function(e){if(e.key){var t=pf[e.key]||e.key;if(t!=="Unidentified")return t}return e.type==="keypress"?(e=Er(e),e===13?"Enter":String.fromCharCode(e)):e.type==="keydown"||e.type==="keyup"?mf[e.keyCode]||"Unidentified":""},code:0,location:0,ctrlKey:0,shiftKey:0,altKey:0,metaKey:0,repeat:0,locale:0,getModifierState:to,charCode:function(e){return e.type==="keypress"?Er(e):0},keyCode:function(e){return e.type==="keydown"||e.type==="keyup"?e.keyCode:0},which:function(e){return e.type==="keypress"?Er(e):e.type==="keydown"||e.type==="keyup"?e.keyCode:0}}),gf=we(yf),wf=V({},nl,{pointerId:0,width:0,height:0,pressure:0,tangentialPressure:0,tiltX:0,tiltY:0,twist:0,pointerType:0,isPrimary:0}),qo=we(wf),Sf=V({},Zn,{touches:0,targetTouches:0,changedTouches:0,altKey:0,metaKey:0,ctrlKey:0,shiftKey:0,getModifierState:to}),kf=we(Sf),Ef=V({},un,{propertyName:0,elapsedTime:0,pseudoElement:0}),Cf=we(Ef),_f=V({},nl,{deltaX:function(e){return"deltaX"in e?e.deltaX:"wheelDeltaX"in e?-e.wheelDeltaX:0},deltaY:function(e){return"deltaY"in e?e.deltaY:"wheelDeltaY"in e?-e.wheelDeltaY:"wheelDelta"in e?-e.wheelDelta:0},deltaZ:0,deltaMode:0}),xf=we(_f),Nf=[9,13,27,32],no=We&&"CompositionEvent"in window,_n=null;We&&"documentMode"in document&&(_n=document.documentMode);var Pf=We&&"TextEvent"in window&&!_n,js=We&&(!no||_n&&8<_n&&11>=_n),bo=" ",ei=!1;function Is(e,t){switch(e){case"keyup":return Nf.indexOf(t.keyCode)!==-1;case"keydown":return t.keyCode!==229;case"keypress":case"mousedown":case"focusout":return!0;default:return!1}}function Fs(e){return e=e.detail,typeof e=="object"&&"data"in e?e.data:null}var Dt=!1;function zf(e,t){switch(e){case"compositionend":return Fs(t);case"keypress":return t.which!==32?null:(ei=!0,bo);case"textInput":return e=t.data,e===bo&&ei?null:e;default:return null}}function Lf(e,t){if(Dt)return e==="compositionend"||!no&&Is(e,t)?(e=Ds(),kr=bu=et=null,Dt=!1,e):null;switch(e){case"paste":return null;case"keypress":if(!(t.ctrlKey||t.altKey||t.metaKey)||t.ctrlKey&&t.altKey){if(t.char&&1<t.char.length)return t.char;if(t.which)return String.fromCharCode(t.which)}return null;case"compositionend":return js&&t.locale!=="ko"?null:t.data;default:return null}}var Tf={color:!0,date:!0,datetime:!0,"datetime-local":!0,email:!0,month:!0,number:!0,password:!0,range:!0,search:!0,tel:!0,text:!0,time:!0,url:!0,week:!0};function ti(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return t==="input"?!!Tf[e.type]:t==="textarea"}function Us(e,t,n,r){hs(r),t=Fr(t,"onChange"),0<t.length&&(n=new eo("onChange","change",null,n,r),e.push({event:n,listeners:t}))}var xn=null,Fn=null;function Rf(e){Gs(e,0)}function rl(e){var t=Ft(e);if(ss(t))return e}function Of(e,t){if(e==="change")return t}var As=!1;if(We){var zl;if(We){var Ll="oninput"in document;if(!Ll){var ni=document.createElement("div");ni.setAttribute("oninput","return;"),Ll=typeof ni.oninput=="function"}zl=Ll}else zl=!1;As=zl&&(!document.documentMode||9<document.documentMode)}function ri(){xn&&(xn.detachEvent("onpropertychange",Vs),Fn=xn=null)}function Vs(e){if(e.propertyName==="value"&&rl(Fn)){var t=[];Us(t,Fn,e,Xu(e)),ws(Rf,t)}}function Mf(e,t,n){e==="focusin"?(ri(),xn=t,Fn=n,xn.attachEvent("onpropertychange",Vs)):e==="focusout"&&ri()}function Df(e){if(e==="selectionchange"||e==="keyup"||e==="keydown")return rl(Fn)}function jf(e,t){if(e==="click")return rl(t)}function If(e,t){if(e==="input"||e==="change")return rl(t)}function Ff(e,t){return e===t&&(e!==0||1/e===1/t)||e!==e&&t!==t}var Me=typeof Object.is=="function"?Object.is:Ff;function Un(e,t){if(Me(e,t))return!0;if(typeof e!="object"||e===null||typeof t!="object"||t===null)return!1;var n=Object.keys(e),r=Object.keys(t);if(n.length!==r.length)return!1;for(r=0;r<n.length;r++){var l=n[r];if(!Kl.call(t,l)||!Me(e[l],t[l]))return!1}return!0}function li(e){for(;e&&e.firstChild;)e=e.firstChild;return e}function ui(e,t){var n=li(e);e=0;for(var r;n;){if(n.nodeType===3){if(r=e+n.textContent.length,e<=t&&r>=t)return{node:n,offset:t-e};e=r}e:{for(;n;){if(n.nextSibling){n=n.nextSibling;break e}n=n.parentNode}n=void 0}n=li(n)}}function $s(e,t){return e&&t?e===t?!0:e&&e.nodeType===3?!1:t&&t.nodeType===3?$s(e,t.parentNode):"contains"in e?e.contains(t):e.compareDocumentPosition?!!(e.compareDocumentPosition(t)&16):!1:!1}function Bs(){for(var e=window,t=Tr();t instanceof e.HTMLIFrameElement;){try{var n=typeof t.contentWindow.location.href=="string"}catch{n=!1}if(n)e=t.contentWindow;else break;t=Tr(e.document)}return t}function ro(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return t&&(t==="input"&&(e.type==="text"||e.type==="search"||e.type==="tel"||e.type==="url"||e.type==="password")||t==="textarea"||e.contentEditable==="true")}function Uf(e){var t=Bs(),n=e.focusedElem,r=e.selectionRange;if(t!==n&&n&&n.ownerDocument&&$s(n.ownerDocument.documentElement,n)){if(r!==null&&ro(n)){if(t=r.start,e=r.end,e===void 0&&(e=t),"selectionStart"in n)n.selectionStart=t,n.selectionEnd=Math.min(e,n.value.length);else if(e=(t=n.ownerDocument||document)&&t.defaultView||window,e.getSelection){e=e.getSelection();var l=n.textContent.length,u=Math.min(r.start,l);r=r.end===void 0?u:Math.min(r.end,l),!e.extend&&u>r&&(l=r,r=u,u=l),l=ui(n,u);var o=ui(n,r);l&&o&&(e.rangeCount!==1||e.anchorNode!==l.node||e.anchorOffset!==l.offset||e.focusNode!==o.node||e.focusOffset!==o.offset)&&(t=t.createRange(),t.setStart(l.node,l.offset),e.removeAllRanges(),u>r?(e.addRange(t),e.extend(o.node,o.offset)):(t.setEnd(o.node,o.offset),e.addRange(t)))}}for(t=[],e=n;e=e.parentNode;)e.nodeType===1&&t.push({element:e,left:e.scrollLeft,top:e.scrollTop});for(typeof n.focus=="function"&&n.focus(),n=0;n<t.length;n++)e=t[n],e.element.scrollLeft=e.left,e.element.scrollTop=e.top}}var Af=We&&"documentMode"in document&&11>=document.documentMode,jt=null,fu=null,Nn=null,du=!1;function oi(e,t,n){var r=n.window===n?n.document:n.nodeType===9?n:n.ownerDocument;du||jt==null||jt!==Tr(r)||(r=jt,"selectionStart"in r&&ro(r)?r={start:r.selectionStart,end:r.selectionEnd}:(r=(r.ownerDocument&&r.ownerDocument.defaultView||window).getSelection(),r={anchorNode:r.anchorNode,anchorOffset:r.anchorOffset,focusNode:r.focusNode,focusOffset:r.focusOffset}),Nn&&Un(Nn,r)||(Nn=r,r=Fr(fu,"onSelect"),0<r.length&&(t=new eo("onSelect","select",null,t,n),e.push({event:t,listeners:r}),t.target=jt)))}function ar(e,t){var n={};return n[e.toLowerCase()]=t.toLowerCase(),n["Webkit"+e]="webkit"+t,n["Moz"+e]="moz"+t,n}var It={animationend:ar("Animation","AnimationEnd"),animationiteration:ar("Animation","AnimationIteration"),animationstart:ar("Animation","AnimationStart"),transitionend:ar("Transition","TransitionEnd")},Tl={},Hs={};We&&(Hs=document.createElement("div").style,"AnimationEvent"in window||(delete It.animationend.animation,delete It.animationiteration.animation,delete It.animationstart.animation),"TransitionEvent"in window||delete It.transitionend.transition);function ll(e){if(Tl[e])return Tl[e];if(!It[e])return e;var t=It[e],n;for(n in t)if(t.hasOwnProperty(n)&&n in Hs)return Tl[e]=t[n];return e}var Ws=ll("animationend"),Qs=ll("animationiteration"),Ks=ll("animationstart"),Ys=ll("transitionend"),Xs=new Map,ii="abort auxClick cancel canPlay canPlayThrough click close contextMenu copy cut drag dragEnd dragEnter dragExit dragLeave dragOver dragStart drop durationChange emptied encrypted ended error gotPointerCapture input invalid keyDown keyPress keyUp load loadedData loadedMetadata loadStart lostPointerCapture mouseDown mouseMove mouseOut mouseOver mouseUp paste pause play playing pointerCancel pointerDown pointerMove pointerOut pointerOver pointerUp progress rateChange reset resize seeked seeking stalled submit suspend timeUpdate touchCancel touchEnd touchStart volumeChange scroll toggle touchMove waiting wheel".split(" ");function dt(e,t){Xs.set(e,t),Lt(t,[e])}for(var Rl=0;Rl<ii.length;Rl++){var Ol=ii[Rl],Vf=Ol.toLowerCase(),$f=Ol[0].toUpperCase()+Ol.slice(1);dt(Vf,"on"+$f)}dt(Ws,"onAnimationEnd");dt(Qs,"onAnimationIteration");dt(Ks,"onAnimationStart");dt("dblclick","onDoubleClick");dt("focusin","onFocus");dt("focusout","onBlur");dt(Ys,"onTransitionEnd");Jt("onMouseEnter",["mouseout","mouseover"]);Jt("onMouseLeave",["mouseout","mouseover"]);Jt("onPointerEnter",["pointerout","pointerover"]);Jt("onPointerLeave",["pointerout","pointerover"]);Lt("onChange","change click focusin focusout input keydown keyup selectionchange".split(" "));Lt("onSelect","focusout contextmenu dragend focusin keydown keyup mousedown mouseup selectionchange".split(" "));Lt("onBeforeInput",["compositionend","keypress","textInput","paste"]);Lt("onCompositionEnd","compositionend focusout keydown keypress keyup mousedown".split(" "));Lt("onCompositionStart","compositionstart focusout keydown keypress keyup mousedown".split(" "));Lt("onCompositionUpdate","compositionupdate focusout keydown keypress keyup mousedown".split(" "));var kn="abort canplay canplaythrough durationchange emptied encrypted ended error loadeddata loadedmetadata loadstart pause play playing progress ratechange resize seeked seeking stalled suspend timeupdate volumechange waiting".split(" "),Bf=new Set("cancel close invalid load scroll toggle".split(" ").concat(kn));function si(e,t,n){var r=e.type||"unknown-event";e.currentTarget=n,Ac(r,t,void 0,e),e.currentTarget=null}function Gs(e,t){t=(t&4)!==0;for(var n=0;n<e.length;n++){var r=e[n],l=r.event;r=r.listeners;e:{var u=void 0;if(t)for(var o=r.length-1;0<=o;o--){var i=r[o],s=i.instance,c=i.currentTarget;if(i=i.listener,s!==u&&l.isPropagationStopped())break e;si(l,i,c),u=s}else for(o=0;o<r.length;o++){if(i=r[o],s=i.instance,c=i.currentTarget,i=i.listener,s!==u&&l.isPropagationStopped())break e;si(l,i,c),u=s}}}if(Or)throw e=iu,Or=!1,iu=null,e}function D(e,t){var n=t[yu];n===void 0&&(n=t[yu]=new Set);var r=e+"__bubble";n.has(r)||(Zs(t,e,2,!1),n.add(r))}function Ml(e,t,n){var r=0;t&&(r|=4),Zs(n,e,r,t)}var cr="_reactListening"+Math.random().toString(36).slice(2);function An(e){if(!e[cr]){e[cr]=!0,rs.forEach(function(n){n!=="selectionchange"&&(Bf.has(n)||Ml(n,!1,e),Ml(n,!0,e))});var t=e.nodeType===9?e:e.ownerDocument;t===null||t[cr]||(t[cr]=!0,Ml("selectionchange",!1,t))}}function Zs(e,t,n,r){switch(Ms(t)){case 1:var l=tf;break;case 4:l=nf;break;default:l=qu}n=l.bind(null,t,n,e),l=void 0,!ou||t!=="touchstart"&&t!=="touchmove"&&t!=="wheel"||(l=!0),r?l!==void 0?e.addEventListener(t,n,{capture:!0,passive:l}):e.addEventListener(t,n,!0):l!==void 0?e.addEventListener(t,n,{passive:l}):e.addEventListener(t,n,!1)}function Dl(e,t,n,r,l){var u=r;if(!(t&1)&&!(t&2)&&r!==null)e:for(;;){if(r===null)return;var o=r.tag;if(o===3||o===4){var i=r.stateNode.containerInfo;if(i===l||i.nodeType===8&&i.parentNode===l)break;if(o===4)for(o=r.return;o!==null;){var s=o.tag;if((s===3||s===4)&&(s=o.stateNode.containerInfo,s===l||s.nodeType===8&&s.parentNode===l))return;o=o.return}for(;i!==null;){if(o=wt(i),o===null)return;if(s=o.tag,s===5||s===6){r=u=o;continue e}i=i.parentNode}}r=r.return}ws(function(){var c=u,h=Xu(n),m=[];e:{var p=Xs.get(e);if(p!==void 0){var g=eo,w=e;switch(e){case"keypress":if(Er(n)===0)break e;case"keydown":case"keyup":g=gf;break;case"focusin":w="focus",g=Pl;break;case"focusout":w="blur",g=Pl;break;case"beforeblur":case"afterblur":g=Pl;break;case"click":if(n.button===2)break e;case"auxclick":case"dblclick":case"mousedown":case"mousemove":case"mouseup":case"mouseout":case"mouseover":case"contextmenu":g=Zo;break;case"drag":case"dragend":case"dragenter":case"dragexit":case"dragleave":case"dragover":case"dragstart":case"drop":g=uf;break;case"touchcancel":case"touchend":case"touchmove":case"touchstart":g=kf;break;case Ws:case Qs:case Ks:g=af;break;case Ys:g=Cf;break;case"scroll":g=rf;break;case"wheel":g=xf;break;case"copy":case"cut":case"paste":g=ff;break;case"gotpointercapture":case"lostpointercapture":case"pointercancel":case"pointerdown":case"pointermove":case"pointerout":case"pointerover":case"pointerup":g=qo}var S=(t&4)!==0,I=!S&&e==="scroll",f=S?p!==null?p+"Capture":null:p;S=[];for(var a=c,d;a!==null;){d=a;var v=d.stateNode;if(d.tag===5&&v!==null&&(d=v,f!==null&&(v=Mn(a,f),v!=null&&S.push(Vn(a,v,d)))),I)break;a=a.return}0<S.length&&(p=new g(p,w,null,n,h),m.push({event:p,listeners:S}))}}if(!(t&7)){e:{if(p=e==="mouseover"||e==="pointerover",g=e==="mouseout"||e==="pointerout",p&&n!==lu&&(w=n.relatedTarget||n.fromElement)&&(wt(w)||w[Qe]))break e;if((g||p)&&(p=h.window===h?h:(p=h.ownerDocument)?p.defaultView||p.parentWindow:window,g?(w=n.relatedTarget||n.toElement,g=c,w=w?wt(w):null,w!==null&&(I=Tt(w),w!==I||w.tag!==5&&w.tag!==6)&&(w=null)):(g=null,w=c),g!==w)){if(S=Zo,v="onMouseLeave",f="onMouseEnter",a="mouse",(e==="pointerout"||e==="pointerover")&&(S=qo,v="onPointerLeave",f="onPointerEnter",a="pointer"),I=g==null?p:Ft(g),d=w==null?p:Ft(w),p=new S(v,a+"leave",g,n,h),p.target=I,p.relatedTarget=d,v=null,wt(h)===c&&(S=new S(f,a+"enter",w,n,h),S.target=d,S.relatedTarget=I,v=S),I=v,g&&w)t:{for(S=g,f=w,a=0,d=S;d;d=Rt(d))a++;for(d=0,v=f;v;v=Rt(v))d++;for(;0<a-d;)S=Rt(S),a--;for(;0<d-a;)f=Rt(f),d--;for(;a--;){if(S===f||f!==null&&S===f.alternate)break t;S=Rt(S),f=Rt(f)}S=null}else S=null;g!==null&&ai(m,p,g,S,!1),w!==null&&I!==null&&ai(m,I,w,S,!0)}}e:{if(p=c?Ft(c):window,g=p.nodeName&&p.nodeName.toLowerCase(),g==="select"||g==="input"&&p.type==="file")var E=Of;else if(ti(p))if(As)E=If;else{E=Df;var _=Mf}else(g=p.nodeName)&&g.toLowerCase()==="input"&&(p.type==="checkbox"||p.type==="radio")&&(E=jf);if(E&&(E=E(e,c))){Us(m,E,n,h);break e}_&&_(e,p,c),e==="focusout"&&(_=p._wrapperState)&&_.controlled&&p.type==="number"&&bl(p,"number",p.value)}switch(_=c?Ft(c):window,e){case"focusin":(ti(_)||_.contentEditable==="true")&&(jt=_,fu=c,Nn=null);break;case"focusout":Nn=fu=jt=null;break;case"mousedown":du=!0;break;case"contextmenu":case"mouseup":case"dragend":du=!1,oi(m,n,h);break;case"selectionchange":if(Af)break;case"keydown":case"keyup":oi(m,n,h)}var x;if(no)e:{switch(e){case"compositionstart":var N="onCompositionStart";break e;case"compositionend":N="onCompositionEnd";break e;case"compositionupdate":N="onCompositionUpdate";break e}N=void 0}else Dt?Is(e,n)&&(N="onCompositionEnd"):e==="keydown"&&n.keyCode===229&&(N="onCompositionStart");N&&(js&&n.locale!=="ko"&&(Dt||N!=="onCompositionStart"?N==="onCompositionEnd"&&Dt&&(x=Ds()):(et=h,bu="value"in et?et.value:et.textContent,Dt=!0)),_=Fr(c,N),0<_.length&&(N=new Jo(N,e,null,n,h),m.push({event:N,listeners:_}),x?N.data=x:(x=Fs(n),x!==null&&(N.data=x)))),(x=Pf?zf(e,n):Lf(e,n))&&(c=Fr(c,"onBeforeInput"),0<c.length&&(h=new Jo("onBeforeInput","beforeinput",null,n,h),m.push({event:h,listeners:c}),h.data=x))}Gs(m,t)})}function Vn(e,t,n){return{instance:e,listener:t,currentTarget:n}}function Fr(e,t){for(var n=t+"Capture",r=[];e!==null;){var l=e,u=l.stateNode;l.tag===5&&u!==null&&(l=u,u=Mn(e,n),u!=null&&r.unshift(Vn(e,u,l)),u=Mn(e,t),u!=null&&r.push(Vn(e,u,l))),e=e.return}return r}function Rt(e){if(e===null)return null;do e=e.return;while(e&&e.tag!==5);return e||null}function ai(e,t,n,r,l){for(var u=t._reactName,o=[];n!==null&&n!==r;){var i=n,s=i.alternate,c=i.stateNode;if(s!==null&&s===r)break;i.tag===5&&c!==null&&(i=c,l?(s=Mn(n,u),s!=null&&o.unshift(Vn(n,s,i))):l||(s=Mn(n,u),s!=null&&o.push(Vn(n,s,i)))),n=n.return}o.length!==0&&e.push({event:t,listeners:o})}var Hf=/\r\n?/g,Wf=/\u0000|\uFFFD/g;function ci(e){return(typeof e=="string"?e:""+e).replace(Hf,`
 `).replace(Wf,"")}function fr(e,t,n){if(t=ci(t),ci(e)!==t&&n)throw Error(y(425))}function Ur(){}var pu=null,mu=null;function hu(e,t){return e==="textarea"||e==="noscript"||typeof t.children=="string"||typeof t.children=="number"||typeof t.dangerouslySetInnerHTML=="object"&&t.dangerouslySetInnerHTML!==null&&t.dangerouslySetInnerHTML.__html!=null}var vu=typeof setTimeout=="function"?setTimeout:void 0,Qf=typeof clearTimeout=="function"?clearTimeout:void 0,fi=typeof Promise=="function"?Promise:void 0,Kf=typeof queueMicrotask=="function"?queueMicrotask:typeof fi<"u"?function(e){return fi.resolve(null).then(e).catch(Yf)}:vu;function Yf(e){setTimeout(function(){throw e})}function jl(e,t){var n=t,r=0;do{var l=n.nextSibling;if(e.removeChild(n),l&&l.nodeType===8)if(n=l.data,n==="/$"){if(r===0){e.removeChild(l),In(t);return}r--}else n!=="$"&&n!=="$?"&&n!=="$!"||r++;n=l}while(n);In(t)}function ut(e){for(;e!=null;e=e.nextSibling){var t=e.nodeType;if(t===1||t===3)break;if(t===8){if(t=e.data,t==="$"||t==="$!"||t==="$?")break;if(t==="/$")return null}}return e}function di(e){e=e.previousSibling;for(var t=0;e;){if(e.nodeType===8){var n=e.data;if(n==="$"||n==="$!"||n==="$?"){if(t===0)return e;t--}else n==="/$"&&t++}e=e.previousSibling}return null}var on=Math.random().toString(36).slice(2),Ie="__reactFiber$"+on,$n="__reactProps$"+on,Qe="__reactContainer$"+on,yu="__reactEvents$"+on,Xf="__reactListeners$"+on,Gf="__reactHandles$"+on;function wt(e){var t=e[Ie];if(t)return t;for(var n=e.parentNode;n;){if(t=n[Qe]||n[Ie]){if(n=t.alternate,t.child!==null||n!==null&&n.child!==null)for(e=di(e);e!==null;){if(n=e[Ie])return n;e=di(e)}return t}e=n,n=e.parentNode}return null}function Jn(e){return e=e[Ie]||e[Qe],!e||e.tag!==5&&e.tag!==6&&e.tag!==13&&e.tag!==3?null:e}function Ft(e){if(e.tag===5||e.tag===6)return e.stateNode;throw Error(y(33))}function ul(e){return e[$n]||null}var gu=[],Ut=-1;function pt(e){return{current:e}}function j(e){0>Ut||(e.current=gu[Ut],gu[Ut]=null,Ut--)}function M(e,t){Ut++,gu[Ut]=e.current,e.current=t}var ft={},le=pt(ft),fe=pt(!1),_t=ft;function qt(e,t){var n=e.type.contextTypes;if(!n)return ft;var r=e.stateNode;if(r&&r.__reactInternalMemoizedUnmaskedChildContext===t)return r.__reactInternalMemoizedMaskedChildContext;var l={},u;for(u in n)l[u]=t[u];return r&&(e=e.stateNode,e.__reactInternalMemoizedUnmaskedChildContext=t,e.__reactInternalMemoizedMaskedChildContext=l),l}function de(e){return e=e.childContextTypes,e!=null}function Ar(){j(fe),j(le)}function pi(e,t,n){if(le.current!==ft)throw Error(y(168));M(le,t),M(fe,n)}function Js(e,t,n){var r=e.stateNode;if(t=t.childContextTypes,typeof r.getChildContext!="function")return n;r=r.getChildContext();for(var l in r)if(!(l in t))throw Error(y(108,Oc(e)||"Unknown",l));return V({},n,r)}function Vr(e){return e=(e=e.stateNode)&&e.__reactInternalMemoizedMergedChildContext||ft,_t=le.current,M(le,e),M(fe,fe.current),!0}function mi(e,t,n){var r=e.stateNode;if(!r)throw Error(y(169));n?(e=Js(e,t,_t),r.__reactInternalMemoizedMergedChildContext=e,j(fe),j(le),M(le,e)):j(fe),M(fe,n)}var Ve=null,ol=!1,Il=!1;function qs(e){Ve===null?Ve=[e]:Ve.push(e)}function Zf(e){ol=!0,qs(e)}function mt(){if(!Il&&Ve!==null){Il=!0;var e=0,t=O;try{var n=Ve;for(O=1;e<n.length;e++){var r=n[e];do r=r(!0);while(r!==null)}Ve=null,ol=!1}catch(l){throw Ve!==null&&(Ve=Ve.slice(e+1)),Cs(Gu,mt),l}finally{O=t,Il=!1}}return null}var At=[],Vt=0,$r=null,Br=0,Se=[],ke=0,xt=null,$e=1,Be="";function yt(e,t){At[Vt++]=Br,At[Vt++]=$r,$r=e,Br=t}function bs(e,t,n){Se[ke++]=$e,Se[ke++]=Be,Se[ke++]=xt,xt=e;var r=$e;e=Be;var l=32-Re(r)-1;r&=~(1<<l),n+=1;var u=32-Re(t)+l;if(30<u){var o=l-l%5;u=(r&(1<<o)-1).toString(32),r>>=o,l-=o,$e=1<<32-Re(t)+l|n<<l|r,Be=u+e}else $e=1<<u|n<<l|r,Be=e}function lo(e){e.return!==null&&(yt(e,1),bs(e,1,0))}function uo(e){for(;e===$r;)$r=At[--Vt],At[Vt]=null,Br=At[--Vt],At[Vt]=null;for(;e===xt;)xt=Se[--ke],Se[ke]=null,Be=Se[--ke],Se[ke]=null,$e=Se[--ke],Se[ke]=null}var ve=null,he=null,F=!1,Te=null;function ea(e,t){var n=Ee(5,null,null,0);n.elementType="DELETED",n.stateNode=t,n.return=e,t=e.deletions,t===null?(e.deletions=[n],e.flags|=16):t.push(n)}function hi(e,t){switch(e.tag){case 5:var n=e.type;return t=t.nodeType!==1||n.toLowerCase()!==t.nodeName.toLowerCase()?null:t,t!==null?(e.stateNode=t,ve=e,he=ut(t.firstChild),!0):!1;case 6:return t=e.pendingProps===""||t.nodeType!==3?null:t,t!==null?(e.stateNode=t,ve=e,he=null,!0):!1;case 13:return t=t.nodeType!==8?null:t,t!==null?(n=xt!==null?{id:$e,overflow:Be}:null,e.memoizedState={dehydrated:t,treeContext:n,retryLane:1073741824},n=Ee(18,null,null,0),n.stateNode=t,n.return=e,e.child=n,ve=e,he=null,!0):!1;default:return!1}}function wu(e){return(e.mode&1)!==0&&(e.flags&128)===0}function Su(e){if(F){var t=he;if(t){var n=t;if(!hi(e,t)){if(wu(e))throw Error(y(418));t=ut(n.nextSibling);var r=ve;t&&hi(e,t)?ea(r,n):(e.flags=e.flags&-4097|2,F=!1,ve=e)}}else{if(wu(e))throw Error(y(418));e.flags=e.flags&-4097|2,F=!1,ve=e}}}function vi(e){for(e=e.return;e!==null&&e.tag!==5&&e.tag!==3&&e.tag!==13;)e=e.return;ve=e}function dr(e){if(e!==ve)return!1;if(!F)return vi(e),F=!0,!1;var t;if((t=e.tag!==3)&&!(t=e.tag!==5)&&(t=e.type,t=t!=="head"&&t!=="body"&&!hu(e.type,e.memoizedProps)),t&&(t=he)){if(wu(e))throw ta(),Error(y(418));for(;t;)ea(e,t),t=ut(t.nextSibling)}if(vi(e),e.tag===13){if(e=e.memoizedState,e=e!==null?e.dehydrated:null,!e)throw Error(y(317));e:{for(e=e.nextSibling,t=0;e;){if(e.nodeType===8){var n=e.data;if(n==="/$"){if(t===0){he=ut(e.nextSibling);break e}t--}else n!=="$"&&n!=="$!"&&n!=="$?"||t++}e=e.nextSibling}he=null}}else he=ve?ut(e.stateNode.nextSibling):null;return!0}function ta(){for(var e=he;e;)e=ut(e.nextSibling)}function bt(){he=ve=null,F=!1}function oo(e){Te===null?Te=[e]:Te.push(e)}var Jf=Xe.ReactCurrentBatchConfig;function hn(e,t,n){if(e=n.ref,e!==null&&typeof e!="function"&&typeof e!="object"){if(n._owner){if(n=n._owner,n){if(n.tag!==1)throw Error(y(309));var r=n.stateNode}if(!r)throw Error(y(147,e));var l=r,u=""+e;return t!==null&&t.ref!==null&&typeof t.ref=="function"&&t.ref._stringRef===u?t.ref:(t=function(o){var i=l.refs;o===null?delete i[u]:i[u]=o},t._stringRef=u,t)}if(typeof e!="string")throw Error(y(284));if(!n._owner)throw Error(y(290,e))}return e}function pr(e,t){throw e=Object.prototype.toString.call(t),Error(y(31,e==="[object Object]"?"object with keys {"+Object.keys(t).join(", ")+"}":e))}function yi(e){var t=e._init;return t(e._payload)}function na(e){function t(f,a){if(e){var d=f.deletions;d===null?(f.deletions=[a],f.flags|=16):d.push(a)}}function n(f,a){if(!e)return null;for(;a!==null;)t(f,a),a=a.sibling;return null}function r(f,a){for(f=new Map;a!==null;)a.key!==null?f.set(a.key,a):f.set(a.index,a),a=a.sibling;return f}function l(f,a){return f=at(f,a),f.index=0,f.sibling=null,f}function u(f,a,d){return f.index=d,e?(d=f.alternate,d!==null?(d=d.index,d<a?(f.flags|=2,a):d):(f.flags|=2,a)):(f.flags|=1048576,a)}function o(f){return e&&f.alternate===null&&(f.flags|=2),f}function i(f,a,d,v){return a===null||a.tag!==6?(a=Hl(d,f.mode,v),a.return=f,a):(a=l(a,d),a.return=f,a)}function s(f,a,d,v){var E=d.type;return E===Mt?h(f,a,d.props.children,v,d.key):a!==null&&(a.elementType===E||typeof E=="object"&&E!==null&&E.$$typeof===Ze&&yi(E)===a.type)?(v=l(a,d.props),v.ref=hn(f,a,d),v.return=f,v):(v=Lr(d.type,d.key,d.props,null,f.mode,v),v.ref=hn(f,a,d),v.return=f,v)}function c(f,a,d,v){return a===null||a.tag!==4||a.stateNode.containerInfo!==d.containerInfo||a.stateNode.implementation!==d.implementation?(a=Wl(d,f.mode,v),a.return=f,a):(a=l(a,d.children||[]),a.return=f,a)}function h(f,a,d,v,E){return a===null||a.tag!==7?(a=Ct(d,f.mode,v,E),a.return=f,a):(a=l(a,d),a.return=f,a)}function m(f,a,d){if(typeof a=="string"&&a!==""||typeof a=="number")return a=Hl(""+a,f.mode,d),a.return=f,a;if(typeof a=="object"&&a!==null){switch(a.$$typeof){case nr:return d=Lr(a.type,a.key,a.props,null,f.mode,d),d.ref=hn(f,null,a),d.return=f,d;case Ot:return a=Wl(a,f.mode,d),a.return=f,a;case Ze:var v=a._init;return m(f,v(a._payload),d)}if(wn(a)||cn(a))return a=Ct(a,f.mode,d,null),a.return=f,a;pr(f,a)}return null}function p(f,a,d,v){var E=a!==null?a.key:null;if(typeof d=="string"&&d!==""||typeof d=="number")return E!==null?null:i(f,a,""+d,v);if(typeof d=="object"&&d!==null){switch(d.$$typeof){case nr:return d.key===E?s(f,a,d,v):null;case Ot:return d.key===E?c(f,a,d,v):null;case Ze:return E=d._init,p(f,a,E(d._payload),v)}if(wn(d)||cn(d))return E!==null?null:h(f,a,d,v,null);pr(f,d)}return null}function g(f,a,d,v,E){if(typeof v=="string"&&v!==""||typeof v=="number")return f=f.get(d)||null,i(a,f,""+v,E);if(typeof v=="object"&&v!==null){switch(v.$$typeof){case nr:return f=f.get(v.key===null?d:v.key)||null,s(a,f,v,E);case Ot:return f=f.get(v.key===null?d:v.key)||null,c(a,f,v,E);case Ze:var _=v._init;return g(f,a,d,_(v._payload),E)}if(wn(v)||cn(v))return f=f.get(d)||null,h(a,f,v,E,null);pr(a,v)}return null}function w(f,a,d,v){for(var E=null,_=null,x=a,N=a=0,B=null;x!==null&&N<d.length;N++){x.index>N?(B=x,x=null):B=x.sibling;var T=p(f,x,d[N],v);if(T===null){x===null&&(x=B);break}e&&x&&T.alternate===null&&t(f,x),a=u(T,a,N),_===null?E=T:_.sibling=T,_=T,x=B}if(N===d.length)return n(f,x),F&&yt(f,N),E;if(x===null){for(;N<d.length;N++)x=m(f,d[N],v),x!==null&&(a=u(x,a,N),_===null?E=x:_.sibling=x,_=x);return F&&yt(f,N),E}for(x=r(f,x);N<d.length;N++)B=g(x,f,N,d[N],v),B!==null&&(e&&B.alternate!==null&&x.delete(B.key===null?N:B.key),a=u(B,a,N),_===null?E=B:_.sibling=B,_=B);return e&&x.forEach(function(Ne){return t(f,Ne)}),F&&yt(f,N),E}function S(f,a,d,v){var E=cn(d);if(typeof E!="function")throw Error(y(150));if(d=E.call(d),d==null)throw Error(y(151));for(var _=E=null,x=a,N=a=0,B=null,T=d.next();x!==null&&!T.done;N++,T=d.next()){x.index>N?(B=x,x=null):B=x.sibling;var Ne=p(f,x,T.value,v);if(Ne===null){x===null&&(x=B);break}e&&x&&Ne.alternate===null&&t(f,x),a=u(Ne,a,N),_===null?E=Ne:_.sibling=Ne,_=Ne,x=B}if(T.done)return n(f,x),F&&yt(f,N),E;if(x===null){for(;!T.done;N++,T=d.next())T=m(f,T.value,v),T!==null&&(a=u(T,a,N),_===null?E=T:_.sibling=T,_=T);return F&&yt(f,N),E}for(x=r(f,x);!T.done;N++,T=d.next())T=g(x,f,N,T.value,v),T!==null&&(e&&T.alternate!==null&&x.delete(T.key===null?N:T.key),a=u(T,a,N),_===null?E=T:_.sibling=T,_=T);return e&&x.forEach(function(sn){return t(f,sn)}),F&&yt(f,N),E}function I(f,a,d,v){if(typeof d=="object"&&d!==null&&d.type===Mt&&d.key===null&&(d=d.props.children),typeof d=="object"&&d!==null){switch(d.$$typeof){case nr:e:{for(var E=d.key,_=a;_!==null;){if(_.key===E){if(E=d.type,E===Mt){if(_.tag===7){n(f,_.sibling),a=l(_,d.props.children),a.return=f,f=a;break e}}else if(_.elementType===E||typeof E=="object"&&E!==null&&E.$$typeof===Ze&&yi(E)===_.type){n(f,_.sibling),a=l(_,d.props),a.ref=hn(f,_,d),a.return=f,f=a;break e}n(f,_);break}else t(f,_);_=_.sibling}d.type===Mt?(a=Ct(d.props.children,f.mode,v,d.key),a.return=f,f=a):(v=Lr(d.type,d.key,d.props,null,f.mode,v),v.ref=hn(f,a,d),v.return=f,f=v)}return o(f);case Ot:e:{for(_=d.key;a!==null;){if(a.key===_)if(a.tag===4&&a.stateNode.containerInfo===d.containerInfo&&a.stateNode.implementation===d.implementation){n(f,a.sibling),a=l(a,d.children||[]),a.return=f,f=a;break e}else{n(f,a);break}else t(f,a);a=a.sibling}a=Wl(d,f.mode,v),a.return=f,f=a}return o(f);case Ze:return _=d._init,I(f,a,_(d._payload),v)}if(wn(d))return w(f,a,d,v);if(cn(d))return S(f,a,d,v);pr(f,d)}return typeof d=="string"&&d!==""||typeof d=="number"?(d=""+d,a!==null&&a.tag===6?(n(f,a.sibling),a=l(a,d),a.return=f,f=a):(n(f,a),a=Hl(d,f.mode,v),a.return=f,f=a),o(f)):n(f,a)}return I}var en=na(!0),ra=na(!1),Hr=pt(null),Wr=null,$t=null,io=null;function so(){io=$t=Wr=null}function ao(e){var t=Hr.current;j(Hr),e._currentValue=t}function ku(e,t,n){for(;e!==null;){var r=e.alternate;if((e.childLanes&t)!==t?(e.childLanes|=t,r!==null&&(r.childLanes|=t)):r!==null&&(r.childLanes&t)!==t&&(r.childLanes|=t),e===n)break;e=e.return}}function Gt(e,t){Wr=e,io=$t=null,e=e.dependencies,e!==null&&e.firstContext!==null&&(e.lanes&t&&(ce=!0),e.firstContext=null)}function _e(e){var t=e._currentValue;if(io!==e)if(e={context:e,memoizedValue:t,next:null},$t===null){if(Wr===null)throw Error(y(308));$t=e,Wr.dependencies={lanes:0,firstContext:e}}else $t=$t.next=e;return t}var St=null;function co(e){St===null?St=[e]:St.push(e)}function la(e,t,n,r){var l=t.interleaved;return l===null?(n.next=n,co(t)):(n.next=l.next,l.next=n),t.interleaved=n,Ke(e,r)}function Ke(e,t){e.lanes|=t;var n=e.alternate;for(n!==null&&(n.lanes|=t),n=e,e=e.return;e!==null;)e.childLanes|=t,n=e.alternate,n!==null&&(n.childLanes|=t),n=e,e=e.return;return n.tag===3?n.stateNode:null}var Je=!1;function fo(e){e.updateQueue={baseState:e.memoizedState,firstBaseUpdate:null,lastBaseUpdate:null,shared:{pending:null,interleaved:null,lanes:0},effects:null}}function ua(e,t){e=e.updateQueue,t.updateQueue===e&&(t.updateQueue={baseState:e.baseState,firstBaseUpdate:e.firstBaseUpdate,lastBaseUpdate:e.lastBaseUpdate,shared:e.shared,effects:e.effects})}function He(e,t){return{eventTime:e,lane:t,tag:0,payload:null,callback:null,next:null}}function ot(e,t,n){var r=e.updateQueue;if(r===null)return null;if(r=r.shared,R&2){var l=r.pending;return l===null?t.next=t:(t.next=l.next,l.next=t),r.pending=t,Ke(e,n)}return l=r.interleaved,l===null?(t.next=t,co(r)):(t.next=l.next,l.next=t),r.interleaved=t,Ke(e,n)}function Cr(e,t,n){if(t=t.updateQueue,t!==null&&(t=t.shared,(n&4194240)!==0)){var r=t.lanes;r&=e.pendingLanes,n|=r,t.lanes=n,Zu(e,n)}}function gi(e,t){var n=e.updateQueue,r=e.alternate;if(r!==null&&(r=r.updateQueue,n===r)){var l=null,u=null;if(n=n.firstBaseUpdate,n!==null){do{var o={eventTime:n.eventTime,lane:n.lane,tag:n.tag,payload:n.payload,callback:n.callback,next:null};u===null?l=u=o:u=u.next=o,n=n.next}while(n!==null);u===null?l=u=t:u=u.next=t}else l=u=t;n={baseState:r.baseState,firstBaseUpdate:l,lastBaseUpdate:u,shared:r.shared,effects:r.effects},e.updateQueue=n;return}e=n.lastBaseUpdate,e===null?n.firstBaseUpdate=t:e.next=t,n.lastBaseUpdate=t}function Qr(e,t,n,r){var l=e.updateQueue;Je=!1;var u=l.firstBaseUpdate,o=l.lastBaseUpdate,i=l.shared.pending;if(i!==null){l.shared.pending=null;var s=i,c=s.next;s.next=null,o===null?u=c:o.next=c,o=s;var h=e.alternate;h!==null&&(h=h.updateQueue,i=h.lastBaseUpdate,i!==o&&(i===null?h.firstBaseUpdate=c:i.next=c,h.lastBaseUpdate=s))}if(u!==null){var m=l.baseState;o=0,h=c=s=null,i=u;do{var p=i.lane,g=i.eventTime;if((r&p)===p){h!==null&&(h=h.next={eventTime:g,lane:0,tag:i.tag,payload:i.payload,callback:i.callback,next:null});e:{var w=e,S=i;switch(p=t,g=n,S.tag){case 1:if(w=S.payload,typeof w=="function"){m=w.call(g,m,p);break e}m=w;break e;case 3:w.flags=w.flags&-65537|128;case 0:if(w=S.payload,p=typeof w=="function"?w.call(g,m,p):w,p==null)break e;m=V({},m,p);break e;case 2:Je=!0}}i.callback!==null&&i.lane!==0&&(e.flags|=64,p=l.effects,p===null?l.effects=[i]:p.push(i))}else g={eventTime:g,lane:p,tag:i.tag,payload:i.payload,callback:i.callback,next:null},h===null?(c=h=g,s=m):h=h.next=g,o|=p;if(i=i.next,i===null){if(i=l.shared.pending,i===null)break;p=i,i=p.next,p.next=null,l.lastBaseUpdate=p,l.shared.pending=null}}while(!0);if(h===null&&(s=m),l.baseState=s,l.firstBaseUpdate=c,l.lastBaseUpdate=h,t=l.shared.interleaved,t!==null){l=t;do o|=l.lane,l=l.next;while(l!==t)}else u===null&&(l.shared.lanes=0);Pt|=o,e.lanes=o,e.memoizedState=m}}function wi(e,t,n){if(e=t.effects,t.effects=null,e!==null)for(t=0;t<e.length;t++){var r=e[t],l=r.callback;if(l!==null){if(r.callback=null,r=n,typeof l!="function")throw Error(y(191,l));l.call(r)}}}var qn={},Ue=pt(qn),Bn=pt(qn),Hn=pt(qn);function kt(e){if(e===qn)throw Error(y(174));return e}function po(e,t){switch(M(Hn,t),M(Bn,e),M(Ue,qn),e=t.nodeType,e){case 9:case 11:t=(t=t.documentElement)?t.namespaceURI:tu(null,"");break;default:e=e===8?t.parentNode:t,t=e.namespaceURI||null,e=e.tagName,t=tu(t,e)}j(Ue),M(Ue,t)}function tn(){j(Ue),j(Bn),j(Hn)}function oa(e){kt(Hn.current);var t=kt(Ue.current),n=tu(t,e.type);t!==n&&(M(Bn,e),M(Ue,n))}function mo(e){Bn.current===e&&(j(Ue),j(Bn))}var U=pt(0);function Kr(e){for(var t=e;t!==null;){if(t.tag===13){var n=t.memoizedState;if(n!==null&&(n=n.dehydrated,n===null||n.data==="$?"||n.data==="$!"))return t}else if(t.tag===19&&t.memoizedProps.revealOrder!==void 0){if(t.flags&128)return t}else if(t.child!==null){t.child.return=t,t=t.child;continue}if(t===e)break;for(;t.sibling===null;){if(t.return===null||t.return===e)return null;t=t.return}t.sibling.return=t.return,t=t.sibling}return null}var Fl=[];function ho(){for(var e=0;e<Fl.length;e++)Fl[e]._workInProgressVersionPrimary=null;Fl.length=0}var _r=Xe.ReactCurrentDispatcher,Ul=Xe.ReactCurrentBatchConfig,Nt=0,A=null,K=null,G=null,Yr=!1,Pn=!1,Wn=0,qf=0;function te(){throw Error(y(321))}function vo(e,t){if(t===null)return!1;for(var n=0;n<t.length&&n<e.length;n++)if(!Me(e[n],t[n]))return!1;return!0}function yo(e,t,n,r,l,u){if(Nt=u,A=t,t.memoizedState=null,t.updateQueue=null,t.lanes=0,_r.current=e===null||e.memoizedState===null?nd:rd,e=n(r,l),Pn){u=0;do{if(Pn=!1,Wn=0,25<=u)throw Error(y(301));u+=1,G=K=null,t.updateQueue=null,_r.current=ld,e=n(r,l)}while(Pn)}if(_r.current=Xr,t=K!==null&&K.next!==null,Nt=0,G=K=A=null,Yr=!1,t)throw Error(y(300));return e}function go(){var e=Wn!==0;return Wn=0,e}function je(){var e={memoizedState:null,baseState:null,baseQueue:null,queue:null,next:null};return G===null?A.memoizedState=G=e:G=G.next=e,G}function xe(){if(K===null){var e=A.alternate;e=e!==null?e.memoizedState:null}else e=K.next;var t=G===null?A.memoizedState:G.next;if(t!==null)G=t,K=e;else{if(e===null)throw Error(y(310));K=e,e={memoizedState:K.memoizedState,baseState:K.baseState,baseQueue:K.baseQueue,queue:K.queue,next:null},G===null?A.memoizedState=G=e:G=G.next=e}return G}function Qn(e,t){return typeof t=="function"?t(e):t}function Al(e){var t=xe(),n=t.queue;if(n===null)throw Error(y(311));n.lastRenderedReducer=e;var r=K,l=r.baseQueue,u=n.pending;if(u!==null){if(l!==null){var o=l.next;l.next=u.next,u.next=o}r.baseQueue=l=u,n.pending=null}if(l!==null){u=l.next,r=r.baseState;var i=o=null,s=null,c=u;do{var h=c.lane;if((Nt&h)===h)s!==null&&(s=s.next={lane:0,action:c.action,hasEagerState:c.hasEagerState,eagerState:c.eagerState,next:null}),r=c.hasEagerState?c.eagerState:e(r,c.action);else{var m={lane:h,action:c.action,hasEagerState:c.hasEagerState,eagerState:c.eagerState,next:null};s===null?(i=s=m,o=r):s=s.next=m,A.lanes|=h,Pt|=h}c=c.next}while(c!==null&&c!==u);s===null?o=r:s.next=i,Me(r,t.memoizedState)||(ce=!0),t.memoizedState=r,t.baseState=o,t.baseQueue=s,n.lastRenderedState=r}if(e=n.interleaved,e!==null){l=e;do u=l.lane,A.lanes|=u,Pt|=u,l=l.next;while(l!==e)}else l===null&&(n.lanes=0);return[t.memoizedState,n.dispatch]}function Vl(e){var t=xe(),n=t.queue;if(n===null)throw Error(y(311));n.lastRenderedReducer=e;var r=n.dispatch,l=n.pending,u=t.memoizedState;if(l!==null){n.pending=null;var o=l=l.next;do u=e(u,o.action),o=o.next;while(o!==l);Me(u,t.memoizedState)||(ce=!0),t.memoizedState=u,t.baseQueue===null&&(t.baseState=u),n.lastRenderedState=u}return[u,r]}function ia(){}function sa(e,t){var n=A,r=xe(),l=t(),u=!Me(r.memoizedState,l);if(u&&(r.memoizedState=l,ce=!0),r=r.queue,wo(fa.bind(null,n,r,e),[e]),r.getSnapshot!==t||u||G!==null&&G.memoizedState.tag&1){if(n.flags|=2048,Kn(9,ca.bind(null,n,r,l,t),void 0,null),Z===null)throw Error(y(349));Nt&30||aa(n,t,l)}return l}function aa(e,t,n){e.flags|=16384,e={getSnapshot:t,value:n},t=A.updateQueue,t===null?(t={lastEffect:null,stores:null},A.updateQueue=t,t.stores=[e]):(n=t.stores,n===null?t.stores=[e]:n.push(e))}function ca(e,t,n,r){t.value=n,t.getSnapshot=r,da(t)&&pa(e)}function fa(e,t,n){return n(function(){da(t)&&pa(e)})}function da(e){var t=e.getSnapshot;e=e.value;try{var n=t();return!Me(e,n)}catch{return!0}}function pa(e){var t=Ke(e,1);t!==null&&Oe(t,e,1,-1)}function Si(e){var t=je();return typeof e=="function"&&(e=e()),t.memoizedState=t.baseState=e,e={pending:null,interleaved:null,lanes:0,dispatch:null,lastRenderedReducer:Qn,lastRenderedState:e},t.queue=e,e=e.dispatch=td.bind(null,A,e),[t.memoizedState,e]}function Kn(e,t,n,r){return e={tag:e,create:t,destroy:n,deps:r,next:null},t=A.updateQueue,t===null?(t={lastEffect:null,stores:null},A.updateQueue=t,t.lastEffect=e.next=e):(n=t.lastEffect,n===null?t.lastEffect=e.next=e:(r=n.next,n.next=e,e.next=r,t.lastEffect=e)),e}function ma(){return xe().memoizedState}function xr(e,t,n,r){var l=je();A.flags|=e,l.memoizedState=Kn(1|t,n,void 0,r===void 0?null:r)}function il(e,t,n,r){var l=xe();r=r===void 0?null:r;var u=void 0;if(K!==null){var o=K.memoizedState;if(u=o.destroy,r!==null&&vo(r,o.deps)){l.memoizedState=Kn(t,n,u,r);return}}A.flags|=e,l.memoizedState=Kn(1|t,n,u,r)}function ki(e,t){return xr(8390656,8,e,t)}function wo(e,t){return il(2048,8,e,t)}function ha(e,t){return il(4,2,e,t)}function va(e,t){return il(4,4,e,t)}function ya(e,t){if(typeof t=="function")return e=e(),t(e),function(){t(null)};if(t!=null)return e=e(),t.current=e,function(){t.current=null}}function ga(e,t,n){return n=n!=null?n.concat([e]):null,il(4,4,ya.bind(null,t,e),n)}function So(){}function wa(e,t){var n=xe();t=t===void 0?null:t;var r=n.memoizedState;return r!==null&&t!==null&&vo(t,r[1])?r[0]:(n.memoizedState=[e,t],e)}function Sa(e,t){var n=xe();t=t===void 0?null:t;var r=n.memoizedState;return r!==null&&t!==null&&vo(t,r[1])?r[0]:(e=e(),n.memoizedState=[e,t],e)}function ka(e,t,n){return Nt&21?(Me(n,t)||(n=Ns(),A.lanes|=n,Pt|=n,e.baseState=!0),t):(e.baseState&&(e.baseState=!1,ce=!0),e.memoizedState=n)}function bf(e,t){var n=O;O=n!==0&&4>n?n:4,e(!0);var r=Ul.transition;Ul.transition={};try{e(!1),t()}finally{O=n,Ul.transition=r}}function Ea(){return xe().memoizedState}function ed(e,t,n){var r=st(e);if(n={lane:r,action:n,hasEagerState:!1,eagerState:null,next:null},Ca(e))_a(t,n);else if(n=la(e,t,n,r),n!==null){var l=oe();Oe(n,e,r,l),xa(n,t,r)}}function td(e,t,n){var r=st(e),l={lane:r,action:n,hasEagerState:!1,eagerState:null,next:null};if(Ca(e))_a(t,l);else{var u=e.alternate;if(e.lanes===0&&(u===null||u.lanes===0)&&(u=t.lastRenderedReducer,u!==null))try{var o=t.lastRenderedState,i=u(o,n);if(l.hasEagerState=!0,l.eagerState=i,Me(i,o)){var s=t.interleaved;s===null?(l.next=l,co(t)):(l.next=s.next,s.next=l),t.interleaved=l;return}}catch{}finally{}n=la(e,t,l,r),n!==null&&(l=oe(),Oe(n,e,r,l),xa(n,t,r))}}function Ca(e){var t=e.alternate;return e===A||t!==null&&t===A}function _a(e,t){Pn=Yr=!0;var n=e.pending;n===null?t.next=t:(t.next=n.next,n.next=t),e.pending=t}function xa(e,t,n){if(n&4194240){var r=t.lanes;r&=e.pendingLanes,n|=r,t.lanes=n,Zu(e,n)}}var Xr={readContext:_e,useCallback:te,useContext:te,useEffect:te,useImperativeHandle:te,useInsertionEffect:te,useLayoutEffect:te,useMemo:te,useReducer:te,useRef:te,useState:te,useDebugValue:te,useDeferredValue:te,useTransition:te,useMutableSource:te,useSyncExternalStore:te,useId:te,unstable_isNewReconciler:!1},nd={readContext:_e,useCallback:function(e,t){return je().memoizedState=[e,t===void 0?null:t],e},useContext:_e,useEffect:ki,useImperativeHandle:function(e,t,n){return n=n!=null?n.concat([e]):null,xr(4194308,4,ya.bind(null,t,e),n)},useLayoutEffect:function(e,t){return xr(4194308,4,e,t)},useInsertionEffect:function(e,t){return xr(4,2,e,t)},useMemo:function(e,t){var n=je();return t=t===void 0?null:t,e=e(),n.memoizedState=[e,t],e},useReducer:function(e,t,n){var r=je();return t=n!==void 0?n(t):t,r.memoizedState=r.baseState=t,e={pending:null,interleaved:null,lanes:0,dispatch:null,lastRenderedReducer:e,lastRenderedState:t},r.queue=e,e=e.dispatch=ed.bind(null,A,e),[r.memoizedState,e]},useRef:function(e){var t=je();return e={current:e},t.memoizedState=e},useState:Si,useDebugValue:So,useDeferredValue:function(e){return je().memoizedState=e},useTransition:function(){var e=Si(!1),t=e[0];return e=bf.bind(null,e[1]),je().memoizedState=e,[t,e]},useMutableSource:function(){},useSyncExternalStore:function(e,t,n){var r=A,l=je();if(F){if(n===void 0)throw Error(y(407));n=n()}else{if(n=t(),Z===null)throw Error(y(349));Nt&30||aa(r,t,n)}l.memoizedState=n;var u={value:n,getSnapshot:t};return l.queue=u,ki(fa.bind(null,r,u,e),[e]),r.flags|=2048,Kn(9,ca.bind(null,r,u,n,t),void 0,null),n},useId:function(){var e=je(),t=Z.identifierPrefix;if(F){var n=Be,r=$e;n=(r&~(1<<32-Re(r)-1)).toString(32)+n,t=":"+t+"R"+n,n=Wn++,0<n&&(t+="H"+n.toString(32)),t+=":"}else n=qf++,t=":"+t+"r"+n.toString(32)+":";return e.memoizedState=t},unstable_isNewReconciler:!1},rd={readContext:_e,useCallback:wa,useContext:_e,useEffect:wo,useImperativeHandle:ga,useInsertionEffect:ha,useLayoutEffect:va,useMemo:Sa,useReducer:Al,useRef:ma,useState:function(){return Al(Qn)},useDebugValue:So,useDeferredValue:function(e){var t=xe();return ka(t,K.memoizedState,e)},useTransition:function(){var e=Al(Qn)[0],t=xe().memoizedState;return[e,t]},useMutableSource:ia,useSyncExternalStore:sa,useId:Ea,unstable_isNewReconciler:!1},ld={readContext:_e,useCallback:wa,useContext:_e,useEffect:wo,useImperativeHandle:ga,useInsertionEffect:ha,useLayoutEffect:va,useMemo:Sa,useReducer:Vl,useRef:ma,useState:function(){return Vl(Qn)},useDebugValue:So,useDeferredValue:function(e){var t=xe();return K===null?t.memoizedState=e:ka(t,K.memoizedState,e)},useTransition:function(){var e=Vl(Qn)[0],t=xe().memoizedState;return[e,t]},useMutableSource:ia,useSyncExternalStore:sa,useId:Ea,unstable_isNewReconciler:!1};function ze(e,t){if(e&&e.defaultProps){t=V({},t),e=e.defaultProps;for(var n in e)t[n]===void 0&&(t[n]=e[n]);return t}return t}function Eu(e,t,n,r){t=e.memoizedState,n=n(r,t),n=n==null?t:V({},t,n),e.memoizedState=n,e.lanes===0&&(e.updateQueue.baseState=n)}var sl={isMounted:function(e){return(e=e._reactInternals)?Tt(e)===e:!1},enqueueSetState:function(e,t,n){e=e._reactInternals;var r=oe(),l=st(e),u=He(r,l);u.payload=t,n!=null&&(u.callback=n),t=ot(e,u,l),t!==null&&(Oe(t,e,l,r),Cr(t,e,l))},enqueueReplaceState:function(e,t,n){e=e._reactInternals;var r=oe(),l=st(e),u=He(r,l);u.tag=1,u.payload=t,n!=null&&(u.callback=n),t=ot(e,u,l),t!==null&&(Oe(t,e,l,r),Cr(t,e,l))},enqueueForceUpdate:function(e,t){e=e._reactInternals;var n=oe(),r=st(e),l=He(n,r);l.tag=2,t!=null&&(l.callback=t),t=ot(e,l,r),t!==null&&(Oe(t,e,r,n),Cr(t,e,r))}};function Ei(e,t,n,r,l,u,o){return e=e.stateNode,typeof e.shouldComponentUpdate=="function"?e.shouldComponentUpdate(r,u,o):t.prototype&&t.prototype.isPureReactComponent?!Un(n,r)||!Un(l,u):!0}function Na(e,t,n){var r=!1,l=ft,u=t.contextType;return typeof u=="object"&&u!==null?u=_e(u):(l=de(t)?_t:le.current,r=t.contextTypes,u=(r=r!=null)?qt(e,l):ft),t=new t(n,u),e.memoizedState=t.state!==null&&t.state!==void 0?t.state:null,t.updater=sl,e.stateNode=t,t._reactInternals=e,r&&(e=e.stateNode,e.__reactInternalMemoizedUnmaskedChildContext=l,e.__reactInternalMemoizedMaskedChildContext=u),t}function Ci(e,t,n,r){e=t.state,typeof t.componentWillReceiveProps=="function"&&t.componentWillReceiveProps(n,r),typeof t.UNSAFE_componentWillReceiveProps=="function"&&t.UNSAFE_componentWillReceiveProps(n,r),t.state!==e&&sl.enqueueReplaceState(t,t.state,null)}function Cu(e,t,n,r){var l=e.stateNode;l.props=n,l.state=e.memoizedState,l.refs={},fo(e);var u=t.contextType;typeof u=="object"&&u!==null?l.context=_e(u):(u=de(t)?_t:le.current,l.context=qt(e,u)),l.state=e.memoizedState,u=t.getDerivedStateFromProps,typeof u=="function"&&(Eu(e,t,u,n),l.state=e.memoizedState),typeof t.getDerivedStateFromProps=="function"||typeof l.getSnapshotBeforeUpdate=="function"||typeof l.UNSAFE_componentWillMount!="function"&&typeof l.componentWillMount!="function"||(t=l.state,typeof l.componentWillMount=="function"&&l.componentWillMount(),typeof l.UNSAFE_componentWillMount=="function"&&l.UNSAFE_componentWillMount(),t!==l.state&&sl.enqueueReplaceState(l,l.state,null),Qr(e,n,l,r),l.state=e.memoizedState),typeof l.componentDidMount=="function"&&(e.flags|=4194308)}function nn(e,t){try{var n="",r=t;do n+=Rc(r),r=r.return;while(r);var l=n}catch(u){l=`
 Error generating stack: `+u.message+`
-`+u.stack}return{value:e,source:t,stack:l,digest:null}}function $l(e,t,n){return{value:e,source:null,stack:n??null,digest:t??null}}function _u(e,t){try{console.error(t.value)}catch(n){setTimeout(function(){throw n})}}var ud=typeof WeakMap=="function"?WeakMap:Map;function Pa(e,t,n){n=He(-1,n),n.tag=3,n.payload={element:null};var r=t.value;return n.callback=function(){Zr||(Zr=!0,Du=r),_u(e,t)},n}function za(e,t,n){n=He(-1,n),n.tag=3;var r=e.type.getDerivedStateFromError;if(typeof r=="function"){var l=t.value;n.payload=function(){return r(l)},n.callback=function(){_u(e,t)}}var u=e.stateNode;return u!==null&&typeof u.componentDidCatch=="function"&&(n.callback=function(){_u(e,t),typeof r!="function"&&(it===null?it=new Set([this]):it.add(this));var o=t.stack;this.componentDidCatch(t.value,{componentStack:o!==null?o:""})}),n}function _i(e,t,n){var r=e.pingCache;if(r===null){r=e.pingCache=new ud;var l=new Set;r.set(t,l)}else l=r.get(t),l===void 0&&(l=new Set,r.set(t,l));l.has(n)||(l.add(n),e=wd.bind(null,e,t,n),t.then(e,e))}function xi(e){do{var t;if((t=e.tag===13)&&(t=e.memoizedState,t=t!==null?t.dehydrated!==null:!0),t)return e;e=e.return}while(e!==null);return null}function Ni(e,t,n,r,l){return e.mode&1?(e.flags|=65536,e.lanes=l,e):(e===t?e.flags|=65536:(e.flags|=128,n.flags|=131072,n.flags&=-52805,n.tag===1&&(n.alternate===null?n.tag=17:(t=He(-1,1),t.tag=2,ot(n,t,1))),n.lanes|=1),e)}var od=Xe.ReactCurrentOwner,ce=!1;function ue(e,t,n,r){t.child=e===null?ra(t,null,n,r):en(t,e.child,n,r)}function Pi(e,t,n,r,l){n=n.render;var u=t.ref;return Gt(t,l),r=yo(e,t,n,r,u,l),n=go(),e!==null&&!ce?(t.updateQueue=e.updateQueue,t.flags&=-2053,e.lanes&=~l,Ye(e,t,l)):(F&&n&&lo(t),t.flags|=1,ue(e,t,r,l),t.child)}function zi(e,t,n,r,l){if(e===null){var u=n.type;return typeof u=="function"&&!zo(u)&&u.defaultProps===void 0&&n.compare===null&&n.defaultProps===void 0?(t.tag=15,t.type=u,La(e,t,u,r,l)):(e=Lr(n.type,null,r,t,t.mode,l),e.ref=t.ref,e.return=t,t.child=e)}if(u=e.child,!(e.lanes&l)){var o=u.memoizedProps;if(n=n.compare,n=n!==null?n:Un,n(o,r)&&e.ref===t.ref)return Ye(e,t,l)}return t.flags|=1,e=at(u,r),e.ref=t.ref,e.return=t,t.child=e}function La(e,t,n,r,l){if(e!==null){var u=e.memoizedProps;if(Un(u,r)&&e.ref===t.ref)if(ce=!1,t.pendingProps=r=u,(e.lanes&l)!==0)e.flags&131072&&(ce=!0);else return t.lanes=e.lanes,Ye(e,t,l)}return xu(e,t,n,r,l)}function Ta(e,t,n){var r=t.pendingProps,l=r.children,u=e!==null?e.memoizedState:null;if(r.mode==="hidden")if(!(t.mode&1))t.memoizedState={baseLanes:0,cachePool:null,transitions:null},M(Ht,me),me|=n;else{if(!(n&1073741824))return e=u!==null?u.baseLanes|n:n,t.lanes=t.childLanes=1073741824,t.memoizedState={baseLanes:e,cachePool:null,transitions:null},t.updateQueue=null,M(Ht,me),me|=e,null;t.memoizedState={baseLanes:0,cachePool:null,transitions:null},r=u!==null?u.baseLanes:n,M(Ht,me),me|=r}else u!==null?(r=u.baseLanes|n,t.memoizedState=null):r=n,M(Ht,me),me|=r;return ue(e,t,l,n),t.child}function Ra(e,t){var n=t.ref;(e===null&&n!==null||e!==null&&e.ref!==n)&&(t.flags|=512,t.flags|=2097152)}function xu(e,t,n,r,l){var u=de(n)?_t:le.current;return u=qt(t,u),Gt(t,l),n=yo(e,t,n,r,u,l),r=go(),e!==null&&!ce?(t.updateQueue=e.updateQueue,t.flags&=-2053,e.lanes&=~l,Ye(e,t,l)):(F&&r&&lo(t),t.flags|=1,ue(e,t,n,l),t.child)}function Li(e,t,n,r,l){if(de(n)){var u=!0;Vr(t)}else u=!1;if(Gt(t,l),t.stateNode===null)Nr(e,t),Na(t,n,r),Cu(t,n,r,l),r=!0;else if(e===null){var o=t.stateNode,i=t.memoizedProps;o.props=i;var s=o.context,c=n.contextType;typeof c=="object"&&c!==null?c=_e(c):(c=de(n)?_t:le.current,c=qt(t,c));var h=n.getDerivedStateFromProps,m=typeof h=="function"||typeof o.getSnapshotBeforeUpdate=="function";m||typeof o.UNSAFE_componentWillReceiveProps!="function"&&typeof o.componentWillReceiveProps!="function"||(i!==r||s!==c)&&Ci(t,o,r,c),Je=!1;var p=t.memoizedState;o.state=p,Qr(t,r,o,l),s=t.memoizedState,i!==r||p!==s||fe.current||Je?(typeof h=="function"&&(Eu(t,n,h,r),s=t.memoizedState),(i=Je||Ei(t,n,i,r,p,s,c))?(m||typeof o.UNSAFE_componentWillMount!="function"&&typeof o.componentWillMount!="function"||(typeof o.componentWillMount=="function"&&o.componentWillMount(),typeof o.UNSAFE_componentWillMount=="function"&&o.UNSAFE_componentWillMount()),typeof o.componentDidMount=="function"&&(t.flags|=4194308)):(typeof o.componentDidMount=="function"&&(t.flags|=4194308),t.memoizedProps=r,t.memoizedState=s),o.props=r,o.state=s,o.context=c,r=i):(typeof o.componentDidMount=="function"&&(t.flags|=4194308),r=!1)}else{o=t.stateNode,ua(e,t),i=t.memoizedProps,c=t.type===t.elementType?i:ze(t.type,i),o.props=c,m=t.pendingProps,p=o.context,s=n.contextType,typeof s=="object"&&s!==null?s=_e(s):(s=de(n)?_t:le.current,s=qt(t,s));var g=n.getDerivedStateFromProps;(h=typeof g=="function"||typeof o.getSnapshotBeforeUpdate=="function")||typeof o.UNSAFE_componentWillReceiveProps!="function"&&typeof o.componentWillReceiveProps!="function"||(i!==m||p!==s)&&Ci(t,o,r,s),Je=!1,p=t.memoizedState,o.state=p,Qr(t,r,o,l);var w=t.memoizedState;i!==m||p!==w||fe.current||Je?(typeof g=="function"&&(Eu(t,n,g,r),w=t.memoizedState),(c=Je||Ei(t,n,c,r,p,w,s)||!1)?(h||typeof o.UNSAFE_componentWillUpdate!="function"&&typeof o.componentWillUpdate!="function"||(typeof o.componentWillUpdate=="function"&&o.componentWillUpdate(r,w,s),typeof o.UNSAFE_componentWillUpdate=="function"&&o.UNSAFE_componentWillUpdate(r,w,s)),typeof o.componentDidUpdate=="function"&&(t.flags|=4),typeof o.getSnapshotBeforeUpdate=="function"&&(t.flags|=1024)):(typeof o.componentDidUpdate!="function"||i===e.memoizedProps&&p===e.memoizedState||(t.flags|=4),typeof o.getSnapshotBeforeUpdate!="function"||i===e.memoizedProps&&p===e.memoizedState||(t.flags|=1024),t.memoizedProps=r,t.memoizedState=w),o.props=r,o.state=w,o.context=s,r=c):(typeof o.componentDidUpdate!="function"||i===e.memoizedProps&&p===e.memoizedState||(t.flags|=4),typeof o.getSnapshotBeforeUpdate!="function"||i===e.memoizedProps&&p===e.memoizedState||(t.flags|=1024),r=!1)}return Nu(e,t,n,r,u,l)}function Nu(e,t,n,r,l,u){Ra(e,t);var o=(t.flags&128)!==0;if(!r&&!o)return l&&mi(t,n,!1),Ye(e,t,u);r=t.stateNode,od.current=t;var i=o&&typeof n.getDerivedStateFromError!="function"?null:r.render();return t.flags|=1,e!==null&&o?(t.child=en(t,e.child,null,u),t.child=en(t,null,i,u)):ue(e,t,i,u),t.memoizedState=r.state,l&&mi(t,n,!0),t.child}function Oa(e){var t=e.stateNode;t.pendingContext?pi(e,t.pendingContext,t.pendingContext!==t.context):t.context&&pi(e,t.context,!1),po(e,t.containerInfo)}function Ti(e,t,n,r,l){return bt(),oo(l),t.flags|=256,ue(e,t,n,r),t.child}var Pu={dehydrated:null,treeContext:null,retryLane:0};function zu(e){return{baseLanes:e,cachePool:null,transitions:null}}function Ma(e,t,n){var r=t.pendingProps,l=U.current,u=!1,o=(t.flags&128)!==0,i;if((i=o)||(i=e!==null&&e.memoizedState===null?!1:(l&2)!==0),i?(u=!0,t.flags&=-129):(e===null||e.memoizedState!==null)&&(l|=1),M(U,l&1),e===null)return Su(t),e=t.memoizedState,e!==null&&(e=e.dehydrated,e!==null)?(t.mode&1?e.data==="$!"?t.lanes=8:t.lanes=1073741824:t.lanes=1,null):(o=r.children,e=r.fallback,u?(r=t.mode,u=t.child,o={mode:"hidden",children:o},!(r&1)&&u!==null?(u.childLanes=0,u.pendingProps=o):u=fl(o,r,0,null),e=Ct(e,r,n,null),u.return=t,e.return=t,u.sibling=e,t.child=u,t.child.memoizedState=zu(n),t.memoizedState=Pu,e):ko(t,o));if(l=e.memoizedState,l!==null&&(i=l.dehydrated,i!==null))return id(e,t,o,r,i,l,n);if(u){u=r.fallback,o=t.mode,l=e.child,i=l.sibling;var s={mode:"hidden",children:r.children};return!(o&1)&&t.child!==l?(r=t.child,r.childLanes=0,r.pendingProps=s,t.deletions=null):(r=at(l,s),r.subtreeFlags=l.subtreeFlags&14680064),i!==null?u=at(i,u):(u=Ct(u,o,n,null),u.flags|=2),u.return=t,r.return=t,r.sibling=u,t.child=r,r=u,u=t.child,o=e.child.memoizedState,o=o===null?zu(n):{baseLanes:o.baseLanes|n,cachePool:null,transitions:o.transitions},u.memoizedState=o,u.childLanes=e.childLanes&~n,t.memoizedState=Pu,r}return u=e.child,e=u.sibling,r=at(u,{mode:"visible",children:r.children}),!(t.mode&1)&&(r.lanes=n),r.return=t,r.sibling=null,e!==null&&(n=t.deletions,n===null?(t.deletions=[e],t.flags|=16):n.push(e)),t.child=r,t.memoizedState=null,r}function ko(e,t){return t=fl({mode:"visible",children:t},e.mode,0,null),t.return=e,e.child=t}function mr(e,t,n,r){return r!==null&&oo(r),en(t,e.child,null,n),e=ko(t,t.pendingProps.children),e.flags|=2,t.memoizedState=null,e}function id(e,t,n,r,l,u,o){if(n)return t.flags&256?(t.flags&=-257,r=$l(Error(y(422))),mr(e,t,o,r)):t.memoizedState!==null?(t.child=e.child,t.flags|=128,null):(u=r.fallback,l=t.mode,r=fl({mode:"visible",children:r.children},l,0,null),u=Ct(u,l,o,null),u.flags|=2,r.return=t,u.return=t,r.sibling=u,t.child=r,t.mode&1&&en(t,e.child,null,o),t.child.memoizedState=zu(o),t.memoizedState=Pu,u);if(!(t.mode&1))return mr(e,t,o,null);if(l.data==="$!"){if(r=l.nextSibling&&l.nextSibling.dataset,r)var i=r.dgst;return r=i,u=Error(y(419)),r=$l(u,r,void 0),mr(e,t,o,r)}if(i=(o&e.childLanes)!==0,ce||i){if(r=Z,r!==null){switch(o&-o){case 4:l=2;break;case 16:l=8;break;case 64:case 128:case 256:case 512:case 1024:case 2048:case 4096:case 8192:case 16384:case 32768:case 65536:case 131072:case 262144:case 524288:case 1048576:case 2097152:case 4194304:case 8388608:case 16777216:case 33554432:case 67108864:l=32;break;case 536870912:l=268435456;break;default:l=0}l=l&(r.suspendedLanes|o)?0:l,l!==0&&l!==u.retryLane&&(u.retryLane=l,Ke(e,l),Oe(r,e,l,-1))}return Po(),r=$l(Error(y(421))),mr(e,t,o,r)}return l.data==="$?"?(t.flags|=128,t.child=e.child,t=Sd.bind(null,e),l._reactRetry=t,null):(e=u.treeContext,he=ut(l.nextSibling),ve=t,F=!0,Te=null,e!==null&&(Se[ke++]=$e,Se[ke++]=Be,Se[ke++]=xt,$e=e.id,Be=e.overflow,xt=t),t=ko(t,r.children),t.flags|=4096,t)}function Ri(e,t,n){e.lanes|=t;var r=e.alternate;r!==null&&(r.lanes|=t),ku(e.return,t,n)}function Bl(e,t,n,r,l){var u=e.memoizedState;u===null?e.memoizedState={isBackwards:t,rendering:null,renderingStartTime:0,last:r,tail:n,tailMode:l}:(u.isBackwards=t,u.rendering=null,u.renderingStartTime=0,u.last=r,u.tail=n,u.tailMode=l)}function Da(e,t,n){var r=t.pendingProps,l=r.revealOrder,u=r.tail;if(ue(e,t,r.children,n),r=U.current,r&2)r=r&1|2,t.flags|=128;else{if(e!==null&&e.flags&128)e:for(e=t.child;e!==null;){if(e.tag===13)e.memoizedState!==null&&Ri(e,n,t);else if(e.tag===19)Ri(e,n,t);else if(e.child!==null){e.child.return=e,e=e.child;continue}if(e===t)break e;for(;e.sibling===null;){if(e.return===null||e.return===t)break e;e=e.return}e.sibling.return=e.return,e=e.sibling}r&=1}if(M(U,r),!(t.mode&1))t.memoizedState=null;else switch(l){case"forwards":for(n=t.child,l=null;n!==null;)e=n.alternate,e!==null&&Kr(e)===null&&(l=n),n=n.sibling;n=l,n===null?(l=t.child,t.child=null):(l=n.sibling,n.sibling=null),Bl(t,!1,l,n,u);break;case"backwards":for(n=null,l=t.child,t.child=null;l!==null;){if(e=l.alternate,e!==null&&Kr(e)===null){t.child=l;break}e=l.sibling,l.sibling=n,n=l,l=e}Bl(t,!0,n,null,u);break;case"together":Bl(t,!1,null,null,void 0);break;default:t.memoizedState=null}return t.child}function Nr(e,t){!(t.mode&1)&&e!==null&&(e.alternate=null,t.alternate=null,t.flags|=2)}function Ye(e,t,n){if(e!==null&&(t.dependencies=e.dependencies),Pt|=t.lanes,!(n&t.childLanes))return null;if(e!==null&&t.child!==e.child)throw Error(y(153));if(t.child!==null){for(e=t.child,n=at(e,e.pendingProps),t.child=n,n.return=t;e.sibling!==null;)e=e.sibling,n=n.sibling=at(e,e.pendingProps),n.return=t;n.sibling=null}return t.child}function sd(e,t,n){switch(t.tag){case 3:Oa(t),bt();break;case 5:oa(t);break;case 1:de(t.type)&&Vr(t);break;case 4:po(t,t.stateNode.containerInfo);break;case 10:var r=t.type._context,l=t.memoizedProps.value;M(Hr,r._currentValue),r._currentValue=l;break;case 13:if(r=t.memoizedState,r!==null)return r.dehydrated!==null?(M(U,U.current&1),t.flags|=128,null):n&t.child.childLanes?Ma(e,t,n):(M(U,U.current&1),e=Ye(e,t,n),e!==null?e.sibling:null);M(U,U.current&1);break;case 19:if(r=(n&t.childLanes)!==0,e.flags&128){if(r)return Da(e,t,n);t.flags|=128}if(l=t.memoizedState,l!==null&&(l.rendering=null,l.tail=null,l.lastEffect=null),M(U,U.current),r)break;return null;case 22:case 23:return t.lanes=0,Ta(e,t,n)}return Ye(e,t,n)}var ja,Lu,Ia,Fa;ja=function(e,t){for(var n=t.child;n!==null;){if(n.tag===5||n.tag===6)e.appendChild(n.stateNode);else if(n.tag!==4&&n.child!==null){n.child.return=n,n=n.child;continue}if(n===t)break;for(;n.sibling===null;){if(n.return===null||n.return===t)return;n=n.return}n.sibling.return=n.return,n=n.sibling}};Lu=function(){};Ia=function(e,t,n,r){var l=e.memoizedProps;if(l!==r){e=t.stateNode,kt(Ue.current);var u=null;switch(n){case"input":l=Jl(e,l),r=Jl(e,r),u=[];break;case"select":l=V({},l,{value:void 0}),r=V({},r,{value:void 0}),u=[];break;case"textarea":l=eu(e,l),r=eu(e,r),u=[];break;default:typeof l.onClick!="function"&&typeof r.onClick=="function"&&(e.onclick=Ur)}nu(n,r);var o;n=null;for(c in l)if(!r.hasOwnProperty(c)&&l.hasOwnProperty(c)&&l[c]!=null)if(c==="style"){var i=l[c];for(o in i)i.hasOwnProperty(o)&&(n||(n={}),n[o]="")}else c!=="dangerouslySetInnerHTML"&&c!=="children"&&c!=="suppressContentEditableWarning"&&c!=="suppressHydrationWarning"&&c!=="autoFocus"&&(Rn.hasOwnProperty(c)?u||(u=[]):(u=u||[]).push(c,null));for(c in r){var s=r[c];if(i=l!=null?l[c]:void 0,r.hasOwnProperty(c)&&s!==i&&(s!=null||i!=null))if(c==="style")if(i){for(o in i)!i.hasOwnProperty(o)||s&&s.hasOwnProperty(o)||(n||(n={}),n[o]="");for(o in s)s.hasOwnProperty(o)&&i[o]!==s[o]&&(n||(n={}),n[o]=s[o])}else n||(u||(u=[]),u.push(c,n)),n=s;else c==="dangerouslySetInnerHTML"?(s=s?s.__html:void 0,i=i?i.__html:void 0,s!=null&&i!==s&&(u=u||[]).push(c,s)):c==="children"?typeof s!="string"&&typeof s!="number"||(u=u||[]).push(c,""+s):c!=="suppressContentEditableWarning"&&c!=="suppressHydrationWarning"&&(Rn.hasOwnProperty(c)?(s!=null&&c==="onScroll"&&D("scroll",e),u||i===s||(u=[])):(u=u||[]).push(c,s))}n&&(u=u||[]).push("style",n);var c=u;(t.updateQueue=c)&&(t.flags|=4)}};Fa=function(e,t,n,r){n!==r&&(t.flags|=4)};function vn(e,t){if(!F)switch(e.tailMode){case"hidden":t=e.tail;for(var n=null;t!==null;)t.alternate!==null&&(n=t),t=t.sibling;n===null?e.tail=null:n.sibling=null;break;case"collapsed":n=e.tail;for(var r=null;n!==null;)n.alternate!==null&&(r=n),n=n.sibling;r===null?t||e.tail===null?e.tail=null:e.tail.sibling=null:r.sibling=null}}function ne(e){var t=e.alternate!==null&&e.alternate.child===e.child,n=0,r=0;if(t)for(var l=e.child;l!==null;)n|=l.lanes|l.childLanes,r|=l.subtreeFlags&14680064,r|=l.flags&14680064,l.return=e,l=l.sibling;else for(l=e.child;l!==null;)n|=l.lanes|l.childLanes,r|=l.subtreeFlags,r|=l.flags,l.return=e,l=l.sibling;return e.subtreeFlags|=r,e.childLanes=n,t}function ad(e,t,n){var r=t.pendingProps;switch(uo(t),t.tag){case 2:case 16:case 15:case 0:case 11:case 7:case 8:case 12:case 9:case 14:return ne(t),null;case 1:return de(t.type)&&Ar(),ne(t),null;case 3:return r=t.stateNode,tn(),j(fe),j(le),ho(),r.pendingContext&&(r.context=r.pendingContext,r.pendingContext=null),(e===null||e.child===null)&&(dr(t)?t.flags|=4:e===null||e.memoizedState.isDehydrated&&!(t.flags&256)||(t.flags|=1024,Te!==null&&(Fu(Te),Te=null))),Lu(e,t),ne(t),null;case 5:mo(t);var l=kt(Hn.current);if(n=t.type,e!==null&&t.stateNode!=null)Ia(e,t,n,r,l),e.ref!==t.ref&&(t.flags|=512,t.flags|=2097152);else{if(!r){if(t.stateNode===null)throw Error(y(166));return ne(t),null}if(e=kt(Ue.current),dr(t)){r=t.stateNode,n=t.type;var u=t.memoizedProps;switch(r[Ie]=t,r[$n]=u,e=(t.mode&1)!==0,n){case"dialog":D("cancel",r),D("close",r);break;case"iframe":case"object":case"embed":D("load",r);break;case"video":case"audio":for(l=0;l<kn.length;l++)D(kn[l],r);break;case"source":D("error",r);break;case"img":case"image":case"link":D("error",r),D("load",r);break;case"details":D("toggle",r);break;case"input":Vo(r,u),D("invalid",r);break;case"select":r._wrapperState={wasMultiple:!!u.multiple},D("invalid",r);break;case"textarea":Bo(r,u),D("invalid",r)}nu(n,u),l=null;for(var o in u)if(u.hasOwnProperty(o)){var i=u[o];o==="children"?typeof i=="string"?r.textContent!==i&&(u.suppressHydrationWarning!==!0&&fr(r.textContent,i,e),l=["children",i]):typeof i=="number"&&r.textContent!==""+i&&(u.suppressHydrationWarning!==!0&&fr(r.textContent,i,e),l=["children",""+i]):Rn.hasOwnProperty(o)&&i!=null&&o==="onScroll"&&D("scroll",r)}switch(n){case"input":rr(r),$o(r,u,!0);break;case"textarea":rr(r),Ho(r);break;case"select":case"option":break;default:typeof u.onClick=="function"&&(r.onclick=Ur)}r=l,t.updateQueue=r,r!==null&&(t.flags|=4)}else{o=l.nodeType===9?l:l.ownerDocument,e==="http://www.w3.org/1999/xhtml"&&(e=fs(n)),e==="http://www.w3.org/1999/xhtml"?n==="script"?(e=o.createElement("div"),e.innerHTML="<script><\/script>",e=e.removeChild(e.firstChild)):typeof r.is=="string"?e=o.createElement(n,{is:r.is}):(e=o.createElement(n),n==="select"&&(o=e,r.multiple?o.multiple=!0:r.size&&(o.size=r.size))):e=o.createElementNS(e,n),e[Ie]=t,e[$n]=r,ja(e,t,!1,!1),t.stateNode=e;e:{switch(o=ru(n,r),n){case"dialog":D("cancel",e),D("close",e),l=r;break;case"iframe":case"object":case"embed":D("load",e),l=r;break;case"video":case"audio":for(l=0;l<kn.length;l++)D(kn[l],e);l=r;break;case"source":D("error",e),l=r;break;case"img":case"image":case"link":D("error",e),D("load",e),l=r;break;case"details":D("toggle",e),l=r;break;case"input":Vo(e,r),l=Jl(e,r),D("invalid",e);break;case"option":l=r;break;case"select":e._wrapperState={wasMultiple:!!r.multiple},l=V({},r,{value:void 0}),D("invalid",e);break;case"textarea":Bo(e,r),l=eu(e,r),D("invalid",e);break;default:l=r}nu(n,l),i=l;for(u in i)if(i.hasOwnProperty(u)){var s=i[u];u==="style"?ms(e,s):u==="dangerouslySetInnerHTML"?(s=s?s.__html:void 0,s!=null&&ds(e,s)):u==="children"?typeof s=="string"?(n!=="textarea"||s!=="")&&On(e,s):typeof s=="number"&&On(e,""+s):u!=="suppressContentEditableWarning"&&u!=="suppressHydrationWarning"&&u!=="autoFocus"&&(Rn.hasOwnProperty(u)?s!=null&&u==="onScroll"&&D("scroll",e):s!=null&&Wu(e,u,s,o))}switch(n){case"input":rr(e),$o(e,r,!1);break;case"textarea":rr(e),Ho(e);break;case"option":r.value!=null&&e.setAttribute("value",""+ct(r.value));break;case"select":e.multiple=!!r.multiple,u=r.value,u!=null?Qt(e,!!r.multiple,u,!1):r.defaultValue!=null&&Qt(e,!!r.multiple,r.defaultValue,!0);break;default:typeof l.onClick=="function"&&(e.onclick=Ur)}switch(n){case"button":case"input":case"select":case"textarea":r=!!r.autoFocus;break e;case"img":r=!0;break e;default:r=!1}}r&&(t.flags|=4)}t.ref!==null&&(t.flags|=512,t.flags|=2097152)}return ne(t),null;case 6:if(e&&t.stateNode!=null)Fa(e,t,e.memoizedProps,r);else{if(typeof r!="string"&&t.stateNode===null)throw Error(y(166));if(n=kt(Hn.current),kt(Ue.current),dr(t)){if(r=t.stateNode,n=t.memoizedProps,r[Ie]=t,(u=r.nodeValue!==n)&&(e=ve,e!==null))switch(e.tag){case 3:fr(r.nodeValue,n,(e.mode&1)!==0);break;case 5:e.memoizedProps.suppressHydrationWarning!==!0&&fr(r.nodeValue,n,(e.mode&1)!==0)}u&&(t.flags|=4)}else r=(n.nodeType===9?n:n.ownerDocument).createTextNode(r),r[Ie]=t,t.stateNode=r}return ne(t),null;case 13:if(j(U),r=t.memoizedState,e===null||e.memoizedState!==null&&e.memoizedState.dehydrated!==null){if(F&&he!==null&&t.mode&1&&!(t.flags&128))ta(),bt(),t.flags|=98560,u=!1;else if(u=dr(t),r!==null&&r.dehydrated!==null){if(e===null){if(!u)throw Error(y(318));if(u=t.memoizedState,u=u!==null?u.dehydrated:null,!u)throw Error(y(317));u[Ie]=t}else bt(),!(t.flags&128)&&(t.memoizedState=null),t.flags|=4;ne(t),u=!1}else Te!==null&&(Fu(Te),Te=null),u=!0;if(!u)return t.flags&65536?t:null}return t.flags&128?(t.lanes=n,t):(r=r!==null,r!==(e!==null&&e.memoizedState!==null)&&r&&(t.child.flags|=8192,t.mode&1&&(e===null||U.current&1?Y===0&&(Y=3):Po())),t.updateQueue!==null&&(t.flags|=4),ne(t),null);case 4:return tn(),Lu(e,t),e===null&&An(t.stateNode.containerInfo),ne(t),null;case 10:return ao(t.type._context),ne(t),null;case 17:return de(t.type)&&Ar(),ne(t),null;case 19:if(j(U),u=t.memoizedState,u===null)return ne(t),null;if(r=(t.flags&128)!==0,o=u.rendering,o===null)if(r)vn(u,!1);else{if(Y!==0||e!==null&&e.flags&128)for(e=t.child;e!==null;){if(o=Kr(e),o!==null){for(t.flags|=128,vn(u,!1),r=o.updateQueue,r!==null&&(t.updateQueue=r,t.flags|=4),t.subtreeFlags=0,r=n,n=t.child;n!==null;)u=n,e=r,u.flags&=14680066,o=u.alternate,o===null?(u.childLanes=0,u.lanes=e,u.child=null,u.subtreeFlags=0,u.memoizedProps=null,u.memoizedState=null,u.updateQueue=null,u.dependencies=null,u.stateNode=null):(u.childLanes=o.childLanes,u.lanes=o.lanes,u.child=o.child,u.subtreeFlags=0,u.deletions=null,u.memoizedProps=o.memoizedProps,u.memoizedState=o.memoizedState,u.updateQueue=o.updateQueue,u.type=o.type,e=o.dependencies,u.dependencies=e===null?null:{lanes:e.lanes,firstContext:e.firstContext}),n=n.sibling;return M(U,U.current&1|2),t.child}e=e.sibling}u.tail!==null&&W()>rn&&(t.flags|=128,r=!0,vn(u,!1),t.lanes=4194304)}else{if(!r)if(e=Kr(o),e!==null){if(t.flags|=128,r=!0,n=e.updateQueue,n!==null&&(t.updateQueue=n,t.flags|=4),vn(u,!0),u.tail===null&&u.tailMode==="hidden"&&!o.alternate&&!F)return ne(t),null}else 2*W()-u.renderingStartTime>rn&&n!==1073741824&&(t.flags|=128,r=!0,vn(u,!1),t.lanes=4194304);u.isBackwards?(o.sibling=t.child,t.child=o):(n=u.last,n!==null?n.sibling=o:t.child=o,u.last=o)}return u.tail!==null?(t=u.tail,u.rendering=t,u.tail=t.sibling,u.renderingStartTime=W(),t.sibling=null,n=U.current,M(U,r?n&1|2:n&1),t):(ne(t),null);case 22:case 23:return No(),r=t.memoizedState!==null,e!==null&&e.memoizedState!==null!==r&&(t.flags|=8192),r&&t.mode&1?me&1073741824&&(ne(t),t.subtreeFlags&6&&(t.flags|=8192)):ne(t),null;case 24:return null;case 25:return null}throw Error(y(156,t.tag))}function cd(e,t){switch(uo(t),t.tag){case 1:return de(t.type)&&Ar(),e=t.flags,e&65536?(t.flags=e&-65537|128,t):null;case 3:return tn(),j(fe),j(le),ho(),e=t.flags,e&65536&&!(e&128)?(t.flags=e&-65537|128,t):null;case 5:return mo(t),null;case 13:if(j(U),e=t.memoizedState,e!==null&&e.dehydrated!==null){if(t.alternate===null)throw Error(y(340));bt()}return e=t.flags,e&65536?(t.flags=e&-65537|128,t):null;case 19:return j(U),null;case 4:return tn(),null;case 10:return ao(t.type._context),null;case 22:case 23:return No(),null;case 24:return null;default:return null}}var hr=!1,re=!1,fd=typeof WeakSet=="function"?WeakSet:Set,k=null;function Bt(e,t){var n=e.ref;if(n!==null)if(typeof n=="function")try{n(null)}catch(r){$(e,t,r)}else n.current=null}function Tu(e,t,n){try{n()}catch(r){$(e,t,r)}}var Oi=!1;function dd(e,t){if(pu=jr,e=Bs(),ro(e)){if("selectionStart"in e)var n={start:e.selectionStart,end:e.selectionEnd};else e:{n=(n=e.ownerDocument)&&n.defaultView||window;var r=n.getSelection&&n.getSelection();if(r&&r.rangeCount!==0){n=r.anchorNode;var l=r.anchorOffset,u=r.focusNode;r=r.focusOffset;try{n.nodeType,u.nodeType}catch{n=null;break e}var o=0,i=-1,s=-1,c=0,h=0,m=e,p=null;t:for(;;){for(var g;m!==n||l!==0&&m.nodeType!==3||(i=o+l),m!==u||r!==0&&m.nodeType!==3||(s=o+r),m.nodeType===3&&(o+=m.nodeValue.length),(g=m.firstChild)!==null;)p=m,m=g;for(;;){if(m===e)break t;if(p===n&&++c===l&&(i=o),p===u&&++h===r&&(s=o),(g=m.nextSibling)!==null)break;m=p,p=m.parentNode}m=g}n=i===-1||s===-1?null:{start:i,end:s}}else n=null}n=n||{start:0,end:0}}else n=null;for(mu={focusedElem:e,selectionRange:n},jr=!1,k=t;k!==null;)if(t=k,e=t.child,(t.subtreeFlags&1028)!==0&&e!==null)e.return=t,k=e;else for(;k!==null;){t=k;try{var w=t.alternate;if(t.flags&1024)switch(t.tag){case 0:case 11:case 15:break;case 1:if(w!==null){var S=w.memoizedProps,I=w.memoizedState,f=t.stateNode,a=f.getSnapshotBeforeUpdate(t.elementType===t.type?S:ze(t.type,S),I);f.__reactInternalSnapshotBeforeUpdate=a}break;case 3:var d=t.stateNode.containerInfo;d.nodeType===1?d.textContent="":d.nodeType===9&&d.documentElement&&d.removeChild(d.documentElement);break;case 5:case 6:case 4:case 17:break;default:throw Error(y(163))}}catch(v){$(t,t.return,v)}if(e=t.sibling,e!==null){e.return=t.return,k=e;break}k=t.return}return w=Oi,Oi=!1,w}function zn(e,t,n){var r=t.updateQueue;if(r=r!==null?r.lastEffect:null,r!==null){var l=r=r.next;do{if((l.tag&e)===e){var u=l.destroy;l.destroy=void 0,u!==void 0&&Tu(t,n,u)}l=l.next}while(l!==r)}}function al(e,t){if(t=t.updateQueue,t=t!==null?t.lastEffect:null,t!==null){var n=t=t.next;do{if((n.tag&e)===e){var r=n.create;n.destroy=r()}n=n.next}while(n!==t)}}function Ru(e){var t=e.ref;if(t!==null){var n=e.stateNode;switch(e.tag){case 5:e=n;break;default:e=n}typeof t=="function"?t(e):t.current=e}}function Ua(e){var t=e.alternate;t!==null&&(e.alternate=null,Ua(t)),e.child=null,e.deletions=null,e.sibling=null,e.tag===5&&(t=e.stateNode,t!==null&&(delete t[Ie],delete t[$n],delete t[yu],delete t[Xf],delete t[Gf])),e.stateNode=null,e.return=null,e.dependencies=null,e.memoizedProps=null,e.memoizedState=null,e.pendingProps=null,e.stateNode=null,e.updateQueue=null}function Aa(e){return e.tag===5||e.tag===3||e.tag===4}function Mi(e){e:for(;;){for(;e.sibling===null;){if(e.return===null||Aa(e.return))return null;e=e.return}for(e.sibling.return=e.return,e=e.sibling;e.tag!==5&&e.tag!==6&&e.tag!==18;){if(e.flags&2||e.child===null||e.tag===4)continue e;e.child.return=e,e=e.child}if(!(e.flags&2))return e.stateNode}}function Ou(e,t,n){var r=e.tag;if(r===5||r===6)e=e.stateNode,t?n.nodeType===8?n.parentNode.insertBefore(e,t):n.insertBefore(e,t):(n.nodeType===8?(t=n.parentNode,t.insertBefore(e,n)):(t=n,t.appendChild(e)),n=n._reactRootContainer,n!=null||t.onclick!==null||(t.onclick=Ur));else if(r!==4&&(e=e.child,e!==null))for(Ou(e,t,n),e=e.sibling;e!==null;)Ou(e,t,n),e=e.sibling}function Mu(e,t,n){var r=e.tag;if(r===5||r===6)e=e.stateNode,t?n.insertBefore(e,t):n.appendChild(e);else if(r!==4&&(e=e.child,e!==null))for(Mu(e,t,n),e=e.sibling;e!==null;)Mu(e,t,n),e=e.sibling}var q=null,Le=!1;function Ge(e,t,n){for(n=n.child;n!==null;)Va(e,t,n),n=n.sibling}function Va(e,t,n){if(Fe&&typeof Fe.onCommitFiberUnmount=="function")try{Fe.onCommitFiberUnmount(tl,n)}catch{}switch(n.tag){case 5:re||Bt(n,t);case 6:var r=q,l=Le;q=null,Ge(e,t,n),q=r,Le=l,q!==null&&(Le?(e=q,n=n.stateNode,e.nodeType===8?e.parentNode.removeChild(n):e.removeChild(n)):q.removeChild(n.stateNode));break;case 18:q!==null&&(Le?(e=q,n=n.stateNode,e.nodeType===8?jl(e.parentNode,n):e.nodeType===1&&jl(e,n),In(e)):jl(q,n.stateNode));break;case 4:r=q,l=Le,q=n.stateNode.containerInfo,Le=!0,Ge(e,t,n),q=r,Le=l;break;case 0:case 11:case 14:case 15:if(!re&&(r=n.updateQueue,r!==null&&(r=r.lastEffect,r!==null))){l=r=r.next;do{var u=l,o=u.destroy;u=u.tag,o!==void 0&&(u&2||u&4)&&Tu(n,t,o),l=l.next}while(l!==r)}Ge(e,t,n);break;case 1:if(!re&&(Bt(n,t),r=n.stateNode,typeof r.componentWillUnmount=="function"))try{r.props=n.memoizedProps,r.state=n.memoizedState,r.componentWillUnmount()}catch(i){$(n,t,i)}Ge(e,t,n);break;case 21:Ge(e,t,n);break;case 22:n.mode&1?(re=(r=re)||n.memoizedState!==null,Ge(e,t,n),re=r):Ge(e,t,n);break;default:Ge(e,t,n)}}function Di(e){var t=e.updateQueue;if(t!==null){e.updateQueue=null;var n=e.stateNode;n===null&&(n=e.stateNode=new fd),t.forEach(function(r){var l=kd.bind(null,e,r);n.has(r)||(n.add(r),r.then(l,l))})}}function Pe(e,t){var n=t.deletions;if(n!==null)for(var r=0;r<n.length;r++){var l=n[r];try{var u=e,o=t,i=o;e:for(;i!==null;){switch(i.tag){case 5:q=i.stateNode,Le=!1;break e;case 3:q=i.stateNode.containerInfo,Le=!0;break e;case 4:q=i.stateNode.containerInfo,Le=!0;break e}i=i.return}if(q===null)throw Error(y(160));Va(u,o,l),q=null,Le=!1;var s=l.alternate;s!==null&&(s.return=null),l.return=null}catch(c){$(l,t,c)}}if(t.subtreeFlags&12854)for(t=t.child;t!==null;)$a(t,e),t=t.sibling}function $a(e,t){var n=e.alternate,r=e.flags;switch(e.tag){case 0:case 11:case 14:case 15:if(Pe(t,e),De(e),r&4){try{zn(3,e,e.return),al(3,e)}catch(S){$(e,e.return,S)}try{zn(5,e,e.return)}catch(S){$(e,e.return,S)}}break;case 1:Pe(t,e),De(e),r&512&&n!==null&&Bt(n,n.return);break;case 5:if(Pe(t,e),De(e),r&512&&n!==null&&Bt(n,n.return),e.flags&32){var l=e.stateNode;try{On(l,"")}catch(S){$(e,e.return,S)}}if(r&4&&(l=e.stateNode,l!=null)){var u=e.memoizedProps,o=n!==null?n.memoizedProps:u,i=e.type,s=e.updateQueue;if(e.updateQueue=null,s!==null)try{i==="input"&&u.type==="radio"&&u.name!=null&&as(l,u),ru(i,o);var c=ru(i,u);for(o=0;o<s.length;o+=2){var h=s[o],m=s[o+1];h==="style"?ms(l,m):h==="dangerouslySetInnerHTML"?ds(l,m):h==="children"?On(l,m):Wu(l,h,m,c)}switch(i){case"input":ql(l,u);break;case"textarea":cs(l,u);break;case"select":var p=l._wrapperState.wasMultiple;l._wrapperState.wasMultiple=!!u.multiple;var g=u.value;g!=null?Qt(l,!!u.multiple,g,!1):p!==!!u.multiple&&(u.defaultValue!=null?Qt(l,!!u.multiple,u.defaultValue,!0):Qt(l,!!u.multiple,u.multiple?[]:"",!1))}l[$n]=u}catch(S){$(e,e.return,S)}}break;case 6:if(Pe(t,e),De(e),r&4){if(e.stateNode===null)throw Error(y(162));l=e.stateNode,u=e.memoizedProps;try{l.nodeValue=u}catch(S){$(e,e.return,S)}}break;case 3:if(Pe(t,e),De(e),r&4&&n!==null&&n.memoizedState.isDehydrated)try{In(t.containerInfo)}catch(S){$(e,e.return,S)}break;case 4:Pe(t,e),De(e);break;case 13:Pe(t,e),De(e),l=e.child,l.flags&8192&&(u=l.memoizedState!==null,l.stateNode.isHidden=u,!u||l.alternate!==null&&l.alternate.memoizedState!==null||(_o=W())),r&4&&Di(e);break;case 22:if(h=n!==null&&n.memoizedState!==null,e.mode&1?(re=(c=re)||h,Pe(t,e),re=c):Pe(t,e),De(e),r&8192){if(c=e.memoizedState!==null,(e.stateNode.isHidden=c)&&!h&&e.mode&1)for(k=e,h=e.child;h!==null;){for(m=k=h;k!==null;){switch(p=k,g=p.child,p.tag){case 0:case 11:case 14:case 15:zn(4,p,p.return);break;case 1:Bt(p,p.return);var w=p.stateNode;if(typeof w.componentWillUnmount=="function"){r=p,n=p.return;try{t=r,w.props=t.memoizedProps,w.state=t.memoizedState,w.componentWillUnmount()}catch(S){$(r,n,S)}}break;case 5:Bt(p,p.return);break;case 22:if(p.memoizedState!==null){Ii(m);continue}}g!==null?(g.return=p,k=g):Ii(m)}h=h.sibling}e:for(h=null,m=e;;){if(m.tag===5){if(h===null){h=m;try{l=m.stateNode,c?(u=l.style,typeof u.setProperty=="function"?u.setProperty("display","none","important"):u.display="none"):(i=m.stateNode,s=m.memoizedProps.style,o=s!=null&&s.hasOwnProperty("display")?s.display:null,i.style.display=ps("display",o))}catch(S){$(e,e.return,S)}}}else if(m.tag===6){if(h===null)try{m.stateNode.nodeValue=c?"":m.memoizedProps}catch(S){$(e,e.return,S)}}else if((m.tag!==22&&m.tag!==23||m.memoizedState===null||m===e)&&m.child!==null){m.child.return=m,m=m.child;continue}if(m===e)break e;for(;m.sibling===null;){if(m.return===null||m.return===e)break e;h===m&&(h=null),m=m.return}h===m&&(h=null),m.sibling.return=m.return,m=m.sibling}}break;case 19:Pe(t,e),De(e),r&4&&Di(e);break;case 21:break;default:Pe(t,e),De(e)}}function De(e){var t=e.flags;if(t&2){try{e:{for(var n=e.return;n!==null;){if(Aa(n)){var r=n;break e}n=n.return}throw Error(y(160))}switch(r.tag){case 5:var l=r.stateNode;r.flags&32&&(On(l,""),r.flags&=-33);var u=Mi(e);Mu(e,u,l);break;case 3:case 4:var o=r.stateNode.containerInfo,i=Mi(e);Ou(e,i,o);break;default:throw Error(y(161))}}catch(s){$(e,e.return,s)}e.flags&=-3}t&4096&&(e.flags&=-4097)}function pd(e,t,n){k=e,Ba(e)}function Ba(e,t,n){for(var r=(e.mode&1)!==0;k!==null;){var l=k,u=l.child;if(l.tag===22&&r){var o=l.memoizedState!==null||hr;if(!o){var i=l.alternate,s=i!==null&&i.memoizedState!==null||re;i=hr;var c=re;if(hr=o,(re=s)&&!c)for(k=l;k!==null;)o=k,s=o.child,o.tag===22&&o.memoizedState!==null?Fi(l):s!==null?(s.return=o,k=s):Fi(l);for(;u!==null;)k=u,Ba(u),u=u.sibling;k=l,hr=i,re=c}ji(e)}else l.subtreeFlags&8772&&u!==null?(u.return=l,k=u):ji(e)}}function ji(e){for(;k!==null;){var t=k;if(t.flags&8772){var n=t.alternate;try{if(t.flags&8772)switch(t.tag){case 0:case 11:case 15:re||al(5,t);break;case 1:var r=t.stateNode;if(t.flags&4&&!re)if(n===null)r.componentDidMount();else{var l=t.elementType===t.type?n.memoizedProps:ze(t.type,n.memoizedProps);r.componentDidUpdate(l,n.memoizedState,r.__reactInternalSnapshotBeforeUpdate)}var u=t.updateQueue;u!==null&&wi(t,u,r);break;case 3:var o=t.updateQueue;if(o!==null){if(n=null,t.child!==null)switch(t.child.tag){case 5:n=t.child.stateNode;break;case 1:n=t.child.stateNode}wi(t,o,n)}break;case 5:var i=t.stateNode;if(n===null&&t.flags&4){n=i;var s=t.memoizedProps;switch(t.type){case"button":case"input":case"select":case"textarea":s.autoFocus&&n.focus();break;case"img":s.src&&(n.src=s.src)}}break;case 6:break;case 4:break;case 12:break;case 13:if(t.memoizedState===null){var c=t.alternate;if(c!==null){var h=c.memoizedState;if(h!==null){var m=h.dehydrated;m!==null&&In(m)}}}break;case 19:case 17:case 21:case 22:case 23:case 25:break;default:throw Error(y(163))}re||t.flags&512&&Ru(t)}catch(p){$(t,t.return,p)}}if(t===e){k=null;break}if(n=t.sibling,n!==null){n.return=t.return,k=n;break}k=t.return}}function Ii(e){for(;k!==null;){var t=k;if(t===e){k=null;break}var n=t.sibling;if(n!==null){n.return=t.return,k=n;break}k=t.return}}function Fi(e){for(;k!==null;){var t=k;try{switch(t.tag){case 0:case 11:case 15:var n=t.return;try{al(4,t)}catch(s){$(t,n,s)}break;case 1:var r=t.stateNode;if(typeof r.componentDidMount=="function"){var l=t.return;try{r.componentDidMount()}catch(s){$(t,l,s)}}var u=t.return;try{Ru(t)}catch(s){$(t,u,s)}break;case 5:var o=t.return;try{Ru(t)}catch(s){$(t,o,s)}}}catch(s){$(t,t.return,s)}if(t===e){k=null;break}var i=t.sibling;if(i!==null){i.return=t.return,k=i;break}k=t.return}}var md=Math.ceil,Gr=Xe.ReactCurrentDispatcher,Eo=Xe.ReactCurrentOwner,Ce=Xe.ReactCurrentBatchConfig,R=0,Z=null,Q=null,b=0,me=0,Ht=pt(0),Y=0,Yn=null,Pt=0,cl=0,Co=0,Ln=null,ae=null,_o=0,rn=1/0,Ae=null,Zr=!1,Du=null,it=null,vr=!1,tt=null,Jr=0,Tn=0,ju=null,Pr=-1,zr=0;function oe(){return R&6?W():Pr!==-1?Pr:Pr=W()}function st(e){return e.mode&1?R&2&&b!==0?b&-b:Jf.transition!==null?(zr===0&&(zr=Ns()),zr):(e=O,e!==0||(e=window.event,e=e===void 0?16:Ms(e.type)),e):1}function Oe(e,t,n,r){if(50<Tn)throw Tn=0,ju=null,Error(y(185));Gn(e,n,r),(!(R&2)||e!==Z)&&(e===Z&&(!(R&2)&&(cl|=n),Y===4&&be(e,b)),pe(e,r),n===1&&R===0&&!(t.mode&1)&&(rn=W()+500,ol&&mt()))}function pe(e,t){var n=e.callbackNode;Zc(e,t);var r=Dr(e,e===Z?b:0);if(r===0)n!==null&&Ko(n),e.callbackNode=null,e.callbackPriority=0;else if(t=r&-r,e.callbackPriority!==t){if(n!=null&&Ko(n),t===1)e.tag===0?Zf(Ui.bind(null,e)):qs(Ui.bind(null,e)),Kf(function(){!(R&6)&&mt()}),n=null;else{switch(Ps(r)){case 1:n=Gu;break;case 4:n=_s;break;case 16:n=Mr;break;case 536870912:n=xs;break;default:n=Mr}n=Za(n,Ha.bind(null,e))}e.callbackPriority=t,e.callbackNode=n}}function Ha(e,t){if(Pr=-1,zr=0,R&6)throw Error(y(327));var n=e.callbackNode;if(Zt()&&e.callbackNode!==n)return null;var r=Dr(e,e===Z?b:0);if(r===0)return null;if(r&30||r&e.expiredLanes||t)t=qr(e,r);else{t=r;var l=R;R|=2;var u=Qa();(Z!==e||b!==t)&&(Ae=null,rn=W()+500,Et(e,t));do try{yd();break}catch(i){Wa(e,i)}while(!0);so(),Gr.current=u,R=l,Q!==null?t=0:(Z=null,b=0,t=Y)}if(t!==0){if(t===2&&(l=su(e),l!==0&&(r=l,t=Iu(e,l))),t===1)throw n=Yn,Et(e,0),be(e,r),pe(e,W()),n;if(t===6)be(e,r);else{if(l=e.current.alternate,!(r&30)&&!hd(l)&&(t=qr(e,r),t===2&&(u=su(e),u!==0&&(r=u,t=Iu(e,u))),t===1))throw n=Yn,Et(e,0),be(e,r),pe(e,W()),n;switch(e.finishedWork=l,e.finishedLanes=r,t){case 0:case 1:throw Error(y(345));case 2:gt(e,ae,Ae);break;case 3:if(be(e,r),(r&130023424)===r&&(t=_o+500-W(),10<t)){if(Dr(e,0)!==0)break;if(l=e.suspendedLanes,(l&r)!==r){oe(),e.pingedLanes|=e.suspendedLanes&l;break}e.timeoutHandle=vu(gt.bind(null,e,ae,Ae),t);break}gt(e,ae,Ae);break;case 4:if(be(e,r),(r&4194240)===r)break;for(t=e.eventTimes,l=-1;0<r;){var o=31-Re(r);u=1<<o,o=t[o],o>l&&(l=o),r&=~u}if(r=l,r=W()-r,r=(120>r?120:480>r?480:1080>r?1080:1920>r?1920:3e3>r?3e3:4320>r?4320:1960*md(r/1960))-r,10<r){e.timeoutHandle=vu(gt.bind(null,e,ae,Ae),r);break}gt(e,ae,Ae);break;case 5:gt(e,ae,Ae);break;default:throw Error(y(329))}}}return pe(e,W()),e.callbackNode===n?Ha.bind(null,e):null}function Iu(e,t){var n=Ln;return e.current.memoizedState.isDehydrated&&(Et(e,t).flags|=256),e=qr(e,t),e!==2&&(t=ae,ae=n,t!==null&&Fu(t)),e}function Fu(e){ae===null?ae=e:ae.push.apply(ae,e)}function hd(e){for(var t=e;;){if(t.flags&16384){var n=t.updateQueue;if(n!==null&&(n=n.stores,n!==null))for(var r=0;r<n.length;r++){var l=n[r],u=l.getSnapshot;l=l.value;try{if(!Me(u(),l))return!1}catch{return!1}}}if(n=t.child,t.subtreeFlags&16384&&n!==null)n.return=t,t=n;else{if(t===e)break;for(;t.sibling===null;){if(t.return===null||t.return===e)return!0;t=t.return}t.sibling.return=t.return,t=t.sibling}}return!0}function be(e,t){for(t&=~Co,t&=~cl,e.suspendedLanes|=t,e.pingedLanes&=~t,e=e.expirationTimes;0<t;){var n=31-Re(t),r=1<<n;e[n]=-1,t&=~r}}function Ui(e){if(R&6)throw Error(y(327));Zt();var t=Dr(e,0);if(!(t&1))return pe(e,W()),null;var n=qr(e,t);if(e.tag!==0&&n===2){var r=su(e);r!==0&&(t=r,n=Iu(e,r))}if(n===1)throw n=Yn,Et(e,0),be(e,t),pe(e,W()),n;if(n===6)throw Error(y(345));return e.finishedWork=e.current.alternate,e.finishedLanes=t,gt(e,ae,Ae),pe(e,W()),null}function xo(e,t){var n=R;R|=1;try{return e(t)}finally{R=n,R===0&&(rn=W()+500,ol&&mt())}}function zt(e){tt!==null&&tt.tag===0&&!(R&6)&&Zt();var t=R;R|=1;var n=Ce.transition,r=O;try{if(Ce.transition=null,O=1,e)return e()}finally{O=r,Ce.transition=n,R=t,!(R&6)&&mt()}}function No(){me=Ht.current,j(Ht)}function Et(e,t){e.finishedWork=null,e.finishedLanes=0;var n=e.timeoutHandle;if(n!==-1&&(e.timeoutHandle=-1,Qf(n)),Q!==null)for(n=Q.return;n!==null;){var r=n;switch(uo(r),r.tag){case 1:r=r.type.childContextTypes,r!=null&&Ar();break;case 3:tn(),j(fe),j(le),ho();break;case 5:mo(r);break;case 4:tn();break;case 13:j(U);break;case 19:j(U);break;case 10:ao(r.type._context);break;case 22:case 23:No()}n=n.return}if(Z=e,Q=e=at(e.current,null),b=me=t,Y=0,Yn=null,Co=cl=Pt=0,ae=Ln=null,St!==null){for(t=0;t<St.length;t++)if(n=St[t],r=n.interleaved,r!==null){n.interleaved=null;var l=r.next,u=n.pending;if(u!==null){var o=u.next;u.next=l,r.next=o}n.pending=r}St=null}return e}function Wa(e,t){do{var n=Q;try{if(so(),_r.current=Xr,Yr){for(var r=A.memoizedState;r!==null;){var l=r.queue;l!==null&&(l.pending=null),r=r.next}Yr=!1}if(Nt=0,G=K=A=null,Pn=!1,Wn=0,Eo.current=null,n===null||n.return===null){Y=1,Yn=t,Q=null;break}e:{var u=e,o=n.return,i=n,s=t;if(t=b,i.flags|=32768,s!==null&&typeof s=="object"&&typeof s.then=="function"){var c=s,h=i,m=h.tag;if(!(h.mode&1)&&(m===0||m===11||m===15)){var p=h.alternate;p?(h.updateQueue=p.updateQueue,h.memoizedState=p.memoizedState,h.lanes=p.lanes):(h.updateQueue=null,h.memoizedState=null)}var g=xi(o);if(g!==null){g.flags&=-257,Ni(g,o,i,u,t),g.mode&1&&_i(u,c,t),t=g,s=c;var w=t.updateQueue;if(w===null){var S=new Set;S.add(s),t.updateQueue=S}else w.add(s);break e}else{if(!(t&1)){_i(u,c,t),Po();break e}s=Error(y(426))}}else if(F&&i.mode&1){var I=xi(o);if(I!==null){!(I.flags&65536)&&(I.flags|=256),Ni(I,o,i,u,t),oo(nn(s,i));break e}}u=s=nn(s,i),Y!==4&&(Y=2),Ln===null?Ln=[u]:Ln.push(u),u=o;do{switch(u.tag){case 3:u.flags|=65536,t&=-t,u.lanes|=t;var f=Pa(u,s,t);gi(u,f);break e;case 1:i=s;var a=u.type,d=u.stateNode;if(!(u.flags&128)&&(typeof a.getDerivedStateFromError=="function"||d!==null&&typeof d.componentDidCatch=="function"&&(it===null||!it.has(d)))){u.flags|=65536,t&=-t,u.lanes|=t;var v=za(u,i,t);gi(u,v);break e}}u=u.return}while(u!==null)}Ya(n)}catch(E){t=E,Q===n&&n!==null&&(Q=n=n.return);continue}break}while(!0)}function Qa(){var e=Gr.current;return Gr.current=Xr,e===null?Xr:e}function Po(){(Y===0||Y===3||Y===2)&&(Y=4),Z===null||!(Pt&268435455)&&!(cl&268435455)||be(Z,b)}function qr(e,t){var n=R;R|=2;var r=Qa();(Z!==e||b!==t)&&(Ae=null,Et(e,t));do try{vd();break}catch(l){Wa(e,l)}while(!0);if(so(),R=n,Gr.current=r,Q!==null)throw Error(y(261));return Z=null,b=0,Y}function vd(){for(;Q!==null;)Ka(Q)}function yd(){for(;Q!==null&&!$c();)Ka(Q)}function Ka(e){var t=Ga(e.alternate,e,me);e.memoizedProps=e.pendingProps,t===null?Ya(e):Q=t,Eo.current=null}function Ya(e){var t=e;do{var n=t.alternate;if(e=t.return,t.flags&32768){if(n=cd(n,t),n!==null){n.flags&=32767,Q=n;return}if(e!==null)e.flags|=32768,e.subtreeFlags=0,e.deletions=null;else{Y=6,Q=null;return}}else if(n=ad(n,t,me),n!==null){Q=n;return}if(t=t.sibling,t!==null){Q=t;return}Q=t=e}while(t!==null);Y===0&&(Y=5)}function gt(e,t,n){var r=O,l=Ce.transition;try{Ce.transition=null,O=1,gd(e,t,n,r)}finally{Ce.transition=l,O=r}return null}function gd(e,t,n,r){do Zt();while(tt!==null);if(R&6)throw Error(y(327));n=e.finishedWork;var l=e.finishedLanes;if(n===null)return null;if(e.finishedWork=null,e.finishedLanes=0,n===e.current)throw Error(y(177));e.callbackNode=null,e.callbackPriority=0;var u=n.lanes|n.childLanes;if(Jc(e,u),e===Z&&(Q=Z=null,b=0),!(n.subtreeFlags&2064)&&!(n.flags&2064)||vr||(vr=!0,Za(Mr,function(){return Zt(),null})),u=(n.flags&15990)!==0,n.subtreeFlags&15990||u){u=Ce.transition,Ce.transition=null;var o=O;O=1;var i=R;R|=4,Eo.current=null,dd(e,n),$a(n,e),Uf(mu),jr=!!pu,mu=pu=null,e.current=n,pd(n),Bc(),R=i,O=o,Ce.transition=u}else e.current=n;if(vr&&(vr=!1,tt=e,Jr=l),u=e.pendingLanes,u===0&&(it=null),Qc(n.stateNode),pe(e,W()),t!==null)for(r=e.onRecoverableError,n=0;n<t.length;n++)l=t[n],r(l.value,{componentStack:l.stack,digest:l.digest});if(Zr)throw Zr=!1,e=Du,Du=null,e;return Jr&1&&e.tag!==0&&Zt(),u=e.pendingLanes,u&1?e===ju?Tn++:(Tn=0,ju=e):Tn=0,mt(),null}function Zt(){if(tt!==null){var e=Ps(Jr),t=Ce.transition,n=O;try{if(Ce.transition=null,O=16>e?16:e,tt===null)var r=!1;else{if(e=tt,tt=null,Jr=0,R&6)throw Error(y(331));var l=R;for(R|=4,k=e.current;k!==null;){var u=k,o=u.child;if(k.flags&16){var i=u.deletions;if(i!==null){for(var s=0;s<i.length;s++){var c=i[s];for(k=c;k!==null;){var h=k;switch(h.tag){case 0:case 11:case 15:zn(8,h,u)}var m=h.child;if(m!==null)m.return=h,k=m;else for(;k!==null;){h=k;var p=h.sibling,g=h.return;if(Ua(h),h===c){k=null;break}if(p!==null){p.return=g,k=p;break}k=g}}}var w=u.alternate;if(w!==null){var S=w.child;if(S!==null){w.child=null;do{var I=S.sibling;S.sibling=null,S=I}while(S!==null)}}k=u}}if(u.subtreeFlags&2064&&o!==null)o.return=u,k=o;else e:for(;k!==null;){if(u=k,u.flags&2048)switch(u.tag){case 0:case 11:case 15:zn(9,u,u.return)}var f=u.sibling;if(f!==null){f.return=u.return,k=f;break e}k=u.return}}var a=e.current;for(k=a;k!==null;){o=k;var d=o.child;if(o.subtreeFlags&2064&&d!==null)d.return=o,k=d;else e:for(o=a;k!==null;){if(i=k,i.flags&2048)try{switch(i.tag){case 0:case 11:case 15:al(9,i)}}catch(E){$(i,i.return,E)}if(i===o){k=null;break e}var v=i.sibling;if(v!==null){v.return=i.return,k=v;break e}k=i.return}}if(R=l,mt(),Fe&&typeof Fe.onPostCommitFiberRoot=="function")try{Fe.onPostCommitFiberRoot(tl,e)}catch{}r=!0}return r}finally{O=n,Ce.transition=t}}return!1}function Ai(e,t,n){t=nn(n,t),t=Pa(e,t,1),e=ot(e,t,1),t=oe(),e!==null&&(Gn(e,1,t),pe(e,t))}function $(e,t,n){if(e.tag===3)Ai(e,e,n);else for(;t!==null;){if(t.tag===3){Ai(t,e,n);break}else if(t.tag===1){var r=t.stateNode;if(typeof t.type.getDerivedStateFromError=="function"||typeof r.componentDidCatch=="function"&&(it===null||!it.has(r))){e=nn(n,e),e=za(t,e,1),t=ot(t,e,1),e=oe(),t!==null&&(Gn(t,1,e),pe(t,e));break}}t=t.return}}function wd(e,t,n){var r=e.pingCache;r!==null&&r.delete(t),t=oe(),e.pingedLanes|=e.suspendedLanes&n,Z===e&&(b&n)===n&&(Y===4||Y===3&&(b&130023424)===b&&500>W()-_o?Et(e,0):Co|=n),pe(e,t)}function Xa(e,t){t===0&&(e.mode&1?(t=or,or<<=1,!(or&130023424)&&(or=4194304)):t=1);var n=oe();e=Ke(e,t),e!==null&&(Gn(e,t,n),pe(e,n))}function Sd(e){var t=e.memoizedState,n=0;t!==null&&(n=t.retryLane),Xa(e,n)}function kd(e,t){var n=0;switch(e.tag){case 13:var r=e.stateNode,l=e.memoizedState;l!==null&&(n=l.retryLane);break;case 19:r=e.stateNode;break;default:throw Error(y(314))}r!==null&&r.delete(t),Xa(e,n)}var Ga;Ga=function(e,t,n){if(e!==null)if(e.memoizedProps!==t.pendingProps||fe.current)ce=!0;else{if(!(e.lanes&n)&&!(t.flags&128))return ce=!1,sd(e,t,n);ce=!!(e.flags&131072)}else ce=!1,F&&t.flags&1048576&&bs(t,Br,t.index);switch(t.lanes=0,t.tag){case 2:var r=t.type;Nr(e,t),e=t.pendingProps;var l=qt(t,le.current);Gt(t,n),l=yo(null,t,r,e,l,n);var u=go();return t.flags|=1,typeof l=="object"&&l!==null&&typeof l.render=="function"&&l.$$typeof===void 0?(t.tag=1,t.memoizedState=null,t.updateQueue=null,de(r)?(u=!0,Vr(t)):u=!1,t.memoizedState=l.state!==null&&l.state!==void 0?l.state:null,fo(t),l.updater=sl,t.stateNode=l,l._reactInternals=t,Cu(t,r,e,n),t=Nu(null,t,r,!0,u,n)):(t.tag=0,F&&u&&lo(t),ue(null,t,l,n),t=t.child),t;case 16:r=t.elementType;e:{switch(Nr(e,t),e=t.pendingProps,l=r._init,r=l(r._payload),t.type=r,l=t.tag=Cd(r),e=ze(r,e),l){case 0:t=xu(null,t,r,e,n);break e;case 1:t=Li(null,t,r,e,n);break e;case 11:t=Pi(null,t,r,e,n);break e;case 14:t=zi(null,t,r,ze(r.type,e),n);break e}throw Error(y(306,r,""))}return t;case 0:return r=t.type,l=t.pendingProps,l=t.elementType===r?l:ze(r,l),xu(e,t,r,l,n);case 1:return r=t.type,l=t.pendingProps,l=t.elementType===r?l:ze(r,l),Li(e,t,r,l,n);case 3:e:{if(Oa(t),e===null)throw Error(y(387));r=t.pendingProps,u=t.memoizedState,l=u.element,ua(e,t),Qr(t,r,null,n);var o=t.memoizedState;if(r=o.element,u.isDehydrated)if(u={element:r,isDehydrated:!1,cache:o.cache,pendingSuspenseBoundaries:o.pendingSuspenseBoundaries,transitions:o.transitions},t.updateQueue.baseState=u,t.memoizedState=u,t.flags&256){l=nn(Error(y(423)),t),t=Ti(e,t,r,n,l);break e}else if(r!==l){l=nn(Error(y(424)),t),t=Ti(e,t,r,n,l);break e}else for(he=ut(t.stateNode.containerInfo.firstChild),ve=t,F=!0,Te=null,n=ra(t,null,r,n),t.child=n;n;)n.flags=n.flags&-3|4096,n=n.sibling;else{if(bt(),r===l){t=Ye(e,t,n);break e}ue(e,t,r,n)}t=t.child}return t;case 5:return oa(t),e===null&&Su(t),r=t.type,l=t.pendingProps,u=e!==null?e.memoizedProps:null,o=l.children,hu(r,l)?o=null:u!==null&&hu(r,u)&&(t.flags|=32),Ra(e,t),ue(e,t,o,n),t.child;case 6:return e===null&&Su(t),null;case 13:return Ma(e,t,n);case 4:return po(t,t.stateNode.containerInfo),r=t.pendingProps,e===null?t.child=en(t,null,r,n):ue(e,t,r,n),t.child;case 11:return r=t.type,l=t.pendingProps,l=t.elementType===r?l:ze(r,l),Pi(e,t,r,l,n);case 7:return ue(e,t,t.pendingProps,n),t.child;case 8:return ue(e,t,t.pendingProps.children,n),t.child;case 12:return ue(e,t,t.pendingProps.children,n),t.child;case 10:e:{if(r=t.type._context,l=t.pendingProps,u=t.memoizedProps,o=l.value,M(Hr,r._currentValue),r._currentValue=o,u!==null)if(Me(u.value,o)){if(u.children===l.children&&!fe.current){t=Ye(e,t,n);break e}}else for(u=t.child,u!==null&&(u.return=t);u!==null;){var i=u.dependencies;if(i!==null){o=u.child;for(var s=i.firstContext;s!==null;){if(s.context===r){if(u.tag===1){s=He(-1,n&-n),s.tag=2;var c=u.updateQueue;if(c!==null){c=c.shared;var h=c.pending;h===null?s.next=s:(s.next=h.next,h.next=s),c.pending=s}}u.lanes|=n,s=u.alternate,s!==null&&(s.lanes|=n),ku(u.return,n,t),i.lanes|=n;break}s=s.next}}else if(u.tag===10)o=u.type===t.type?null:u.child;else if(u.tag===18){if(o=u.return,o===null)throw Error(y(341));o.lanes|=n,i=o.alternate,i!==null&&(i.lanes|=n),ku(o,n,t),o=u.sibling}else o=u.child;if(o!==null)o.return=u;else for(o=u;o!==null;){if(o===t){o=null;break}if(u=o.sibling,u!==null){u.return=o.return,o=u;break}o=o.return}u=o}ue(e,t,l.children,n),t=t.child}return t;case 9:return l=t.type,r=t.pendingProps.children,Gt(t,n),l=_e(l),r=r(l),t.flags|=1,ue(e,t,r,n),t.child;case 14:return r=t.type,l=ze(r,t.pendingProps),l=ze(r.type,l),zi(e,t,r,l,n);case 15:return La(e,t,t.type,t.pendingProps,n);case 17:return r=t.type,l=t.pendingProps,l=t.elementType===r?l:ze(r,l),Nr(e,t),t.tag=1,de(r)?(e=!0,Vr(t)):e=!1,Gt(t,n),Na(t,r,l),Cu(t,r,l,n),Nu(null,t,r,!0,e,n);case 19:return Da(e,t,n);case 22:return Ta(e,t,n)}throw Error(y(156,t.tag))};function Za(e,t){return Cs(e,t)}function Ed(e,t,n,r){this.tag=e,this.key=n,this.sibling=this.child=this.return=this.stateNode=this.type=this.elementType=null,this.index=0,this.ref=null,this.pendingProps=t,this.dependencies=this.memoizedState=this.updateQueue=this.memoizedProps=null,this.mode=r,this.subtreeFlags=this.flags=0,this.deletions=null,this.childLanes=this.lanes=0,this.alternate=null}function Ee(e,t,n,r){return new Ed(e,t,n,r)}function zo(e){return e=e.prototype,!(!e||!e.isReactComponent)}function Cd(e){if(typeof e=="function")return zo(e)?1:0;if(e!=null){if(e=e.$$typeof,e===Ku)return 11;if(e===Yu)return 14}return 2}function at(e,t){var n=e.alternate;return n===null?(n=Ee(e.tag,t,e.key,e.mode),n.elementType=e.elementType,n.type=e.type,n.stateNode=e.stateNode,n.alternate=e,e.alternate=n):(n.pendingProps=t,n.type=e.type,n.flags=0,n.subtreeFlags=0,n.deletions=null),n.flags=e.flags&14680064,n.childLanes=e.childLanes,n.lanes=e.lanes,n.child=e.child,n.memoizedProps=e.memoizedProps,n.memoizedState=e.memoizedState,n.updateQueue=e.updateQueue,t=e.dependencies,n.dependencies=t===null?null:{lanes:t.lanes,firstContext:t.firstContext},n.sibling=e.sibling,n.index=e.index,n.ref=e.ref,n}function Lr(e,t,n,r,l,u){var o=2;if(r=e,typeof e=="function")zo(e)&&(o=1);else if(typeof e=="string")o=5;else e:switch(e){case Mt:return Ct(n.children,l,u,t);case Qu:o=8,l|=8;break;case Yl:return e=Ee(12,n,t,l|2),e.elementType=Yl,e.lanes=u,e;case Xl:return e=Ee(13,n,t,l),e.elementType=Xl,e.lanes=u,e;case Gl:return e=Ee(19,n,t,l),e.elementType=Gl,e.lanes=u,e;case os:return fl(n,l,u,t);default:if(typeof e=="object"&&e!==null)switch(e.$$typeof){case ls:o=10;break e;case us:o=9;break e;case Ku:o=11;break e;case Yu:o=14;break e;case Ze:o=16,r=null;break e}throw Error(y(130,e==null?e:typeof e,""))}return t=Ee(o,n,t,l),t.elementType=e,t.type=r,t.lanes=u,t}function Ct(e,t,n,r){return e=Ee(7,e,r,t),e.lanes=n,e}function fl(e,t,n,r){return e=Ee(22,e,r,t),e.elementType=os,e.lanes=n,e.stateNode={isHidden:!1},e}function Hl(e,t,n){return e=Ee(6,e,null,t),e.lanes=n,e}function Wl(e,t,n){return t=Ee(4,e.children!==null?e.children:[],e.key,t),t.lanes=n,t.stateNode={containerInfo:e.containerInfo,pendingChildren:null,implementation:e.implementation},t}function _d(e,t,n,r,l){this.tag=t,this.containerInfo=e,this.finishedWork=this.pingCache=this.current=this.pendingChildren=null,this.timeoutHandle=-1,this.callbackNode=this.pendingContext=this.context=null,this.callbackPriority=0,this.eventTimes=_l(0),this.expirationTimes=_l(-1),this.entangledLanes=this.finishedLanes=this.mutableReadLanes=this.expiredLanes=this.pingedLanes=this.suspendedLanes=this.pendingLanes=0,this.entanglements=_l(0),this.identifierPrefix=r,this.onRecoverableError=l,this.mutableSourceEagerHydrationData=null}function Lo(e,t,n,r,l,u,o,i,s){return e=new _d(e,t,n,i,s),t===1?(t=1,u===!0&&(t|=8)):t=0,u=Ee(3,null,null,t),e.current=u,u.stateNode=e,u.memoizedState={element:r,isDehydrated:n,cache:null,transitions:null,pendingSuspenseBoundaries:null},fo(u),e}function xd(e,t,n){var r=3<arguments.length&&arguments[3]!==void 0?arguments[3]:null;return{$$typeof:Ot,key:r==null?null:""+r,children:e,containerInfo:t,implementation:n}}function Ja(e){if(!e)return ft;e=e._reactInternals;e:{if(Tt(e)!==e||e.tag!==1)throw Error(y(170));var t=e;do{switch(t.tag){case 3:t=t.stateNode.context;break e;case 1:if(de(t.type)){t=t.stateNode.__reactInternalMemoizedMergedChildContext;break e}}t=t.return}while(t!==null);throw Error(y(171))}if(e.tag===1){var n=e.type;if(de(n))return Js(e,n,t)}return t}function qa(e,t,n,r,l,u,o,i,s){return e=Lo(n,r,!0,e,l,u,o,i,s),e.context=Ja(null),n=e.current,r=oe(),l=st(n),u=He(r,l),u.callback=t??null,ot(n,u,l),e.current.lanes=l,Gn(e,l,r),pe(e,r),e}function dl(e,t,n,r){var l=t.current,u=oe(),o=st(l);return n=Ja(n),t.context===null?t.context=n:t.pendingContext=n,t=He(u,o),t.payload={element:e},r=r===void 0?null:r,r!==null&&(t.callback=r),e=ot(l,t,o),e!==null&&(Oe(e,l,o,u),Cr(e,l,o)),o}function br(e){if(e=e.current,!e.child)return null;switch(e.child.tag){case 5:return e.child.stateNode;default:return e.child.stateNode}}function Vi(e,t){if(e=e.memoizedState,e!==null&&e.dehydrated!==null){var n=e.retryLane;e.retryLane=n!==0&&n<t?n:t}}function To(e,t){Vi(e,t),(e=e.alternate)&&Vi(e,t)}function Nd(){return null}var ba=typeof reportError=="function"?reportError:function(e){console.error(e)};function Ro(e){this._internalRoot=e}pl.prototype.render=Ro.prototype.render=function(e){var t=this._internalRoot;if(t===null)throw Error(y(409));dl(e,t,null,null)};pl.prototype.unmount=Ro.prototype.unmount=function(){var e=this._internalRoot;if(e!==null){this._internalRoot=null;var t=e.containerInfo;zt(function(){dl(null,e,null,null)}),t[Qe]=null}};function pl(e){this._internalRoot=e}pl.prototype.unstable_scheduleHydration=function(e){if(e){var t=Ts();e={blockedOn:null,target:e,priority:t};for(var n=0;n<qe.length&&t!==0&&t<qe[n].priority;n++);qe.splice(n,0,e),n===0&&Os(e)}};function Oo(e){return!(!e||e.nodeType!==1&&e.nodeType!==9&&e.nodeType!==11)}function ml(e){return!(!e||e.nodeType!==1&&e.nodeType!==9&&e.nodeType!==11&&(e.nodeType!==8||e.nodeValue!==" react-mount-point-unstable "))}function $i(){}function Pd(e,t,n,r,l){if(l){if(typeof r=="function"){var u=r;r=function(){var c=br(o);u.call(c)}}var o=qa(t,r,e,0,null,!1,!1,"",$i);return e._reactRootContainer=o,e[Qe]=o.current,An(e.nodeType===8?e.parentNode:e),zt(),o}for(;l=e.lastChild;)e.removeChild(l);if(typeof r=="function"){var i=r;r=function(){var c=br(s);i.call(c)}}var s=Lo(e,0,!1,null,null,!1,!1,"",$i);return e._reactRootContainer=s,e[Qe]=s.current,An(e.nodeType===8?e.parentNode:e),zt(function(){dl(t,s,n,r)}),s}function hl(e,t,n,r,l){var u=n._reactRootContainer;if(u){var o=u;if(typeof l=="function"){var i=l;l=function(){var s=br(o);i.call(s)}}dl(t,o,e,l)}else o=Pd(n,t,e,l,r);return br(o)}zs=function(e){switch(e.tag){case 3:var t=e.stateNode;if(t.current.memoizedState.isDehydrated){var n=Sn(t.pendingLanes);n!==0&&(Zu(t,n|1),pe(t,W()),!(R&6)&&(rn=W()+500,mt()))}break;case 13:zt(function(){var r=Ke(e,1);if(r!==null){var l=oe();Oe(r,e,1,l)}}),To(e,1)}};Ju=function(e){if(e.tag===13){var t=Ke(e,134217728);if(t!==null){var n=oe();Oe(t,e,134217728,n)}To(e,134217728)}};Ls=function(e){if(e.tag===13){var t=st(e),n=Ke(e,t);if(n!==null){var r=oe();Oe(n,e,t,r)}To(e,t)}};Ts=function(){return O};Rs=function(e,t){var n=O;try{return O=e,t()}finally{O=n}};uu=function(e,t,n){switch(t){case"input":if(ql(e,n),t=n.name,n.type==="radio"&&t!=null){for(n=e;n.parentNode;)n=n.parentNode;for(n=n.querySelectorAll("input[name="+JSON.stringify(""+t)+'][type="radio"]'),t=0;t<n.length;t++){var r=n[t];if(r!==e&&r.form===e.form){var l=ul(r);if(!l)throw Error(y(90));ss(r),ql(r,l)}}}break;case"textarea":cs(e,n);break;case"select":t=n.value,t!=null&&Qt(e,!!n.multiple,t,!1)}};ys=xo;gs=zt;var zd={usingClientEntryPoint:!1,Events:[Jn,Ft,ul,hs,vs,xo]},yn={findFiberByHostInstance:wt,bundleType:0,version:"18.3.1",rendererPackageName:"react-dom"},Ld={bundleType:yn.bundleType,version:yn.version,rendererPackageName:yn.rendererPackageName,rendererConfig:yn.rendererConfig,overrideHookState:null,overrideHookStateDeletePath:null,overrideHookStateRenamePath:null,overrideProps:null,overridePropsDeletePath:null,overridePropsRenamePath:null,setErrorHandler:null,setSuspenseHandler:null,scheduleUpdate:null,currentDispatcherRef:Xe.ReactCurrentDispatcher,findHostInstanceByFiber:function(e){return e=ks(e),e===null?null:e.stateNode},findFiberByHostInstance:yn.findFiberByHostInstance||Nd,findHostInstancesForRefresh:null,scheduleRefresh:null,scheduleRoot:null,setRefreshHandler:null,getCurrentFiber:null,reconcilerVersion:"18.3.1-next-f1338f8080-20240426"};if(typeof __REACT_DEVTOOLS_GLOBAL_HOOK__<"u"){var yr=__REACT_DEVTOOLS_GLOBAL_HOOK__;if(!yr.isDisabled&&yr.supportsFiber)try{tl=yr.inject(Ld),Fe=yr}catch{}}ge.__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED=zd;ge.createPortal=function(e,t){var n=2<arguments.length&&arguments[2]!==void 0?arguments[2]:null;if(!Oo(t))throw Error(y(200));return xd(e,t,null,n)};ge.createRoot=function(e,t){if(!Oo(e))throw Error(y(299));var n=!1,r="",l=ba;return t!=null&&(t.unstable_strictMode===!0&&(n=!0),t.identifierPrefix!==void 0&&(r=t.identifierPrefix),t.onRecoverableError!==void 0&&(l=t.onRecoverableError)),t=Lo(e,1,!1,null,null,n,!1,r,l),e[Qe]=t.current,An(e.nodeType===8?e.parentNode:e),new Ro(t)};ge.findDOMNode=function(e){if(e==null)return null;if(e.nodeType===1)return e;var t=e._reactInternals;if(t===void 0)throw typeof e.render=="function"?Error(y(188)):(e=Object.keys(e).join(","),Error(y(268,e)));return e=ks(t),e=e===null?null:e.stateNode,e};ge.flushSync=function(e){return zt(e)};ge.hydrate=function(e,t,n){if(!ml(t))throw Error(y(200));return hl(null,e,t,!0,n)};ge.hydrateRoot=function(e,t,n){if(!Oo(e))throw Error(y(405));var r=n!=null&&n.hydratedSources||null,l=!1,u="",o=ba;if(n!=null&&(n.unstable_strictMode===!0&&(l=!0),n.identifierPrefix!==void 0&&(u=n.identifierPrefix),n.onRecoverableError!==void 0&&(o=n.onRecoverableError)),t=qa(t,null,e,1,n??null,l,!1,u,o),e[Qe]=t.current,An(e),r)for(e=0;e<r.length;e++)n=r[e],l=n._getVersion,l=l(n._source),t.mutableSourceEagerHydrationData==null?t.mutableSourceEagerHydrationData=[n,l]:t.mutableSourceEagerHydrationData.push(n,l);return new pl(t)};ge.render=function(e,t,n){if(!ml(t))throw Error(y(200));return hl(null,e,t,!1,n)};ge.unmountComponentAtNode=function(e){if(!ml(e))throw Error(y(40));return e._reactRootContainer?(zt(function(){hl(null,null,e,!1,function(){e._reactRootContainer=null,e[Qe]=null})}),!0):!1};ge.unstable_batchedUpdates=xo;ge.unstable_renderSubtreeIntoContainer=function(e,t,n,r){if(!ml(n))throw Error(y(200));if(e==null||e._reactInternals===void 0)throw Error(y(38));return hl(e,t,n,!1,r)};ge.version="18.3.1-next-f1338f8080-20240426";function ec(){if(!(typeof __REACT_DEVTOOLS_GLOBAL_HOOK__>"u"||typeof __REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE!="function"))try{__REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE(ec)}catch(e){console.error(e)}}ec(),es.exports=ge;var Td=es.exports,Bi=Td;Ql.createRoot=Bi.createRoot,Ql.hydrateRoot=Bi.hydrateRoot;function Rd(){const[e,t]=Wt.useState(""),[n,r]=Wt.useState(""),[l,u]=Wt.useState(!1),o=async()=>{u(!0);try{const s=await(await fetch("/api/greeting",{method:"POST",headers:{"Content-Type":"application/json"},body:JSON.stringify({name:e})})).json();r(s.message||JSON.stringify(s))}catch(i){r(`Error: ${i}`)}u(!1)};return J.jsxs("div",{className:"container",children:[J.jsxs("header",{children:[J.jsx("h1",{children:"Worker Dashboard"}),J.jsx("p",{className:"subtitle",children:"Test your worker functions"})]}),J.jsx("main",{children:J.jsxs("section",{className:"card",children:[J.jsx("h2",{children:"Greeting Function"}),J.jsxs("div",{className:"input-group",children:[J.jsx("input",{type:"text",placeholder:"Enter your name",value:e,onChange:i=>t(i.target.value)}),J.jsx("button",{onClick:o,disabled:l||!e,children:l?"Loading...":"Call Function"})]}),n&&J.jsxs("div",{className:"response",children:[J.jsx("strong",{children:"Response:"}),J.jsx("pre",{children:n})]})]})}),J.jsx("footer",{children:J.jsx("p",{children:"Worker Starter Template"})})]})}Ql.createRoot(document.getElementById("root")).render(J.jsx(gc.StrictMode,{children:J.jsx(Rd,{})}));
+`+u.stack}return{value:e,source:t,stack:l,digest:null}}function $l(e,t,n){return{value:e,source:null,stack:n??null,digest:t??null}}function _u(e,t){try{console.error(t.value)}catch(n){setTimeout(function(){throw n})}}var ud=typeof WeakMap=="function"?WeakMap:Map;function Pa(e,t,n){n=He(-1,n),n.tag=3,n.payload={element:null};var r=t.value;return n.callback=function(){Zr||(Zr=!0,Du=r),_u(e,t)},n}function za(e,t,n){n=He(-1,n),n.tag=3;var r=e.type.getDerivedStateFromError;if(typeof r=="function"){var l=t.value;n.payload=function(){return r(l)},n.callback=function(){_u(e,t)}}var u=e.stateNode;return u!==null&&typeof u.componentDidCatch=="function"&&(n.callback=function(){_u(e,t),typeof r!="function"&&(it===null?it=new Set([this]):it.add(this));var o=t.stack;this.componentDidCatch(t.value,{componentStack:o!==null?o:""})}),n}function _i(e,t,n){var r=e.pingCache;if(r===null){r=e.pingCache=new ud;var l=new Set;r.set(t,l)}else l=r.get(t),l===void 0&&(l=new Set,r.set(t,l));l.has(n)||(l.add(n),e=wd.bind(null,e,t,n),t.then(e,e))}function xi(e){do{var t;if((t=e.tag===13)&&(t=e.memoizedState,t=t!==null?t.dehydrated!==null:!0),t)return e;e=e.return}while(e!==null);return null}function Ni(e,t,n,r,l){return e.mode&1?(e.flags|=65536,e.lanes=l,e):(e===t?e.flags|=65536:(e.flags|=128,n.flags|=131072,n.flags&=-52805,n.tag===1&&(n.alternate===null?n.tag=17:(t=He(-1,1),t.tag=2,ot(n,t,1))),n.lanes|=1),e)}var od=Xe.ReactCurrentOwner,ce=!1;function ue(e,t,n,r){t.child=e===null?ra(t,null,n,r):en(t,e.child,n,r)}function Pi(e,t,n,r,l){n=n.render;var u=t.ref;return Gt(t,l),r=yo(e,t,n,r,u,l),n=go(),e!==null&&!ce?(t.updateQueue=e.updateQueue,t.flags&=-2053,e.lanes&=~l,Ye(e,t,l)):(F&&n&&lo(t),t.flags|=1,ue(e,t,r,l),t.child)}function zi(e,t,n,r,l){if(e===null){var u=n.type;return typeof u=="function"&&!zo(u)&&u.defaultProps===void 0&&n.compare===null&&n.defaultProps===void 0?(t.tag=15,t.type=u,La(e,t,u,r,l)):(e=Lr(n.type,null,r,t,t.mode,l),e.ref=t.ref,e.return=t,t.child=e)}if(u=e.child,!(e.lanes&l)){var o=u.memoizedProps;if(n=n.compare,n=n!==null?n:Un,n(o,r)&&e.ref===t.ref)return Ye(e,t,l)}return t.flags|=1,e=at(u,r),e.ref=t.ref,e.return=t,t.child=e}function La(e,t,n,r,l){if(e!==null){var u=e.memoizedProps;if(Un(u,r)&&e.ref===t.ref)if(ce=!1,t.pendingProps=r=u,(e.lanes&l)!==0)e.flags&131072&&(ce=!0);else return t.lanes=e.lanes,Ye(e,t,l)}return xu(e,t,n,r,l)}function Ta(e,t,n){var r=t.pendingProps,l=r.children,u=e!==null?e.memoizedState:null;if(r.mode==="hidden")if(!(t.mode&1))t.memoizedState={baseLanes:0,cachePool:null,transitions:null},M(Ht,me),me|=n;else{if(!(n&1073741824))return e=u!==null?u.baseLanes|n:n,t.lanes=t.childLanes=1073741824,t.memoizedState={baseLanes:e,cachePool:null,transitions:null},t.updateQueue=null,M(Ht,me),me|=e,null;t.memoizedState={baseLanes:0,cachePool:null,transitions:null},r=u!==null?u.baseLanes:n,M(Ht,me),me|=r}else u!==null?(r=u.baseLanes|n,t.memoizedState=null):r=n,M(Ht,me),me|=r;return ue(e,t,l,n),t.child}function Ra(e,t){var n=t.ref;(e===null&&n!==null||e!==null&&e.ref!==n)&&(t.flags|=512,t.flags|=2097152)}function xu(e,t,n,r,l){var u=de(n)?_t:le.current;return u=qt(t,u),Gt(t,l),n=yo(e,t,n,r,u,l),r=go(),e!==null&&!ce?(t.updateQueue=e.updateQueue,t.flags&=-2053,e.lanes&=~l,Ye(e,t,l)):(F&&r&&lo(t),t.flags|=1,ue(e,t,n,l),t.child)}function Li(e,t,n,r,l){if(de(n)){var u=!0;Vr(t)}else u=!1;if(Gt(t,l),t.stateNode===null)Nr(e,t),Na(t,n,r),Cu(t,n,r,l),r=!0;else if(e===null){var o=t.stateNode,i=t.memoizedProps;o.props=i;var s=o.context,c=n.contextType;typeof c=="object"&&c!==null?c=_e(c):(c=de(n)?_t:le.current,c=qt(t,c));var h=n.getDerivedStateFromProps,m=typeof h=="function"||typeof o.getSnapshotBeforeUpdate=="function";m||typeof o.UNSAFE_componentWillReceiveProps!="function"&&typeof o.componentWillReceiveProps!="function"||(i!==r||s!==c)&&Ci(t,o,r,c),Je=!1;var p=t.memoizedState;o.state=p,Qr(t,r,o,l),s=t.memoizedState,i!==r||p!==s||fe.current||Je?(typeof h=="function"&&(Eu(t,n,h,r),s=t.memoizedState),(i=Je||Ei(t,n,i,r,p,s,c))?(m||typeof o.UNSAFE_componentWillMount!="function"&&typeof o.componentWillMount!="function"||(typeof o.componentWillMount=="function"&&o.componentWillMount(),typeof o.UNSAFE_componentWillMount=="function"&&o.UNSAFE_componentWillMount()),typeof o.componentDidMount=="function"&&(t.flags|=4194308)):(typeof o.componentDidMount=="function"&&(t.flags|=4194308),t.memoizedProps=r,t.memoizedState=s),o.props=r,o.state=s,o.context=c,r=i):(typeof o.componentDidMount=="function"&&(t.flags|=4194308),r=!1)}else{o=t.stateNode,ua(e,t),i=t.memoizedProps,c=t.type===t.elementType?i:ze(t.type,i),o.props=c,m=t.pendingProps,p=o.context,s=n.contextType,typeof s=="object"&&s!==null?s=_e(s):(s=de(n)?_t:le.current,s=qt(t,s));var g=n.getDerivedStateFromProps;(h=typeof g=="function"||typeof o.getSnapshotBeforeUpdate=="function")||typeof o.UNSAFE_componentWillReceiveProps!="function"&&typeof o.componentWillReceiveProps!="function"||(i!==m||p!==s)&&Ci(t,o,r,s),Je=!1,p=t.memoizedState,o.state=p,Qr(t,r,o,l);var w=t.memoizedState;i!==m||p!==w||fe.current||Je?(typeof g=="function"&&(Eu(t,n,g,r),w=t.memoizedState),(c=Je||Ei(t,n,c,r,p,w,s)||!1)?(h||typeof o.UNSAFE_componentWillUpdate!="function"&&typeof o.componentWillUpdate!="function"||(typeof o.componentWillUpdate=="function"&&o.componentWillUpdate(r,w,s),typeof o.UNSAFE_componentWillUpdate=="function"&&o.UNSAFE_componentWillUpdate(r,w,s)),typeof o.componentDidUpdate=="function"&&(t.flags|=4),typeof o.getSnapshotBeforeUpdate=="function"&&(t.flags|=1024)):(typeof o.componentDidUpdate!="function"||i===e.memoizedProps&&p===e.memoizedState||(t.flags|=4),typeof o.getSnapshotBeforeUpdate!="function"||i===e.memoizedProps&&p===e.memoizedState||(t.flags|=1024),t.memoizedProps=r,t.memoizedState=w),o.props=r,o.state=w,o.context=s,r=c):(typeof o.componentDidUpdate!="function"||i===e.memoizedProps&&p===e.memoizedState||(t.flags|=4),typeof o.getSnapshotBeforeUpdate!="function"||i===e.memoizedProps&&p===e.memoizedState||(t.flags|=1024),r=!1)}return Nu(e,t,n,r,u,l)}function Nu(e,t,n,r,l,u){Ra(e,t);var o=(t.flags&128)!==0;if(!r&&!o)return l&&mi(t,n,!1),Ye(e,t,u);r=t.stateNode,od.current=t;var i=o&&typeof n.getDerivedStateFromError!="function"?null:r.render();return t.flags|=1,e!==null&&o?(t.child=en(t,e.child,null,u),t.child=en(t,null,i,u)):ue(e,t,i,u),t.memoizedState=r.state,l&&mi(t,n,!0),t.child}function Oa(e){var t=e.stateNode;t.pendingContext?pi(e,t.pendingContext,t.pendingContext!==t.context):t.context&&pi(e,t.context,!1),po(e,t.containerInfo)}function Ti(e,t,n,r,l){return bt(),oo(l),t.flags|=256,ue(e,t,n,r),t.child}var Pu={dehydrated:null,treeContext:null,retryLane:0};function zu(e){return{baseLanes:e,cachePool:null,transitions:null}}function Ma(e,t,n){var r=t.pendingProps,l=U.current,u=!1,o=(t.flags&128)!==0,i;if((i=o)||(i=e!==null&&e.memoizedState===null?!1:(l&2)!==0),i?(u=!0,t.flags&=-129):(e===null||e.memoizedState!==null)&&(l|=1),M(U,l&1),e===null)return Su(t),e=t.memoizedState,e!==null&&(e=e.dehydrated,e!==null)?(t.mode&1?e.data==="$!"?t.lanes=8:t.lanes=1073741824:t.lanes=1,null):(o=r.children,e=r.fallback,u?(r=t.mode,u=t.child,o={mode:"hidden",children:o},!(r&1)&&u!==null?(u.childLanes=0,u.pendingProps=o):u=fl(o,r,0,null),e=Ct(e,r,n,null),u.return=t,e.return=t,u.sibling=e,t.child=u,t.child.memoizedState=zu(n),t.memoizedState=Pu,e):ko(t,o));if(l=e.memoizedState,l!==null&&(i=l.dehydrated,i!==null))return id(e,t,o,r,i,l,n);if(u){u=r.fallback,o=t.mode,l=e.child,i=l.sibling;var s={mode:"hidden",children:r.children};return!(o&1)&&t.child!==l?(r=t.child,r.childLanes=0,r.pendingProps=s,t.deletions=null):(r=at(l,s),r.subtreeFlags=l.subtreeFlags&14680064),i!==null?u=at(i,u):(u=Ct(u,o,n,null),u.flags|=2),u.return=t,r.return=t,r.sibling=u,t.child=r,r=u,u=t.child,o=e.child.memoizedState,o=o===null?zu(n):{baseLanes:o.baseLanes|n,cachePool:null,transitions:o.transitions},u.memoizedState=o,u.childLanes=e.childLanes&~n,t.memoizedState=Pu,r}return u=e.child,e=u.sibling,r=at(u,{mode:"visible",children:r.children}),!(t.mode&1)&&(r.lanes=n),r.return=t,r.sibling=null,e!==null&&(n=t.deletions,n===null?(t.deletions=[e],t.flags|=16):n.push(e)),t.child=r,t.memoizedState=null,r}function ko(e,t){return t=fl({mode:"visible",children:t},e.mode,0,null),t.return=e,e.child=t}function mr(e,t,n,r){return r!==null&&oo(r),en(t,e.child,null,n),e=ko(t,t.pendingProps.children),e.flags|=2,t.memoizedState=null,e}function id(e,t,n,r,l,u,o){if(n)return t.flags&256?(t.flags&=-257,r=$l(Error(y(422))),mr(e,t,o,r)):t.memoizedState!==null?(t.child=e.child,t.flags|=128,null):(u=r.fallback,l=t.mode,r=fl({mode:"visible",children:r.children},l,0,null),u=Ct(u,l,o,null),u.flags|=2,r.return=t,u.return=t,r.sibling=u,t.child=r,t.mode&1&&en(t,e.child,null,o),t.child.memoizedState=zu(o),t.memoizedState=Pu,u);if(!(t.mode&1))return mr(e,t,o,null);if(l.data==="$!"){if(r=l.nextSibling&&l.nextSibling.dataset,r)var i=r.dgst;return r=i,u=Error(y(419)),r=$l(u,r,void 0),mr(e,t,o,r)}if(i=(o&e.childLanes)!==0,ce||i){if(r=Z,r!==null){switch(o&-o){case 4:l=2;break;case 16:l=8;break;case 64:case 128:case 256:case 512:case 1024:case 2048:case 4096:case 8192:case 16384:case 32768:case 65536:case 131072:case 262144:case 524288:case 1048576:case 2097152:case 4194304:case 8388608:case 16777216:case 33554432:case 67108864:l=32;break;case 536870912:l=268435456;break;default:l=0}l=l&(r.suspendedLanes|o)?0:l,l!==0&&l!==u.retryLane&&(u.retryLane=l,Ke(e,l),Oe(r,e,l,-1))}return Po(),r=$l(Error(y(421))),mr(e,t,o,r)}return l.data==="$?"?(t.flags|=128,t.child=e.child,t=Sd.bind(null,e),l._reactRetry=t,null):(e=u.treeContext,he=ut(l.nextSibling),ve=t,F=!0,Te=null,e!==null&&(Se[ke++]=$e,Se[ke++]=Be,Se[ke++]=xt,$e=e.id,Be=e.overflow,xt=t),t=ko(t,r.children),t.flags|=4096,t)}function Ri(e,t,n){e.lanes|=t;var r=e.alternate;r!==null&&(r.lanes|=t),ku(e.return,t,n)}function Bl(e,t,n,r,l){var u=e.memoizedState;u===null?e.memoizedState={isBackwards:t,rendering:null,renderingStartTime:0,last:r,tail:n,tailMode:l}:(u.isBackwards=t,u.rendering=null,u.renderingStartTime=0,u.last=r,u.tail=n,u.tailMode=l)}function Da(e,t,n){var r=t.pendingProps,l=r.revealOrder,u=r.tail;if(ue(e,t,r.children,n),r=U.current,r&2)r=r&1|2,t.flags|=128;else{if(e!==null&&e.flags&128)e:for(e=t.child;e!==null;){if(e.tag===13)e.memoizedState!==null&&Ri(e,n,t);else if(e.tag===19)Ri(e,n,t);else if(e.child!==null){e.child.return=e,e=e.child;continue}if(e===t)break e;for(;e.sibling===null;){if(e.return===null||e.return===t)break e;e=e.return}e.sibling.return=e.return,e=e.sibling}r&=1}if(M(U,r),!(t.mode&1))t.memoizedState=null;else switch(l){case"forwards":for(n=t.child,l=null;n!==null;)e=n.alternate,e!==null&&Kr(e)===null&&(l=n),n=n.sibling;n=l,n===null?(l=t.child,t.child=null):(l=n.sibling,n.sibling=null),Bl(t,!1,l,n,u);break;case"backwards":for(n=null,l=t.child,t.child=null;l!==null;){if(e=l.alternate,e!==null&&Kr(e)===null){t.child=l;break}e=l.sibling,l.sibling=n,n=l,l=e}Bl(t,!0,n,null,u);break;case"together":Bl(t,!1,null,null,void 0);break;default:t.memoizedState=null}return t.child}function Nr(e,t){!(t.mode&1)&&e!==null&&(e.alternate=null,t.alternate=null,t.flags|=2)}function Ye(e,t,n){if(e!==null&&(t.dependencies=e.dependencies),Pt|=t.lanes,!(n&t.childLanes))return null;if(e!==null&&t.child!==e.child)throw Error(y(153));if(t.child!==null){for(e=t.child,n=at(e,e.pendingProps),t.child=n,n.return=t;e.sibling!==null;)e=e.sibling,n=n.sibling=at(e,e.pendingProps),n.return=t;n.sibling=null}return t.child}function sd(e,t,n){switch(t.tag){case 3:Oa(t),bt();break;case 5:oa(t);break;case 1:de(t.type)&&Vr(t);break;case 4:po(t,t.stateNode.containerInfo);break;case 10:var r=t.type._context,l=t.memoizedProps.value;M(Hr,r._currentValue),r._currentValue=l;break;case 13:if(r=t.memoizedState,r!==null)return r.dehydrated!==null?(M(U,U.current&1),t.flags|=128,null):n&t.child.childLanes?Ma(e,t,n):(M(U,U.current&1),e=Ye(e,t,n),e!==null?e.sibling:null);M(U,U.current&1);break;case 19:if(r=(n&t.childLanes)!==0,e.flags&128){if(r)return Da(e,t,n);t.flags|=128}if(l=t.memoizedState,l!==null&&(l.rendering=null,l.tail=null,l.lastEffect=null),M(U,U.current),r)break;return null;case 22:case 23:return t.lanes=0,Ta(e,t,n)}return Ye(e,t,n)}var ja,Lu,Ia,Fa;ja=function(e,t){for(var n=t.child;n!==null;){if(n.tag===5||n.tag===6)e.appendChild(n.stateNode);else if(n.tag!==4&&n.child!==null){n.child.return=n,n=n.child;continue}if(n===t)break;for(;n.sibling===null;){if(n.return===null||n.return===t)return;n=n.return}n.sibling.return=n.return,n=n.sibling}};Lu=function(){};Ia=function(e,t,n,r){var l=e.memoizedProps;if(l!==r){e=t.stateNode,kt(Ue.current);var u=null;switch(n){case"input":l=Jl(e,l),r=Jl(e,r),u=[];break;case"select":l=V({},l,{value:void 0}),r=V({},r,{value:void 0}),u=[];break;case"textarea":l=eu(e,l),r=eu(e,r),u=[];break;default:typeof l.onClick!="function"&&typeof r.onClick=="function"&&(e.onclick=Ur)}nu(n,r);var o;n=null;for(c in l)if(!r.hasOwnProperty(c)&&l.hasOwnProperty(c)&&l[c]!=null)if(c==="style"){var i=l[c];for(o in i)i.hasOwnProperty(o)&&(n||(n={}),n[o]="")}else c!=="dangerouslySetInnerHTML"&&c!=="children"&&c!=="suppressContentEditableWarning"&&c!=="suppressHydrationWarning"&&c!=="autoFocus"&&(Rn.hasOwnProperty(c)?u||(u=[]):(u=u||[]).push(c,null));for(c in r){var s=r[c];if(i=l!=null?l[c]:void 0,r.hasOwnProperty(c)&&s!==i&&(s!=null||i!=null))if(c==="style")if(i){for(o in i)!i.hasOwnProperty(o)||s&&s.hasOwnProperty(o)||(n||(n={}),n[o]="");for(o in s)s.hasOwnProperty(o)&&i[o]!==s[o]&&(n||(n={}),n[o]=s[o])}else n||(u||(u=[]),u.push(c,n)),n=s;else c==="dangerouslySetInnerHTML"?(s=s?s.__html:void 0,i=i?i.__html:void 0,s!=null&&i!==s&&(u=u||[]).push(c,s)):c==="children"?typeof s!="string"&&typeof s!="number"||(u=u||[]).push(c,""+s):c!=="suppressContentEditableWarning"&&c!=="suppressHydrationWarning"&&(Rn.hasOwnProperty(c)?(s!=null&&c==="onScroll"&&D("scroll",e),u||i===s||(u=[])):(u=u||[]).push(c,s))}n&&(u=u||[]).push("style",n);var c=u;(t.updateQueue=c)&&(t.flags|=4)}};Fa=function(e,t,n,r){n!==r&&(t.flags|=4)};function vn(e,t){if(!F)switch(e.tailMode){case"hidden":t=e.tail;for(var n=null;t!==null;)t.alternate!==null&&(n=t),t=t.sibling;n===null?e.tail=null:n.sibling=null;break;case"collapsed":n=e.tail;for(var r=null;n!==null;)n.alternate!==null&&(r=n),n=n.sibling;r===null?t||e.tail===null?e.tail=null:e.tail.sibling=null:r.sibling=null}}function ne(e){var t=e.alternate!==null&&e.alternate.child===e.child,n=0,r=0;if(t)for(var l=e.child;l!==null;)n|=l.lanes|l.childLanes,r|=l.subtreeFlags&14680064,r|=l.flags&14680064,l.return=e,l=l.sibling;else for(l=e.child;l!==null;)n|=l.lanes|l.childLanes,r|=l.subtreeFlags,r|=l.flags,l.return=e,l=l.sibling;return e.subtreeFlags|=r,e.childLanes=n,t}function ad(e,t,n){var r=t.pendingProps;switch(uo(t),t.tag){case 2:case 16:case 15:case 0:case 11:case 7:case 8:case 12:case 9:case 14:return ne(t),null;case 1:return de(t.type)&&Ar(),ne(t),null;case 3:return r=t.stateNode,tn(),j(fe),j(le),ho(),r.pendingContext&&(r.context=r.pendingContext,r.pendingContext=null),(e===null||e.child===null)&&(dr(t)?t.flags|=4:e===null||e.memoizedState.isDehydrated&&!(t.flags&256)||(t.flags|=1024,Te!==null&&(Fu(Te),Te=null))),Lu(e,t),ne(t),null;case 5:mo(t);var l=kt(Hn.current);if(n=t.type,e!==null&&t.stateNode!=null)Ia(e,t,n,r,l),e.ref!==t.ref&&(t.flags|=512,t.flags|=2097152);else{if(!r){if(t.stateNode===null)throw Error(y(166));return ne(t),null}if(e=kt(Ue.current),dr(t)){r=t.stateNode,n=t.type;var u=t.memoizedProps;switch(r[Ie]=t,r[$n]=u,e=(t.mode&1)!==0,n){case"dialog":D("cancel",r),D("close",r);break;case"iframe":case"object":case"embed":D("load",r);break;case"video":case"audio":for(l=0;l<kn.length;l++)D(kn[l],r);break;case"source":D("error",r);break;case"img":case"image":case"link":D("error",r),D("load",r);break;case"details":D("toggle",r);break;case"input":Vo(r,u),D("invalid",r);break;case"select":r._wrapperState={wasMultiple:!!u.multiple},D("invalid",r);break;case"textarea":Bo(r,u),D("invalid",r)}nu(n,u),l=null;for(var o in u)if(u.hasOwnProperty(o)){var i=u[o];o==="children"?typeof i=="string"?r.textContent!==i&&(u.suppressHydrationWarning!==!0&&fr(r.textContent,i,e),l=["children",i]):typeof i=="number"&&r.textContent!==""+i&&(u.suppressHydrationWarning!==!0&&fr(r.textContent,i,e),l=["children",""+i]):Rn.hasOwnProperty(o)&&i!=null&&o==="onScroll"&&D("scroll",r)}switch(n){case"input":rr(r),$o(r,u,!0);break;case"textarea":rr(r),Ho(r);break;case"select":case"option":break;default:typeof u.onClick=="function"&&(r.onclick=Ur)}r=l,t.updateQueue=r,r!==null&&(t.flags|=4)}else{o=l.nodeType===9?l:l.ownerDocument,e==="http://www.w3.org/1999/xhtml"&&(e=fs(n)),e==="http://www.w3.org/1999/xhtml"?n==="script"?(e=o.createElement("div"),e.innerHTML="<script><\/script>",e=e.removeChild(e.firstChild)):typeof r.is=="string"?e=o.createElement(n,{is:r.is}):(e=o.createElement(n),n==="select"&&(o=e,r.multiple?o.multiple=!0:r.size&&(o.size=r.size))):e=o.createElementNS(e,n),e[Ie]=t,e[$n]=r,ja(e,t,!1,!1),t.stateNode=e;e:{switch(o=ru(n,r),n){case"dialog":D("cancel",e),D("close",e),l=r;break;case"iframe":case"object":case"embed":D("load",e),l=r;break;case"video":case"audio":for(l=0;l<kn.length;l++)D(kn[l],e);l=r;break;case"source":D("error",e),l=r;break;case"img":case"image":case"link":D("error",e),D("load",e),l=r;break;case"details":D("toggle",e),l=r;break;case"input":Vo(e,r),l=Jl(e,r),D("invalid",e);break;case"option":l=r;break;case"select":e._wrapperState={wasMultiple:!!r.multiple},l=V({},r,{value:void 0}),D("invalid",e);break;case"textarea":Bo(e,r),l=eu(e,r),D("invalid",e);break;default:l=r}nu(n,l),i=l;for(u in i)if(i.hasOwnProperty(u)){var s=i[u];u==="style"?ms(e,s):u==="dangerouslySetInnerHTML"?(s=s?s.__html:void 0,s!=null&&ds(e,s)):u==="children"?typeof s=="string"?(n!=="textarea"||s!=="")&&On(e,s):typeof s=="number"&&On(e,""+s):u!=="suppressContentEditableWarning"&&u!=="suppressHydrationWarning"&&u!=="autoFocus"&&(Rn.hasOwnProperty(u)?s!=null&&u==="onScroll"&&D("scroll",e):s!=null&&Wu(e,u,s,o))}switch(n){case"input":rr(e),$o(e,r,!1);break;case"textarea":rr(e),Ho(e);break;case"option":r.value!=null&&e.setAttribute("value",""+ct(r.value));break;case"select":e.multiple=!!r.multiple,u=r.value,u!=null?Qt(e,!!r.multiple,u,!1):r.defaultValue!=null&&Qt(e,!!r.multiple,r.defaultValue,!0);break;default:typeof l.onClick=="function"&&(e.onclick=Ur)}switch(n){case"button":case"input":case"select":case"textarea":r=!!r.autoFocus;break e;case"img":r=!0;break e;default:r=!1}}r&&(t.flags|=4)}t.ref!==null&&(t.flags|=512,t.flags|=2097152)}return ne(t),null;case 6:if(e&&t.stateNode!=null)Fa(e,t,e.memoizedProps,r);else{if(typeof r!="string"&&t.stateNode===null)throw Error(y(166));if(n=kt(Hn.current),kt(Ue.current),dr(t)){if(r=t.stateNode,n=t.memoizedProps,r[Ie]=t,(u=r.nodeValue!==n)&&(e=ve,e!==null))switch(e.tag){case 3:fr(r.nodeValue,n,(e.mode&1)!==0);break;case 5:e.memoizedProps.suppressHydrationWarning!==!0&&fr(r.nodeValue,n,(e.mode&1)!==0)}u&&(t.flags|=4)}else r=(n.nodeType===9?n:n.ownerDocument).createTextNode(r),r[Ie]=t,t.stateNode=r}return ne(t),null;case 13:if(j(U),r=t.memoizedState,e===null||e.memoizedState!==null&&e.memoizedState.dehydrated!==null){if(F&&he!==null&&t.mode&1&&!(t.flags&128))ta(),bt(),t.flags|=98560,u=!1;else if(u=dr(t),r!==null&&r.dehydrated!==null){if(e===null){if(!u)throw Error(y(318));if(u=t.memoizedState,u=u!==null?u.dehydrated:null,!u)throw Error(y(317));u[Ie]=t}else bt(),!(t.flags&128)&&(t.memoizedState=null),t.flags|=4;ne(t),u=!1}else Te!==null&&(Fu(Te),Te=null),u=!0;if(!u)return t.flags&65536?t:null}return t.flags&128?(t.lanes=n,t):(r=r!==null,r!==(e!==null&&e.memoizedState!==null)&&r&&(t.child.flags|=8192,t.mode&1&&(e===null||U.current&1?Y===0&&(Y=3):Po())),t.updateQueue!==null&&(t.flags|=4),ne(t),null);case 4:return tn(),Lu(e,t),e===null&&An(t.stateNode.containerInfo),ne(t),null;case 10:return ao(t.type._context),ne(t),null;case 17:return de(t.type)&&Ar(),ne(t),null;case 19:if(j(U),u=t.memoizedState,u===null)return ne(t),null;if(r=(t.flags&128)!==0,o=u.rendering,o===null)if(r)vn(u,!1);else{if(Y!==0||e!==null&&e.flags&128)for(e=t.child;e!==null;){if(o=Kr(e),o!==null){for(t.flags|=128,vn(u,!1),r=o.updateQueue,r!==null&&(t.updateQueue=r,t.flags|=4),t.subtreeFlags=0,r=n,n=t.child;n!==null;)u=n,e=r,u.flags&=14680066,o=u.alternate,o===null?(u.childLanes=0,u.lanes=e,u.child=null,u.subtreeFlags=0,u.memoizedProps=null,u.memoizedState=null,u.updateQueue=null,u.dependencies=null,u.stateNode=null):(u.childLanes=o.childLanes,u.lanes=o.lanes,u.child=o.child,u.subtreeFlags=0,u.deletions=null,u.memoizedProps=o.memoizedProps,u.memoizedState=o.memoizedState,u.updateQueue=o.updateQueue,u.type=o.type,e=o.dependencies,u.dependencies=e===null?null:{lanes:e.lanes,firstContext:e.firstContext}),n=n.sibling;return M(U,U.current&1|2),t.child}e=e.sibling}u.tail!==null&&W()>rn&&(t.flags|=128,r=!0,vn(u,!1),t.lanes=4194304)}else{if(!r)if(e=Kr(o),e!==null){if(t.flags|=128,r=!0,n=e.updateQueue,n!==null&&(t.updateQueue=n,t.flags|=4),vn(u,!0),u.tail===null&&u.tailMode==="hidden"&&!o.alternate&&!F)return ne(t),null}else 2*W()-u.renderingStartTime>rn&&n!==1073741824&&(t.flags|=128,r=!0,vn(u,!1),t.lanes=4194304);u.isBackwards?(o.sibling=t.child,t.child=o):(n=u.last,n!==null?n.sibling=o:t.child=o,u.last=o)}return u.tail!==null?(t=u.tail,u.rendering=t,u.tail=t.sibling,u.renderingStartTime=W(),t.sibling=null,n=U.current,M(U,r?n&1|2:n&1),t):(ne(t),null);case 22:case 23:return No(),r=t.memoizedState!==null,e!==null&&e.memoizedState!==null!==r&&(t.flags|=8192),r&&t.mode&1?me&1073741824&&(ne(t),t.subtreeFlags&6&&(t.flags|=8192)):ne(t),null;case 24:return null;case 25:return null}throw Error(y(156,t.tag))}function cd(e,t){switch(uo(t),t.tag){case 1:return de(t.type)&&Ar(),e=t.flags,e&65536?(t.flags=e&-65537|128,t):null;case 3:return tn(),j(fe),j(le),ho(),e=t.flags,e&65536&&!(e&128)?(t.flags=e&-65537|128,t):null;case 5:return mo(t),null;case 13:if(j(U),e=t.memoizedState,e!==null&&e.dehydrated!==null){if(t.alternate===null)throw Error(y(340));bt()}return e=t.flags,e&65536?(t.flags=e&-65537|128,t):null;case 19:return j(U),null;case 4:return tn(),null;case 10:return ao(t.type._context),null;case 22:case 23:return No(),null;case 24:return null;default:return null}}var hr=!1,re=!1,fd=typeof WeakSet=="function"?WeakSet:Set,k=null;function Bt(e,t){var n=e.ref;if(n!==null)if(typeof n=="function")try{n(null)}catch(r){$(e,t,r)}else n.current=null}function Tu(e,t,n){try{n()}catch(r){$(e,t,r)}}var Oi=!1;function dd(e,t){if(pu=jr,e=Bs(),ro(e)){if("selectionStart"in e)var n={start:e.selectionStart,end:e.selectionEnd};else e:{n=(n=e.ownerDocument)&&n.defaultView||window;var r=n.getSelection&&n.getSelection();if(r&&r.rangeCount!==0){n=r.anchorNode;var l=r.anchorOffset,u=r.focusNode;r=r.focusOffset;try{n.nodeType,u.nodeType}catch{n=null;break e}var o=0,i=-1,s=-1,c=0,h=0,m=e,p=null;t:for(;;){for(var g;m!==n||l!==0&&m.nodeType!==3||(i=o+l),m!==u||r!==0&&m.nodeType!==3||(s=o+r),m.nodeType===3&&(o+=m.nodeValue.length),(g=m.firstChild)!==null;)p=m,m=g;for(;;){if(m===e)break t;if(p===n&&++c===l&&(i=o),p===u&&++h===r&&(s=o),(g=m.nextSibling)!==null)break;m=p,p=m.parentNode}m=g}n=i===-1||s===-1?null:{start:i,end:s}}else n=null}n=n||{start:0,end:0}}else n=null;for(mu={focusedElem:e,selectionRange:n},jr=!1,k=t;k!==null;)if(t=k,e=t.child,(t.subtreeFlags&1028)!==0&&e!==null)e.return=t,k=e;else for(;k!==null;){t=k;try{var w=t.alternate;if(t.flags&1024)switch(t.tag){case 0:case 11:case 15:break;case 1:if(w!==null){var S=w.memoizedProps,I=w.memoizedState,f=t.stateNode,a=f.getSnapshotBeforeUpdate(t.elementType===t.type?S:ze(t.type,S),I);f.__reactInternalSnapshotBeforeUpdate=a}break;case 3:var d=t.stateNode.containerInfo;d.nodeType===1?d.textContent="":d.nodeType===9&&d.documentElement&&d.removeChild(d.documentElement);break;case 5:case 6:case 4:case 17:break;default:throw Error(y(163))}}catch(v){$(t,t.return,v)}if(e=t.sibling,e!==null){e.return=t.return,k=e;break}k=t.return}return w=Oi,Oi=!1,w}function zn(e,t,n){var r=t.updateQueue;if(r=r!==null?r.lastEffect:null,r!==null){var l=r=r.next;do{if((l.tag&e)===e){var u=l.destroy;l.destroy=void 0,u!==void 0&&Tu(t,n,u)}l=l.next}while(l!==r)}}function al(e,t){if(t=t.updateQueue,t=t!==null?t.lastEffect:null,t!==null){var n=t=t.next;do{if((n.tag&e)===e){var r=n.create;n.destroy=r()}n=n.next}while(n!==t)}}function Ru(e){var t=e.ref;if(t!==null){var n=e.stateNode;switch(e.tag){case 5:e=n;break;default:e=n}typeof t=="function"?t(e):t.current=e}}function Ua(e){var t=e.alternate;t!==null&&(e.alternate=null,Ua(t)),e.child=null,e.deletions=null,e.sibling=null,e.tag===5&&(t=e.stateNode,t!==null&&(delete t[Ie],delete t[$n],delete t[yu],delete t[Xf],delete t[Gf])),e.stateNode=null,e.return=null,e.dependencies=null,e.memoizedProps=null,e.memoizedState=null,e.pendingProps=null,e.stateNode=null,e.updateQueue=null}function Aa(e){return e.tag===5||e.tag===3||e.tag===4}function Mi(e){e:for(;;){for(;e.sibling===null;){if(e.return===null||Aa(e.return))return null;e=e.return}for(e.sibling.return=e.return,e=e.sibling;e.tag!==5&&e.tag!==6&&e.tag!==18;){if(e.flags&2||e.child===null||e.tag===4)continue e;e.child.return=e,e=e.child}if(!(e.flags&2))return e.stateNode}}function Ou(e,t,n){var r=e.tag;if(r===5||r===6)e=e.stateNode,t?n.nodeType===8?n.parentNode.insertBefore(e,t):n.insertBefore(e,t):(n.nodeType===8?(t=n.parentNode,t.insertBefore(e,n)):(t=n,t.appendChild(e)),n=n._reactRootContainer,n!=null||t.onclick!==null||(t.onclick=Ur));else if(r!==4&&(e=e.child,e!==null))for(Ou(e,t,n),e=e.sibling;e!==null;)Ou(e,t,n),e=e.sibling}function Mu(e,t,n){var r=e.tag;if(r===5||r===6)e=e.stateNode,t?n.insertBefore(e,t):n.appendChild(e);else if(r!==4&&(e=e.child,e!==null))for(Mu(e,t,n),e=e.sibling;e!==null;)Mu(e,t,n),e=e.sibling}var q=null,Le=!1;function Ge(e,t,n){for(n=n.child;n!==null;)Va(e,t,n),n=n.sibling}function Va(e,t,n){if(Fe&&typeof Fe.onCommitFiberUnmount=="function")try{Fe.onCommitFiberUnmount(tl,n)}catch{}switch(n.tag){case 5:re||Bt(n,t);case 6:var r=q,l=Le;q=null,Ge(e,t,n),q=r,Le=l,q!==null&&(Le?(e=q,n=n.stateNode,e.nodeType===8?e.parentNode.removeChild(n):e.removeChild(n)):q.removeChild(n.stateNode));break;case 18:q!==null&&(Le?(e=q,n=n.stateNode,e.nodeType===8?jl(e.parentNode,n):e.nodeType===1&&jl(e,n),In(e)):jl(q,n.stateNode));break;case 4:r=q,l=Le,q=n.stateNode.containerInfo,Le=!0,Ge(e,t,n),q=r,Le=l;break;case 0:case 11:case 14:case 15:if(!re&&(r=n.updateQueue,r!==null&&(r=r.lastEffect,r!==null))){l=r=r.next;do{var u=l,o=u.destroy;u=u.tag,o!==void 0&&(u&2||u&4)&&Tu(n,t,o),l=l.next}while(l!==r)}Ge(e,t,n);break;case 1:if(!re&&(Bt(n,t),r=n.stateNode,typeof r.componentWillUnmount=="function"))try{r.props=n.memoizedProps,r.state=n.memoizedState,r.componentWillUnmount()}catch(i){$(n,t,i)}Ge(e,t,n);break;case 21:Ge(e,t,n);break;case 22:n.mode&1?(re=(r=re)||n.memoizedState!==null,Ge(e,t,n),re=r):Ge(e,t,n);break;default:Ge(e,t,n)}}function Di(e){var t=e.updateQueue;if(t!==null){e.updateQueue=null;var n=e.stateNode;n===null&&(n=e.stateNode=new fd),t.forEach(function(r){var l=kd.bind(null,e,r);n.has(r)||(n.add(r),r.then(l,l))})}}function Pe(e,t){var n=t.deletions;if(n!==null)for(var r=0;r<n.length;r++){var l=n[r];try{var u=e,o=t,i=o;e:for(;i!==null;){switch(i.tag){case 5:q=i.stateNode,Le=!1;break e;case 3:q=i.stateNode.containerInfo,Le=!0;break e;case 4:q=i.stateNode.containerInfo,Le=!0;break e}i=i.return}if(q===null)throw Error(y(160));Va(u,o,l),q=null,Le=!1;var s=l.alternate;s!==null&&(s.return=null),l.return=null}catch(c){$(l,t,c)}}if(t.subtreeFlags&12854)for(t=t.child;t!==null;)$a(t,e),t=t.sibling}function $a(e,t){var n=e.alternate,r=e.flags;switch(e.tag){case 0:case 11:case 14:case 15:if(Pe(t,e),De(e),r&4){try{zn(3,e,e.return),al(3,e)}catch(S){$(e,e.return,S)}try{zn(5,e,e.return)}catch(S){$(e,e.return,S)}}break;case 1:Pe(t,e),De(e),r&512&&n!==null&&Bt(n,n.return);break;case 5:if(Pe(t,e),De(e),r&512&&n!==null&&Bt(n,n.return),e.flags&32){var l=e.stateNode;try{On(l,"")}catch(S){$(e,e.return,S)}}if(r&4&&(l=e.stateNode,l!=null)){var u=e.memoizedProps,o=n!==null?n.memoizedProps:u,i=e.type,s=e.updateQueue;if(e.updateQueue=null,s!==null)try{i==="input"&&u.type==="radio"&&u.name!=null&&as(l,u),ru(i,o);var c=ru(i,u);for(o=0;o<s.length;o+=2){var h=s[o],m=s[o+1];h==="style"?ms(l,m):h==="dangerouslySetInnerHTML"?ds(l,m):h==="children"?On(l,m):Wu(l,h,m,c)}switch(i){case"input":ql(l,u);break;case"textarea":cs(l,u);break;case"select":var p=l._wrapperState.wasMultiple;l._wrapperState.wasMultiple=!!u.multiple;var g=u.value;g!=null?Qt(l,!!u.multiple,g,!1):p!==!!u.multiple&&(u.defaultValue!=null?Qt(l,!!u.multiple,u.defaultValue,!0):Qt(l,!!u.multiple,u.multiple?[]:"",!1))}l[$n]=u}catch(S){$(e,e.return,S)}}break;case 6:if(Pe(t,e),De(e),r&4){if(e.stateNode===null)throw Error(y(162));l=e.stateNode,u=e.memoizedProps;try{l.nodeValue=u}catch(S){$(e,e.return,S)}}break;case 3:if(Pe(t,e),De(e),r&4&&n!==null&&n.memoizedState.isDehydrated)try{In(t.containerInfo)}catch(S){$(e,e.return,S)}break;case 4:Pe(t,e),De(e);break;case 13:Pe(t,e),De(e),l=e.child,l.flags&8192&&(u=l.memoizedState!==null,l.stateNode.isHidden=u,!u||l.alternate!==null&&l.alternate.memoizedState!==null||(_o=W())),r&4&&Di(e);break;case 22:if(h=n!==null&&n.memoizedState!==null,e.mode&1?(re=(c=re)||h,Pe(t,e),re=c):Pe(t,e),De(e),r&8192){if(c=e.memoizedState!==null,(e.stateNode.isHidden=c)&&!h&&e.mode&1)for(k=e,h=e.child;h!==null;){for(m=k=h;k!==null;){switch(p=k,g=p.child,p.tag){case 0:case 11:case 14:case 15:zn(4,p,p.return);break;case 1:Bt(p,p.return);var w=p.stateNode;if(typeof w.componentWillUnmount=="function"){r=p,n=p.return;try{t=r,w.props=t.memoizedProps,w.state=t.memoizedState,w.componentWillUnmount()}catch(S){$(r,n,S)}}break;case 5:Bt(p,p.return);break;case 22:if(p.memoizedState!==null){Ii(m);continue}}g!==null?(g.return=p,k=g):Ii(m)}h=h.sibling}e:for(h=null,m=e;;){if(m.tag===5){if(h===null){h=m;try{l=m.stateNode,c?(u=l.style,typeof u.setProperty=="function"?u.setProperty("display","none","important"):u.display="none"):(i=m.stateNode,s=m.memoizedProps.style,o=s!=null&&s.hasOwnProperty("display")?s.display:null,i.style.display=ps("display",o))}catch(S){$(e,e.return,S)}}}else if(m.tag===6){if(h===null)try{m.stateNode.nodeValue=c?"":m.memoizedProps}catch(S){$(e,e.return,S)}}else if((m.tag!==22&&m.tag!==23||m.memoizedState===null||m===e)&&m.child!==null){m.child.return=m,m=m.child;continue}if(m===e)break e;for(;m.sibling===null;){if(m.return===null||m.return===e)break e;h===m&&(h=null),m=m.return}h===m&&(h=null),m.sibling.return=m.return,m=m.sibling}}break;case 19:Pe(t,e),De(e),r&4&&Di(e);break;case 21:break;default:Pe(t,e),De(e)}}function De(e){var t=e.flags;if(t&2){try{e:{for(var n=e.return;n!==null;){if(Aa(n)){var r=n;break e}n=n.return}throw Error(y(160))}switch(r.tag){case 5:var l=r.stateNode;r.flags&32&&(On(l,""),r.flags&=-33);var u=Mi(e);Mu(e,u,l);break;case 3:case 4:var o=r.stateNode.containerInfo,i=Mi(e);Ou(e,i,o);break;default:throw Error(y(161))}}catch(s){$(e,e.return,s)}e.flags&=-3}t&4096&&(e.flags&=-4097)}function pd(e,t,n){k=e,Ba(e)}function Ba(e,t,n){for(var r=(e.mode&1)!==0;k!==null;){var l=k,u=l.child;if(l.tag===22&&r){var o=l.memoizedState!==null||hr;if(!o){var i=l.alternate,s=i!==null&&i.memoizedState!==null||re;i=hr;var c=re;if(hr=o,(re=s)&&!c)for(k=l;k!==null;)o=k,s=o.child,o.tag===22&&o.memoizedState!==null?Fi(l):s!==null?(s.return=o,k=s):Fi(l);for(;u!==null;)k=u,Ba(u),u=u.sibling;k=l,hr=i,re=c}ji(e)}else l.subtreeFlags&8772&&u!==null?(u.return=l,k=u):ji(e)}}function ji(e){for(;k!==null;){var t=k;if(t.flags&8772){var n=t.alternate;try{if(t.flags&8772)switch(t.tag){case 0:case 11:case 15:re||al(5,t);break;case 1:var r=t.stateNode;if(t.flags&4&&!re)if(n===null)r.componentDidMount();else{var l=t.elementType===t.type?n.memoizedProps:ze(t.type,n.memoizedProps);r.componentDidUpdate(l,n.memoizedState,r.__reactInternalSnapshotBeforeUpdate)}var u=t.updateQueue;u!==null&&wi(t,u,r);break;case 3:var o=t.updateQueue;if(o!==null){if(n=null,t.child!==null)switch(t.child.tag){case 5:n=t.child.stateNode;break;case 1:n=t.child.stateNode}wi(t,o,n)}break;case 5:var i=t.stateNode;if(n===null&&t.flags&4){n=i;var s=t.memoizedProps;switch(t.type){case"button":case"input":case"select":case"textarea":s.autoFocus&&n.focus();break;case"img":s.src&&(n.src=s.src)}}break;case 6:break;case 4:break;case 12:break;case 13:if(t.memoizedState===null){var c=t.alternate;if(c!==null){var h=c.memoizedState;if(h!==null){var m=h.dehydrated;m!==null&&In(m)}}}break;case 19:case 17:case 21:case 22:case 23:case 25:break;default:throw Error(y(163))}re||t.flags&512&&Ru(t)}catch(p){$(t,t.return,p)}}if(t===e){k=null;break}if(n=t.sibling,n!==null){n.return=t.return,k=n;break}k=t.return}}function Ii(e){for(;k!==null;){var t=k;if(t===e){k=null;break}var n=t.sibling;if(n!==null){n.return=t.return,k=n;break}k=t.return}}function Fi(e){for(;k!==null;){var t=k;try{switch(t.tag){case 0:case 11:case 15:var n=t.return;try{al(4,t)}catch(s){$(t,n,s)}break;case 1:var r=t.stateNode;if(typeof r.componentDidMount=="function"){var l=t.return;try{r.componentDidMount()}catch(s){$(t,l,s)}}var u=t.return;try{Ru(t)}catch(s){$(t,u,s)}break;case 5:var o=t.return;try{Ru(t)}catch(s){$(t,o,s)}}}catch(s){$(t,t.return,s)}if(t===e){k=null;break}var i=t.sibling;if(i!==null){i.return=t.return,k=i;break}k=t.return}}var md=Math.ceil,Gr=Xe.ReactCurrentDispatcher,Eo=Xe.ReactCurrentOwner,Ce=Xe.ReactCurrentBatchConfig,R=0,Z=null,Q=null,b=0,me=0,Ht=pt(0),Y=0,Yn=null,Pt=0,cl=0,Co=0,Ln=null,ae=null,_o=0,rn=1/0,Ae=null,Zr=!1,Du=null,it=null,vr=!1,tt=null,Jr=0,Tn=0,ju=null,Pr=-1,zr=0;function oe(){return R&6?W():Pr!==-1?Pr:Pr=W()}function st(e){return e.mode&1?R&2&&b!==0?b&-b:Jf.transition!==null?(zr===0&&(zr=Ns()),zr):(e=O,e!==0||(e=window.event,e=e===void 0?16:Ms(e.type)),e):1}function Oe(e,t,n,r){if(50<Tn)throw Tn=0,ju=null,Error(y(185));Gn(e,n,r),(!(R&2)||e!==Z)&&(e===Z&&(!(R&2)&&(cl|=n),Y===4&&be(e,b)),pe(e,r),n===1&&R===0&&!(t.mode&1)&&(rn=W()+500,ol&&mt()))}function pe(e,t){var n=e.callbackNode;Zc(e,t);var r=Dr(e,e===Z?b:0);if(r===0)n!==null&&Ko(n),e.callbackNode=null,e.callbackPriority=0;else if(t=r&-r,e.callbackPriority!==t){if(n!=null&&Ko(n),t===1)e.tag===0?Zf(Ui.bind(null,e)):qs(Ui.bind(null,e)),Kf(function(){!(R&6)&&mt()}),n=null;else{switch(Ps(r)){case 1:n=Gu;break;case 4:n=_s;break;case 16:n=Mr;break;case 536870912:n=xs;break;default:n=Mr}n=Za(n,Ha.bind(null,e))}e.callbackPriority=t,e.callbackNode=n}}function Ha(e,t){if(Pr=-1,zr=0,R&6)throw Error(y(327));var n=e.callbackNode;if(Zt()&&e.callbackNode!==n)return null;var r=Dr(e,e===Z?b:0);if(r===0)return null;if(r&30||r&e.expiredLanes||t)t=qr(e,r);else{t=r;var l=R;R|=2;var u=Qa();(Z!==e||b!==t)&&(Ae=null,rn=W()+500,Et(e,t));do try{yd();break}catch(i){Wa(e,i)}while(!0);so(),Gr.current=u,R=l,Q!==null?t=0:(Z=null,b=0,t=Y)}if(t!==0){if(t===2&&(l=su(e),l!==0&&(r=l,t=Iu(e,l))),t===1)throw n=Yn,Et(e,0),be(e,r),pe(e,W()),n;if(t===6)be(e,r);else{if(l=e.current.alternate,!(r&30)&&!hd(l)&&(t=qr(e,r),t===2&&(u=su(e),u!==0&&(r=u,t=Iu(e,u))),t===1))throw n=Yn,Et(e,0),be(e,r),pe(e,W()),n;switch(e.finishedWork=l,e.finishedLanes=r,t){case 0:case 1:throw Error(y(345));case 2:gt(e,ae,Ae);break;case 3:if(be(e,r),(r&130023424)===r&&(t=_o+500-W(),10<t)){if(Dr(e,0)!==0)break;if(l=e.suspendedLanes,(l&r)!==r){oe(),e.pingedLanes|=e.suspendedLanes&l;break}e.timeoutHandle=vu(gt.bind(null,e,ae,Ae),t);break}gt(e,ae,Ae);break;case 4:if(be(e,r),(r&4194240)===r)break;for(t=e.eventTimes,l=-1;0<r;){var o=31-Re(r);u=1<<o,o=t[o],o>l&&(l=o),r&=~u}if(r=l,r=W()-r,r=(120>r?120:480>r?480:1080>r?1080:1920>r?1920:3e3>r?3e3:4320>r?4320:1960*md(r/1960))-r,10<r){e.timeoutHandle=vu(gt.bind(null,e,ae,Ae),r);break}gt(e,ae,Ae);break;case 5:gt(e,ae,Ae);break;default:throw Error(y(329))}}}return pe(e,W()),e.callbackNode===n?Ha.bind(null,e):null}function Iu(e,t){var n=Ln;return e.current.memoizedState.isDehydrated&&(Et(e,t).flags|=256),e=qr(e,t),e!==2&&(t=ae,ae=n,t!==null&&Fu(t)),e}function Fu(e){ae===null?ae=e:ae.push.apply(ae,e)}function hd(e){for(var t=e;;){if(t.flags&16384){var n=t.updateQueue;if(n!==null&&(n=n.stores,n!==null))for(var r=0;r<n.length;r++){var l=n[r],u=l.getSnapshot;l=l.value;try{if(!Me(u(),l))return!1}catch{return!1}}}if(n=t.child,t.subtreeFlags&16384&&n!==null)n.return=t,t=n;else{if(t===e)break;for(;t.sibling===null;){if(t.return===null||t.return===e)return!0;t=t.return}t.sibling.return=t.return,t=t.sibling}}return!0}function be(e,t){for(t&=~Co,t&=~cl,e.suspendedLanes|=t,e.pingedLanes&=~t,e=e.expirationTimes;0<t;){var n=31-Re(t),r=1<<n;e[n]=-1,t&=~r}}function Ui(e){if(R&6)throw Error(y(327));Zt();var t=Dr(e,0);if(!(t&1))return pe(e,W()),null;var n=qr(e,t);if(e.tag!==0&&n===2){var r=su(e);r!==0&&(t=r,n=Iu(e,r))}if(n===1)throw n=Yn,Et(e,0),be(e,t),pe(e,W()),n;if(n===6)throw Error(y(345));return e.finishedWork=e.current.alternate,e.finishedLanes=t,gt(e,ae,Ae),pe(e,W()),null}function xo(e,t){var n=R;R|=1;try{return e(t)}finally{R=n,R===0&&(rn=W()+500,ol&&mt())}}function zt(e){tt!==null&&tt.tag===0&&!(R&6)&&Zt();var t=R;R|=1;var n=Ce.transition,r=O;try{if(Ce.transition=null,O=1,e)return e()}finally{O=r,Ce.transition=n,R=t,!(R&6)&&mt()}}function No(){me=Ht.current,j(Ht)}function Et(e,t){e.finishedWork=null,e.finishedLanes=0;var n=e.timeoutHandle;if(n!==-1&&(e.timeoutHandle=-1,Qf(n)),Q!==null)for(n=Q.return;n!==null;){var r=n;switch(uo(r),r.tag){case 1:r=r.type.childContextTypes,r!=null&&Ar();break;case 3:tn(),j(fe),j(le),ho();break;case 5:mo(r);break;case 4:tn();break;case 13:j(U);break;case 19:j(U);break;case 10:ao(r.type._context);break;case 22:case 23:No()}n=n.return}if(Z=e,Q=e=at(e.current,null),b=me=t,Y=0,Yn=null,Co=cl=Pt=0,ae=Ln=null,St!==null){for(t=0;t<St.length;t++)if(n=St[t],r=n.interleaved,r!==null){n.interleaved=null;var l=r.next,u=n.pending;if(u!==null){var o=u.next;u.next=l,r.next=o}n.pending=r}St=null}return e}function Wa(e,t){do{var n=Q;try{if(so(),_r.current=Xr,Yr){for(var r=A.memoizedState;r!==null;){var l=r.queue;l!==null&&(l.pending=null),r=r.next}Yr=!1}if(Nt=0,G=K=A=null,Pn=!1,Wn=0,Eo.current=null,n===null||n.return===null){Y=1,Yn=t,Q=null;break}e:{var u=e,o=n.return,i=n,s=t;if(t=b,i.flags|=32768,s!==null&&typeof s=="object"&&typeof s.then=="function"){var c=s,h=i,m=h.tag;if(!(h.mode&1)&&(m===0||m===11||m===15)){var p=h.alternate;p?(h.updateQueue=p.updateQueue,h.memoizedState=p.memoizedState,h.lanes=p.lanes):(h.updateQueue=null,h.memoizedState=null)}var g=xi(o);if(g!==null){g.flags&=-257,Ni(g,o,i,u,t),g.mode&1&&_i(u,c,t),t=g,s=c;var w=t.updateQueue;if(w===null){var S=new Set;S.add(s),t.updateQueue=S}else w.add(s);break e}else{if(!(t&1)){_i(u,c,t),Po();break e}s=Error(y(426))}}else if(F&&i.mode&1){var I=xi(o);if(I!==null){!(I.flags&65536)&&(I.flags|=256),Ni(I,o,i,u,t),oo(nn(s,i));break e}}u=s=nn(s,i),Y!==4&&(Y=2),Ln===null?Ln=[u]:Ln.push(u),u=o;do{switch(u.tag){case 3:u.flags|=65536,t&=-t,u.lanes|=t;var f=Pa(u,s,t);gi(u,f);break e;case 1:i=s;var a=u.type,d=u.stateNode;if(!(u.flags&128)&&(typeof a.getDerivedStateFromError=="function"||d!==null&&typeof d.componentDidCatch=="function"&&(it===null||!it.has(d)))){u.flags|=65536,t&=-t,u.lanes|=t;var v=za(u,i,t);gi(u,v);break e}}u=u.return}while(u!==null)}Ya(n)}catch(E){t=E,Q===n&&n!==null&&(Q=n=n.return);continue}break}while(!0)}function Qa(){var e=Gr.current;return Gr.current=Xr,e===null?Xr:e}function Po(){(Y===0||Y===3||Y===2)&&(Y=4),Z===null||!(Pt&268435455)&&!(cl&268435455)||be(Z,b)}function qr(e,t){var n=R;R|=2;var r=Qa();(Z!==e||b!==t)&&(Ae=null,Et(e,t));do try{vd();break}catch(l){Wa(e,l)}while(!0);if(so(),R=n,Gr.current=r,Q!==null)throw Error(y(261));return Z=null,b=0,Y}function vd(){for(;Q!==null;)Ka(Q)}function yd(){for(;Q!==null&&!$c();)Ka(Q)}function Ka(e){var t=Ga(e.alternate,e,me);e.memoizedProps=e.pendingProps,t===null?Ya(e):Q=t,Eo.current=null}function Ya(e){var t=e;do{var n=t.alternate;if(e=t.return,t.flags&32768){if(n=cd(n,t),n!==null){n.flags&=32767,Q=n;return}if(e!==null)e.flags|=32768,e.subtreeFlags=0,e.deletions=null;else{Y=6,Q=null;return}}else if(n=ad(n,t,me),n!==null){Q=n;return}if(t=t.sibling,t!==null){Q=t;return}Q=t=e}while(t!==null);Y===0&&(Y=5)}function gt(e,t,n){var r=O,l=Ce.transition;try{Ce.transition=null,O=1,gd(e,t,n,r)}finally{Ce.transition=l,O=r}return null}function gd(e,t,n,r){do Zt();while(tt!==null);if(R&6)throw Error(y(327));n=e.finishedWork;var l=e.finishedLanes;if(n===null)return null;if(e.finishedWork=null,e.finishedLanes=0,n===e.current)throw Error(y(177));e.callbackNode=null,e.callbackPriority=0;var u=n.lanes|n.childLanes;if(Jc(e,u),e===Z&&(Q=Z=null,b=0),!(n.subtreeFlags&2064)&&!(n.flags&2064)||vr||(vr=!0,Za(Mr,function(){return Zt(),null})),u=(n.flags&15990)!==0,n.subtreeFlags&15990||u){u=Ce.transition,Ce.transition=null;var o=O;O=1;var i=R;R|=4,Eo.current=null,dd(e,n),$a(n,e),Uf(mu),jr=!!pu,mu=pu=null,e.current=n,pd(n),Bc(),R=i,O=o,Ce.transition=u}else e.current=n;if(vr&&(vr=!1,tt=e,Jr=l),u=e.pendingLanes,u===0&&(it=null),Qc(n.stateNode),pe(e,W()),t!==null)for(r=e.onRecoverableError,n=0;n<t.length;n++)l=t[n],r(l.value,{componentStack:l.stack,digest:l.digest});if(Zr)throw Zr=!1,e=Du,Du=null,e;return Jr&1&&e.tag!==0&&Zt(),u=e.pendingLanes,u&1?e===ju?Tn++:(Tn=0,ju=e):Tn=0,mt(),null}function Zt(){if(tt!==null){var e=Ps(Jr),t=Ce.transition,n=O;try{if(Ce.transition=null,O=16>e?16:e,tt===null)var r=!1;else{if(e=tt,tt=null,Jr=0,R&6)throw Error(y(331));var l=R;for(R|=4,k=e.current;k!==null;){var u=k,o=u.child;if(k.flags&16){var i=u.deletions;if(i!==null){for(var s=0;s<i.length;s++){var c=i[s];for(k=c;k!==null;){var h=k;switch(h.tag){case 0:case 11:case 15:zn(8,h,u)}var m=h.child;if(m!==null)m.return=h,k=m;else for(;k!==null;){h=k;var p=h.sibling,g=h.return;if(Ua(h),h===c){k=null;break}if(p!==null){p.return=g,k=p;break}k=g}}}var w=u.alternate;if(w!==null){var S=w.child;if(S!==null){w.child=null;do{var I=S.sibling;S.sibling=null,S=I}while(S!==null)}}k=u}}if(u.subtreeFlags&2064&&o!==null)o.return=u,k=o;else e:for(;k!==null;){if(u=k,u.flags&2048)switch(u.tag){case 0:case 11:case 15:zn(9,u,u.return)}var f=u.sibling;if(f!==null){f.return=u.return,k=f;break e}k=u.return}}var a=e.current;for(k=a;k!==null;){o=k;var d=o.child;if(o.subtreeFlags&2064&&d!==null)d.return=o,k=d;else e:for(o=a;k!==null;){if(i=k,i.flags&2048)try{switch(i.tag){case 0:case 11:case 15:al(9,i)}}catch(E){$(i,i.return,E)}if(i===o){k=null;break e}var v=i.sibling;if(v!==null){v.return=i.return,k=v;break e}k=i.return}}if(R=l,mt(),Fe&&typeof Fe.onPostCommitFiberRoot=="function")try{Fe.onPostCommitFiberRoot(tl,e)}catch{}r=!0}return r}finally{O=n,Ce.transition=t}}return!1}function Ai(e,t,n){t=nn(n,t),t=Pa(e,t,1),e=ot(e,t,1),t=oe(),e!==null&&(Gn(e,1,t),pe(e,t))}function $(e,t,n){if(e.tag===3)Ai(e,e,n);else for(;t!==null;){if(t.tag===3){Ai(t,e,n);break}else if(t.tag===1){var r=t.stateNode;if(typeof t.type.getDerivedStateFromError=="function"||typeof r.componentDidCatch=="function"&&(it===null||!it.has(r))){e=nn(n,e),e=za(t,e,1),t=ot(t,e,1),e=oe(),t!==null&&(Gn(t,1,e),pe(t,e));break}}t=t.return}}function wd(e,t,n){var r=e.pingCache;r!==null&&r.delete(t),t=oe(),e.pingedLanes|=e.suspendedLanes&n,Z===e&&(b&n)===n&&(Y===4||Y===3&&(b&130023424)===b&&500>W()-_o?Et(e,0):Co|=n),pe(e,t)}function Xa(e,t){t===0&&(e.mode&1?(t=or,or<<=1,!(or&130023424)&&(or=4194304)):t=1);var n=oe();e=Ke(e,t),e!==null&&(Gn(e,t,n),pe(e,n))}function Sd(e){var t=e.memoizedState,n=0;t!==null&&(n=t.retryLane),Xa(e,n)}function kd(e,t){var n=0;switch(e.tag){case 13:var r=e.stateNode,l=e.memoizedState;l!==null&&(n=l.retryLane);break;case 19:r=e.stateNode;break;default:throw Error(y(314))}r!==null&&r.delete(t),Xa(e,n)}var Ga;Ga=function(e,t,n){if(e!==null)if(e.memoizedProps!==t.pendingProps||fe.current)ce=!0;else{if(!(e.lanes&n)&&!(t.flags&128))return ce=!1,sd(e,t,n);ce=!!(e.flags&131072)}else ce=!1,F&&t.flags&1048576&&bs(t,Br,t.index);switch(t.lanes=0,t.tag){case 2:var r=t.type;Nr(e,t),e=t.pendingProps;var l=qt(t,le.current);Gt(t,n),l=yo(null,t,r,e,l,n);var u=go();return t.flags|=1,typeof l=="object"&&l!==null&&typeof l.render=="function"&&l.$$typeof===void 0?(t.tag=1,t.memoizedState=null,t.updateQueue=null,de(r)?(u=!0,Vr(t)):u=!1,t.memoizedState=l.state!==null&&l.state!==void 0?l.state:null,fo(t),l.updater=sl,t.stateNode=l,l._reactInternals=t,Cu(t,r,e,n),t=Nu(null,t,r,!0,u,n)):(t.tag=0,F&&u&&lo(t),ue(null,t,l,n),t=t.child),t;case 16:r=t.elementType;e:{switch(Nr(e,t),e=t.pendingProps,l=r._init,r=l(r._payload),t.type=r,l=t.tag=Cd(r),e=ze(r,e),l){case 0:t=xu(null,t,r,e,n);break e;case 1:t=Li(null,t,r,e,n);break e;case 11:t=Pi(null,t,r,e,n);break e;case 14:t=zi(null,t,r,ze(r.type,e),n);break e}throw Error(y(306,r,""))}return t;case 0:return r=t.type,l=t.pendingProps,l=t.elementType===r?l:ze(r,l),xu(e,t,r,l,n);case 1:return r=t.type,l=t.pendingProps,l=t.elementType===r?l:ze(r,l),Li(e,t,r,l,n);case 3:e:{if(Oa(t),e===null)throw Error(y(387));r=t.pendingProps,u=t.memoizedState,l=u.element,ua(e,t),Qr(t,r,null,n);var o=t.memoizedState;if(r=o.element,u.isDehydrated)if(u={element:r,isDehydrated:!1,cache:o.cache,pendingSuspenseBoundaries:o.pendingSuspenseBoundaries,transitions:o.transitions},t.updateQueue.baseState=u,t.memoizedState=u,t.flags&256){l=nn(Error(y(423)),t),t=Ti(e,t,r,n,l);break e}else if(r!==l){l=nn(Error(y(424)),t),t=Ti(e,t,r,n,l);break e}else for(he=ut(t.stateNode.containerInfo.firstChild),ve=t,F=!0,Te=null,n=ra(t,null,r,n),t.child=n;n;)n.flags=n.flags&-3|4096,n=n.sibling;else{if(bt(),r===l){t=Ye(e,t,n);break e}ue(e,t,r,n)}t=t.child}return t;case 5:return oa(t),e===null&&Su(t),r=t.type,l=t.pendingProps,u=e!==null?e.memoizedProps:null,o=l.children,hu(r,l)?o=null:u!==null&&hu(r,u)&&(t.flags|=32),Ra(e,t),ue(e,t,o,n),t.child;case 6:return e===null&&Su(t),null;case 13:return Ma(e,t,n);case 4:return po(t,t.stateNode.containerInfo),r=t.pendingProps,e===null?t.child=en(t,null,r,n):ue(e,t,r,n),t.child;case 11:return r=t.type,l=t.pendingProps,l=t.elementType===r?l:ze(r,l),Pi(e,t,r,l,n);case 7:return ue(e,t,t.pendingProps,n),t.child;case 8:return ue(e,t,t.pendingProps.children,n),t.child;case 12:return ue(e,t,t.pendingProps.children,n),t.child;case 10:e:{if(r=t.type._context,l=t.pendingProps,u=t.memoizedProps,o=l.value,M(Hr,r._currentValue),r._currentValue=o,u!==null)if(Me(u.value,o)){if(u.children===l.children&&!fe.current){t=Ye(e,t,n);break e}}else for(u=t.child,u!==null&&(u.return=t);u!==null;){var i=u.dependencies;if(i!==null){o=u.child;for(var s=i.firstContext;s!==null;){if(s.context===r){if(u.tag===1){s=He(-1,n&-n),s.tag=2;var c=u.updateQueue;if(c!==null){c=c.shared;var h=c.pending;h===null?s.next=s:(s.next=h.next,h.next=s),c.pending=s}}u.lanes|=n,s=u.alternate,s!==null&&(s.lanes|=n),ku(u.return,n,t),i.lanes|=n;break}s=s.next}}else if(u.tag===10)o=u.type===t.type?null:u.child;else if(u.tag===18){if(o=u.return,o===null)throw Error(y(341));o.lanes|=n,i=o.alternate,i!==null&&(i.lanes|=n),ku(o,n,t),o=u.sibling}else o=u.child;if(o!==null)o.return=u;else for(o=u;o!==null;){if(o===t){o=null;break}if(u=o.sibling,u!==null){u.return=o.return,o=u;break}o=o.return}u=o}ue(e,t,l.children,n),t=t.child}return t;case 9:return l=t.type,r=t.pendingProps.children,Gt(t,n),l=_e(l),r=r(l),t.flags|=1,ue(e,t,r,n),t.child;case 14:return r=t.type,l=ze(r,t.pendingProps),l=ze(r.type,l),zi(e,t,r,l,n);case 15:return La(e,t,t.type,t.pendingProps,n);case 17:return r=t.type,l=t.pendingProps,l=t.elementType===r?l:ze(r,l),Nr(e,t),t.tag=1,de(r)?(e=!0,Vr(t)):e=!1,Gt(t,n),Na(t,r,l),Cu(t,r,l,n),Nu(null,t,r,!0,e,n);case 19:return Da(e,t,n);case 22:return Ta(e,t,n)}throw Error(y(156,t.tag))};function Za(e,t){return Cs(e,t)}function Ed(e,t,n,r){this.tag=e,this.key=n,this.sibling=this.child=this.return=this.stateNode=this.type=this.elementType=null,this.index=0,this.ref=null,this.pendingProps=t,this.dependencies=this.memoizedState=this.updateQueue=this.memoizedProps=null,this.mode=r,this.subtreeFlags=this.flags=0,this.deletions=null,this.childLanes=this.lanes=0,this.alternate=null}function Ee(e,t,n,r){return new Ed(e,t,n,r)}function zo(e){return e=e.prototype,!(!e||!e.isReactComponent)}function Cd(e){if(typeof e=="function")return zo(e)?1:0;if(e!=null){if(e=e.$$typeof,e===Ku)return 11;if(e===Yu)return 14}return 2}function at(e,t){var n=e.alternate;return n===null?(n=Ee(e.tag,t,e.key,e.mode),n.elementType=e.elementType,n.type=e.type,n.stateNode=e.stateNode,n.alternate=e,e.alternate=n):(n.pendingProps=t,n.type=e.type,n.flags=0,n.subtreeFlags=0,n.deletions=null),n.flags=e.flags&14680064,n.childLanes=e.childLanes,n.lanes=e.lanes,n.child=e.child,n.memoizedProps=e.memoizedProps,n.memoizedState=e.memoizedState,n.updateQueue=e.updateQueue,t=e.dependencies,n.dependencies=t===null?null:{lanes:t.lanes,firstContext:t.firstContext},n.sibling=e.sibling,n.index=e.index,n.ref=e.ref,n}function Lr(e,t,n,r,l,u){var o=2;if(r=e,typeof e=="function")zo(e)&&(o=1);else if(typeof e=="string")o=5;else e:switch(e){case Mt:return Ct(n.children,l,u,t);case Qu:o=8,l|=8;break;case Yl:return e=Ee(12,n,t,l|2),e.elementType=Yl,e.lanes=u,e;case Xl:return e=Ee(13,n,t,l),e.elementType=Xl,e.lanes=u,e;case Gl:return e=Ee(19,n,t,l),e.elementType=Gl,e.lanes=u,e;case os:return fl(n,l,u,t);default:if(typeof e=="object"&&e!==null)switch(e.$$typeof){case ls:o=10;break e;case us:o=9;break e;case Ku:o=11;break e;case Yu:o=14;break e;case Ze:o=16,r=null;break e}throw Error(y(130,e==null?e:typeof e,""))}return t=Ee(o,n,t,l),t.elementType=e,t.type=r,t.lanes=u,t}function Ct(e,t,n,r){return e=Ee(7,e,r,t),e.lanes=n,e}function fl(e,t,n,r){return e=Ee(22,e,r,t),e.elementType=os,e.lanes=n,e.stateNode={isHidden:!1},e}function Hl(e,t,n){return e=Ee(6,e,null,t),e.lanes=n,e}function Wl(e,t,n){return t=Ee(4,e.children!==null?e.children:[],e.key,t),t.lanes=n,t.stateNode={containerInfo:e.containerInfo,pendingChildren:null,implementation:e.implementation},t}function _d(e,t,n,r,l){this.tag=t,this.containerInfo=e,this.finishedWork=this.pingCache=this.current=this.pendingChildren=null,this.timeoutHandle=-1,this.callbackNode=this.pendingContext=this.context=null,this.callbackPriority=0,this.eventTimes=_l(0),this.expirationTimes=_l(-1),this.entangledLanes=this.finishedLanes=this.mutableReadLanes=this.expiredLanes=this.pingedLanes=this.suspendedLanes=this.pendingLanes=0,this.entanglements=_l(0),this.identifierPrefix=r,this.onRecoverableError=l,this.mutableSourceEagerHydrationData=null}function Lo(e,t,n,r,l,u,o,i,s){return e=new _d(e,t,n,i,s),t===1?(t=1,u===!0&&(t|=8)):t=0,u=Ee(3,null,null,t),e.current=u,u.stateNode=e,u.memoizedState={element:r,isDehydrated:n,cache:null,transitions:null,pendingSuspenseBoundaries:null},fo(u),e}function xd(e,t,n){var r=3<arguments.length&&arguments[3]!==void 0?arguments[3]:null;return{$$typeof:Ot,key:r==null?null:""+r,children:e,containerInfo:t,implementation:n}}function Ja(e){if(!e)return ft;e=e._reactInternals;e:{if(Tt(e)!==e||e.tag!==1)throw Error(y(170));var t=e;do{switch(t.tag){case 3:t=t.stateNode.context;break e;case 1:if(de(t.type)){t=t.stateNode.__reactInternalMemoizedMergedChildContext;break e}}t=t.return}while(t!==null);throw Error(y(171))}if(e.tag===1){var n=e.type;if(de(n))return Js(e,n,t)}return t}function qa(e,t,n,r,l,u,o,i,s){return e=Lo(n,r,!0,e,l,u,o,i,s),e.context=Ja(null),n=e.current,r=oe(),l=st(n),u=He(r,l),u.callback=t??null,ot(n,u,l),e.current.lanes=l,Gn(e,l,r),pe(e,r),e}function dl(e,t,n,r){var l=t.current,u=oe(),o=st(l);return n=Ja(n),t.context===null?t.context=n:t.pendingContext=n,t=He(u,o),t.payload={element:e},r=r===void 0?null:r,r!==null&&(t.callback=r),e=ot(l,t,o),e!==null&&(Oe(e,l,o,u),Cr(e,l,o)),o}function br(e){if(e=e.current,!e.child)return null;switch(e.child.tag){case 5:return e.child.stateNode;default:return e.child.stateNode}}function Vi(e,t){if(e=e.memoizedState,e!==null&&e.dehydrated!==null){var n=e.retryLane;e.retryLane=n!==0&&n<t?n:t}}function To(e,t){Vi(e,t),(e=e.alternate)&&Vi(e,t)}function Nd(){return null}var ba=typeof reportError=="function"?reportError:function(e){console.error(e)};function Ro(e){this._internalRoot=e}pl.prototype.render=Ro.prototype.render=function(e){var t=this._internalRoot;if(t===null)throw Error(y(409));dl(e,t,null,null)};pl.prototype.unmount=Ro.prototype.unmount=function(){var e=this._internalRoot;if(e!==null){this._internalRoot=null;var t=e.containerInfo;zt(function(){dl(null,e,null,null)}),t[Qe]=null}};function pl(e){this._internalRoot=e}pl.prototype.unstable_scheduleHydration=function(e){if(e){var t=Ts();e={blockedOn:null,target:e,priority:t};for(var n=0;n<qe.length&&t!==0&&t<qe[n].priority;n++);qe.splice(n,0,e),n===0&&Os(e)}};function Oo(e){return!(!e||e.nodeType!==1&&e.nodeType!==9&&e.nodeType!==11)}function ml(e){return!(!e||e.nodeType!==1&&e.nodeType!==9&&e.nodeType!==11&&(e.nodeType!==8||e.nodeValue!==" react-mount-point-unstable "))}function $i(){}function Pd(e,t,n,r,l){if(l){if(typeof r=="function"){var u=r;r=function(){var c=br(o);u.call(c)}}var o=qa(t,r,e,0,null,!1,!1,"",$i);return e._reactRootContainer=o,e[Qe]=o.current,An(e.nodeType===8?e.parentNode:e),zt(),o}for(;l=e.lastChild;)e.removeChild(l);if(typeof r=="function"){var i=r;r=function(){var c=br(s);i.call(c)}}var s=Lo(e,0,!1,null,null,!1,!1,"",$i);return e._reactRootContainer=s,e[Qe]=s.current,An(e.nodeType===8?e.parentNode:e),zt(function(){dl(t,s,n,r)}),s}function hl(e,t,n,r,l){var u=n._reactRootContainer;if(u){var o=u;if(typeof l=="function"){var i=l;l=function(){var s=br(o);i.call(s)}}dl(t,o,e,l)}else o=Pd(n,t,e,l,r);return br(o)}zs=function(e){switch(e.tag){case 3:var t=e.stateNode;if(t.current.memoizedState.isDehydrated){var n=Sn(t.pendingLanes);n!==0&&(Zu(t,n|1),pe(t,W()),!(R&6)&&(rn=W()+500,mt()))}break;case 13:zt(function(){var r=Ke(e,1);if(r!==null){var l=oe();Oe(r,e,1,l)}}),To(e,1)}};Ju=function(e){if(e.tag===13){var t=Ke(e,134217728);if(t!==null){var n=oe();Oe(t,e,134217728,n)}To(e,134217728)}};Ls=function(e){if(e.tag===13){var t=st(e),n=Ke(e,t);if(n!==null){var r=oe();Oe(n,e,t,r)}To(e,t)}};Ts=function(){return O};Rs=function(e,t){var n=O;try{return O=e,t()}finally{O=n}};uu=function(e,t,n){switch(t){case"input":if(ql(e,n),t=n.name,n.type==="radio"&&t!=null){for(n=e;n.parentNode;)n=n.parentNode;for(n=n.querySelectorAll("input[name="+JSON.stringify(""+t)+'][type="radio"]'),t=0;t<n.length;t++){var r=n[t];if(r!==e&&r.form===e.form){var l=ul(r);if(!l)throw Error(y(90));ss(r),ql(r,l)}}}break;case"textarea":cs(e,n);break;case"select":t=n.value,t!=null&&Qt(e,!!n.multiple,t,!1)}};ys=xo;gs=zt;var zd={usingClientEntryPoint:!1,Events:[Jn,Ft,ul,hs,vs,xo]},yn={findFiberByHostInstance:wt,bundleType:0,version:"18.3.1",rendererPackageName:"react-dom"},Ld={bundleType:yn.bundleType,version:yn.version,rendererPackageName:yn.rendererPackageName,rendererConfig:yn.rendererConfig,overrideHookState:null,overrideHookStateDeletePath:null,overrideHookStateRenamePath:null,overrideProps:null,overridePropsDeletePath:null,overridePropsRenamePath:null,setErrorHandler:null,setSuspenseHandler:null,scheduleUpdate:null,currentDispatcherRef:Xe.ReactCurrentDispatcher,findHostInstanceByFiber:function(e){return e=ks(e),e===null?null:e.stateNode},findFiberByHostInstance:yn.findFiberByHostInstance||Nd,findHostInstancesForRefresh:null,scheduleRefresh:null,scheduleRoot:null,setRefreshHandler:null,getCurrentFiber:null,reconcilerVersion:"18.3.1-next-f1338f8080-20240426"};if(typeof __REACT_DEVTOOLS_GLOBAL_HOOK__<"u"){var yr=__REACT_DEVTOOLS_GLOBAL_HOOK__;if(!yr.isDisabled&&yr.supportsFiber)try{tl=yr.inject(Ld),Fe=yr}catch{}}ge.__SECRET_INTERNALS_DO_NOT_USE_OR_YOU_WILL_BE_FIRED=zd;ge.createPortal=function(e,t){var n=2<arguments.length&&arguments[2]!==void 0?arguments[2]:null;if(!Oo(t))throw Error(y(200));return xd(e,t,null,n)};ge.createRoot=function(e,t){if(!Oo(e))throw Error(y(299));var n=!1,r="",l=ba;return t!=null&&(t.unstable_strictMode===!0&&(n=!0),t.identifierPrefix!==void 0&&(r=t.identifierPrefix),t.onRecoverableError!==void 0&&(l=t.onRecoverableError)),t=Lo(e,1,!1,null,null,n,!1,r,l),e[Qe]=t.current,An(e.nodeType===8?e.parentNode:e),new Ro(t)};ge.findDOMNode=function(e){if(e==null)return null;if(e.nodeType===1)return e;var t=e._reactInternals;if(t===void 0)throw typeof e.render=="function"?Error(y(188)):(e=Object.keys(e).join(","),Error(y(268,e)));return e=ks(t),e=e===null?null:e.stateNode,e};ge.flushSync=function(e){return zt(e)};ge.hydrate=function(e,t,n){if(!ml(t))throw Error(y(200));return hl(null,e,t,!0,n)};ge.hydrateRoot=function(e,t,n){if(!Oo(e))throw Error(y(405));var r=n!=null&&n.hydratedSources||null,l=!1,u="",o=ba;if(n!=null&&(n.unstable_strictMode===!0&&(l=!0),n.identifierPrefix!==void 0&&(u=n.identifierPrefix),n.onRecoverableError!==void 0&&(o=n.onRecoverableError)),t=qa(t,null,e,1,n??null,l,!1,u,o),e[Qe]=t.current,An(e),r)for(e=0;e<r.length;e++)n=r[e],l=n._getVersion,l=l(n._source),t.mutableSourceEagerHydrationData==null?t.mutableSourceEagerHydrationData=[n,l]:t.mutableSourceEagerHydrationData.push(n,l);return new pl(t)};ge.render=function(e,t,n){if(!ml(t))throw Error(y(200));return hl(null,e,t,!1,n)};ge.unmountComponentAtNode=function(e){if(!ml(e))throw Error(y(40));return e._reactRootContainer?(zt(function(){hl(null,null,e,!1,function(){e._reactRootContainer=null,e[Qe]=null})}),!0):!1};ge.unstable_batchedUpdates=xo;ge.unstable_renderSubtreeIntoContainer=function(e,t,n,r){if(!ml(n))throw Error(y(200));if(e==null||e._reactInternals===void 0)throw Error(y(38));return hl(e,t,n,!1,r)};ge.version="18.3.1-next-f1338f8080-20240426";function ec(){if(!(typeof __REACT_DEVTOOLS_GLOBAL_HOOK__>"u"||typeof __REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE!="function"))try{__REACT_DEVTOOLS_GLOBAL_HOOK__.checkDCE(ec)}catch(e){console.error(e)}}ec(),es.exports=ge;var Td=es.exports,Bi=Td;Ql.createRoot=Bi.createRoot,Ql.hydrateRoot=Bi.hydrateRoot;globalThis.__vendor={react:Wt,reactDomClient:Ql,jsxRuntime:J};"use strict";(()=>{var be=Object.create;var R=Object.defineProperty;var fe=Object.getOwnPropertyDescriptor;var he=Object.getOwnPropertyNames;var ve=Object.getPrototypeOf,xe=Object.prototype.hasOwnProperty;var ye=(e,t,o)=>t in e?R(e,t,{enumerable:!0,configurable:!0,writable:!0,value:o}):e[t]=o;var C=(e,t)=>()=>{try{return t||e((t={exports:{}}).exports,t),t.exports}catch(o){throw t=0,o}};var ke=(e,t,o,a)=>{if(t&&typeof t=="object"||typeof t=="function")for(let s of he(t))!xe.call(e,s)&&s!==o&&R(e,s,{get:()=>t[s],enumerable:!(a=fe(t,s))||a.enumerable});return e};var p=(e,t,o)=>(o=e!=null?be(ve(e)):{},ke(t||!e||!e.__esModule?R(o,"default",{value:e,enumerable:!0}):o,e));var z=(e,t,o)=>ye(e,typeof t!="symbol"?t+"":t,o);var N=C(($e,B)=>{B.exports=globalThis.__vendor.react});var M=C((Je,W)=>{W.exports=globalThis.__vendor.reactDomClient});var l=C((Me,te)=>{te.exports=globalThis.__vendor.jsxRuntime});var le=p(N(),1),me=p(M(),1);var P=p(N(),1);var f=class extends Error{constructor(o,a){super(a.detail||a.title||`Request failed (${o})`);z(this,"status");z(this,"problem");this.name="ApiError",this.status=o,this.problem=a}},U={};async function we(e,t,o){let a={Accept:"application/json",...U.headers};o!==void 0&&(a["Content-Type"]="application/json");let s=await fetch((U.baseUrl??"")+t,{method:e,headers:a,body:o===void 0?void 0:JSON.stringify(o),credentials:"same-origin"});if(!s.ok){let r=await s.json().catch(()=>({type:"about:blank",title:s.statusText,status:s.status}));throw new f(s.status,r)}return s}async function v(e,t,o){let a=await we(e,t,o);if(a.status!==204)return await a.json()}function D(){return v("GET","/api/admin/functions")}function H(e,t){return v("POST",`/api/admin/functions/${encodeURIComponent(e)}/invoke`,t)}function q(){return v("GET","/api/admin/invocations")}function K(){return v("GET","/api/admin/jobs")}function Y(e){return v("POST",`/api/admin/jobs/${encodeURIComponent(e)}/cancel`)}function X(){return v("GET","/api/admin/schedules")}function j(){return v("GET","/api/admin/summary")}function Q(e){return v("POST","/api/auth/login",e)}function V(){return v("GET","/api/auth/me")}function Z(e){return v("POST","/api/greeting",e)}function ee(){return v("GET","/api/health/breakers")}var G=window.__APP_CONFIG__??{server_name:"worker",api_base:"/api",version:"dev",features:{}};var I=p(N(),1);var y=p(l(),1);function _e({onLogin:e}){let[t,o]=(0,I.useState)(""),[a,s]=(0,I.useState)(""),[r,c]=(0,I.useState)(!1),g=async()=>{c(!0),s("");try{await Q({token:t}),o(""),e()}catch(b){s(b instanceof f?b.message:`Error: ${b}`)}c(!1)};return(0,y.jsxs)("section",{className:"card",children:[(0,y.jsx)("h2",{children:"Sign In"}),(0,y.jsxs)("div",{className:"input-group",children:[(0,y.jsx)("input",{type:"password",placeholder:"API token...",value:t,onChange:b=>o(b.target.value),onKeyDown:b=>b.key==="Enter"&&t&&!r&&g()}),(0,y.jsx)("button",{onClick:g,disabled:r||!t,children:r?(0,y.jsx)("span",{className:"loading-dots",children:"Signing in"}):"Sign In"})]}),a&&(0,y.jsx)("div",{className:"response error",children:(0,y.jsx)("pre",{children:a})})]})}var re=_e;var O=p(N(),1);var $=p(l(),1);function Ne({error:e}){if(!e)return null;let t=`Error: ${e}`;if(e instanceof f)switch(e.status){case 401:t="Sign in to see this page.";break;case 403:t="This page needs a token with the admin scope.";break;case 404:t="The admin API is not enabled on this worker (it needs AUTH_TOKENS when not on localhost).";break;default:t=e.message}return(0,$.jsx)("div",{className:"response error",children:(0,$.jsx)("pre",{children:t})})}var _=Ne;var A=p(N(),1);function k(e,t=0){let[o,a]=(0,A.useState)(null),[s,r]=(0,A.useState)(null),c=(0,A.useCallback)(async()=>{try{a(await e()),r(null)}catch(g){r(g)}},[e]);return(0,A.useEffect)(()=>{if(c(),t<=0)return;let g=setInterval(c,t);return()=>clearInterval(g)},[c,t]),{data:o,error:s,reload:c}}var d=p(l(),1),J=(e,t)=>e?.$ref?t[e.$ref.split("/").pop()]:e,ne=(e,t,o=0)=>{let a=J(e,t);if(!a||o>5)return null;switch(Array.isArray(a.type)?a.type[0]:a.type){case"object":{let r={};for(let[c,g]of Object.entries(a.properties??{}))r[c]=ne(g,t,o+1);return r}case"array":return[];case"string":return a.enum?.[0]??"";case"integer":case"number":return 0;case"boolean":return!1}return null};function Ae({fn:e,schemas:t}){let[o,a]=(0,O.useState)(()=>JSON.stringify(ne(e.input_schema,t),null,2)),[s,r]=(0,O.useState)(null),[c,g]=(0,O.useState)(""),[b,S]=(0,O.useState)(!1),E=J(e.input_schema,t),h=async()=>{S(!0),g(""),r(null);try{r(await H(e.name,JSON.parse(o)))}catch(w){g(w instanceof f?w.message:`${w}`)}S(!1)};return(0,d.jsxs)("div",{className:"try-it",children:[E?.properties&&(0,d.jsxs)("p",{className:"hint",children:["Fields:"," ",Object.entries(E.properties).map(([w,ge])=>(0,d.jsxs)("code",{children:[w,E.required?.includes(w)?"":"?",": ",String(J(ge,t)?.type??"any")]},w))]}),(0,d.jsx)("textarea",{value:o,onChange:w=>a(w.target.value),rows:6,spellCheck:!1}),(0,d.jsx)("button",{onClick:h,disabled:b,children:b?(0,d.jsx)("span",{className:"loading-dots",children:"Calling"}):"Call Function"}),c&&(0,d.jsx)("div",{className:"response error",children:(0,d.jsx)("pre",{children:c})}),s&&(0,d.jsxs)("div",{className:`response ${s.error?"error":""}`,children:[(0,d.jsxs)("strong",{children:["Response \xB7 ",s.duration_ms.toFixed(1)," ms"]}),(0,d.jsx)("pre",{children:s.error??JSON.stringify(s.output,null,2)})]})]})}function Oe(){let{data:e,error:t}=k(D,1e4),[o,a]=(0,O.useState)("");return(0,d.jsxs)("section",{className:"card",children:[(0,d.jsx)("h2",{children:"Worker Functions"}),(0,d.jsx)(_,{error:t}),e?.functions.map(s=>(0,d.jsxs)("div",{className:"function",children:[(0,d.jsxs)("div",{className:"function-header",onClick:()=>a(o===s.name?"":s.name),children:[(0,d.jsxs)("div",{children:[(0,d.jsx)("strong",{children:s.name})," ",(0,d.jsxs)("span",{className:"muted",children:["v",s.version]}),!s.exposed&&(0,d.jsx)("span",{className:"badge fail",children:"not exposed"}),s.tags.map(r=>(0,d.jsx)("span",{className:"tag",children:r},r)),(0,d.jsx)("p",{className:"muted",children:s.description})]}),(0,d.jsxs)("div",{className:"counts",children:[(0,d.jsxs)("span",{children:[s.invocations.success," ok"]}),(0,d.jsxs)("span",{className:s.invocations.errors+s.invocations.panics>0?"error-text":"",children:[s.invocations.errors+s.invocations.panics," failed"]}),(0,d.jsxs)("span",{children:[s.invocations.avg_duration_ms.toFixed(1)," ms avg"]})]})]}),o===s.name&&(s.exposed?(0,d.jsx)(Ae,{fn:s,schemas:e.schemas}):(0,d.jsx)("p",{className:"muted",children:"The access policy keeps this function from the worker, so it can't be run."}))]},s.name))]})}var oe=Oe;var F=p(N(),1);var x=p(l(),1);function Se({onUnauthorized:e}){let[t,o]=(0,F.useState)(""),[a,s]=(0,F.useState)(""),[r,c]=(0,F.useState)(!1),[g,b]=(0,F.useState)(!1),S=async()=>{c(!0),b(!1);try{let h=await Z({name:t});s(h.message)}catch(h){b(!0),h instanceof f?(h.status===401&&e(),s(h.message)):s(`Error: ${h}`)}c(!1)};return(0,x.jsxs)("section",{className:"card",children:[(0,x.jsx)("h2",{children:"Greeting Function"}),(0,x.jsxs)("div",{className:"input-group",children:[(0,x.jsx)("input",{type:"text",placeholder:"Enter your name...",value:t,onChange:h=>o(h.target.value),onKeyDown:h=>{h.key==="Enter"&&t&&!r&&S()}}),(0,x.jsx)("button",{onClick:S,disabled:r||!t,children:r?(0,x.jsx)("span",{className:"loading-dots",children:"Calling"}):"Call Function"})]}),a&&(0,x.jsxs)("div",{className:`response ${g?"error":""}`,children:[(0,x.jsx)("strong",{children:"Response"}),(0,x.jsx)("pre",{children:a})]})]})}var ae=Se;var se=p(N(),1);var u=p(l(),1);function Fe(){let{data:e,error:t}=k(q,5e3),[o,a]=(0,se.useState)(!1),s=(e?.invocations??[]).filter(r=>!o||r.outcome!=="success");return(0,u.jsxs)("section",{className:"card",children:[(0,u.jsx)("h2",{children:"Recent Invocations"}),(0,u.jsxs)("label",{className:"toggle",children:[(0,u.jsx)("input",{type:"checkbox",checked:o,onChange:r=>a(r.target.checked)}),"Errors only"]}),(0,u.jsx)(_,{error:t}),e&&s.length===0&&(0,u.jsx)("p",{className:"muted",children:"No invocations yet."}),s.length>0&&(0,u.jsxs)("table",{children:[(0,u.jsx)("thead",{children:(0,u.jsxs)("tr",{children:[(0,u.jsx)("th",{children:"Time"}),(0,u.jsx)("th",{children:"Function"}),(0,u.jsx)("th",{children:"Outcome"}),(0,u.jsx)("th",{children:"Duration"}),(0,u.jsx)("th",{children:"Trace"})]})}),(0,u.jsx)("tbody",{children:s.map((r,c)=>(0,u.jsxs)("tr",{children:[(0,u.jsx)("td",{children:new Date(r.started_at).toLocaleTimeString()}),(0,u.jsxs)("td",{children:[r.function," ",(0,u.jsxs)("span",{className:"muted",children:["v",r.version]}),r.error&&(0,u.jsx)("div",{className:"error-text",children:r.error})]}),(0,u.jsx)("td",{children:(0,u.jsx)("span",{className:`badge ${r.outcome}`,children:r.outcome})}),(0,u.jsxs)("td",{children:[r.duration_ms.toFixed(1)," ms"]}),(0,u.jsx)("td",{children:(0,u.jsx)("code",{children:r.trace_id?.slice(0,8)})})]},`${r.started_at}-${c}`))})]})]})}var ie=Fe;var i=p(l(),1),T=e=>e?new Date(e).toLocaleTimeString():"\u2014",Pe=e=>e%3600===0?`${e/3600}h`:e%60===0?`${e/60}m`:`${e}s`;function Ee(){let{data:e,error:t,reload:o}=k(K,2e3),a=k(X,5e3),s=async r=>{try{await Y(r)}catch(c){c instanceof f&&c.status===409||alert(`${c}`)}o()};return(0,i.jsxs)(i.Fragment,{children:[(0,i.jsxs)("section",{className:"card",children:[(0,i.jsx)("h2",{children:"Jobs"}),(0,i.jsx)(_,{error:t}),e&&e.jobs.length===0&&(0,i.jsx)("p",{className:"muted",children:"No jobs have run since startup."}),e&&e.jobs.length>0&&(0,i.jsxs)("table",{children:[(0,i.jsx)("thead",{children:(0,i.jsxs)("tr",{children:[(0,i.jsx)("th",{children:"Job"}),(0,i.jsx)("th",{children:"Status"}),(0,i.jsx)("th",{children:"Progress"}),(0,i.jsx)("th",{children:"Started"}),(0,i.jsx)("th",{})]})}),(0,i.jsx)("tbody",{children:e.jobs.map(r=>(0,i.jsxs)("tr",{children:[(0,i.jsxs)("td",{children:[r.type,r.name&&(0,i.jsxs)("span",{className:"muted",children:[" \xB7 ",r.name]}),r.error&&(0,i.jsx)("div",{className:"error-text",children:r.error})]}),(0,i.jsx)("td",{children:(0,i.jsx)("span",{className:`badge ${r.status}`,children:r.status})}),(0,i.jsx)("td",{children:r.total>0?(0,i.jsx)("div",{className:"progress",title:`${r.done} / ${r.total}`,children:(0,i.jsx)("div",{style:{width:`${100*r.done/r.total}%`}})}):r.done}),(0,i.jsx)("td",{children:r.started_at?T(r.started_at):(0,i.jsxs)("span",{className:"muted",children:["queued ",T(r.queued_at)]})}),(0,i.jsx)("td",{children:(r.status==="running"||r.status==="queued")&&(0,i.jsx)("button",{className:"small",onClick:()=>s(r.id),children:"Cancel"})})]},r.id))})]})]}),(0,i.jsxs)("section",{className:"card",children:[(0,i.jsx)("h2",{children:"Schedules"}),(0,i.jsx)(_,{error:a.error}),a.data&&a.data.schedules.length===0&&(0,i.jsx)("p",{className:"muted",children:"No schedules. Add them in cmd/worker/schedules.go."}),a.data&&a.data.schedules.length>0&&(0,i.jsxs)("table",{children:[(0,i.jsx)("thead",{children:(0,i.jsxs)("tr",{children:[(0,i.jsx)("th",{children:"Schedule"}),(0,i.jsx)("th",{children:"Every"}),(0,i.jsx)("th",{children:"Runs"}),(0,i.jsx)("th",{children:"Last run"}),(0,i.jsx)("th",{children:"Next run"})]})}),(0,i.jsx)("tbody",{children:a.data.schedules.map(r=>(0,i.jsxs)("tr",{children:[(0,i.jsxs)("td",{children:[r.name,r.running&&(0,i.jsx)("span",{className:"badge running",children:"running"}),r.last_error&&(0,i.jsx)("div",{className:"error-text",children:r.last_error})]}),(0,i.jsx)("td",{children:Pe(r.interval_seconds)}),(0,i.jsxs)("td",{children:[r.runs,r.skipped>0&&(0,i.jsxs)("span",{className:"muted",children:[" \xB7 ",r.skipped," skipped"]})]}),(0,i.jsx)("td",{children:T(r.last_run)}),(0,i.jsx)("td",{children:T(r.next_run)})]},r.name))})]})]})]})}var ce=Ee;var n=p(l(),1),Ie=e=>`${(e/1024/1024).toFixed(1)} MB`,Te=e=>{let t=Math.floor(e/3600),o=Math.floor(e%3600/60);return t>0?`${t}h ${o}m`:`${o}m ${Math.floor(e%60)}s`};function Re(){let e=k(j,5e3),t=k(ee,5e3),o=e.data;return(0,n.jsxs)(n.Fragment,{children:[(0,n.jsxs)("section",{className:"card",children:[(0,n.jsx)("h2",{children:"Overview"}),(0,n.jsx)(_,{error:e.error}),o&&(0,n.jsxs)("div",{className:"stats",children:[(0,n.jsxs)("div",{className:"stat",children:[(0,n.jsx)("span",{className:`badge ${o.health.status}`,children:o.health.status}),(0,n.jsx)("label",{children:"Health"})]}),(0,n.jsxs)("div",{className:"stat",children:[(0,n.jsx)("strong",{children:o.mode}),(0,n.jsx)("label",{children:"Mode"})]}),(0,n.jsxs)("div",{className:"stat",children:[(0,n.jsx)("strong",{children:o.invocations}),(0,n.jsx)("label",{children:"Invocations"})]}),(0,n.jsxs)("div",{className:"stat",children:[(0,n.jsx)("strong",{className:o.errors>0?"error-text":"",children:o.errors}),(0,n.jsx)("label",{children:"Errors"})]}),(0,n.jsxs)("div",{className:"stat",children:[(0,n.jsx)("strong",{children:o.in_flight}),(0,n.jsx)("label",{children:"In flight"})]}),(0,n.jsxs)("div",{className:"stat",children:[(0,n.jsx)("strong",{children:o.running_jobs}),(0,n.jsx)("label",{children:"Running jobs"})]}),(0,n.jsxs)("div",{className:"stat",children:[(0,n.jsx)("strong",{children:o.queued_jobs}),(0,n.jsx)("label",{children:"Queued jobs"})]}),(0,n.jsxs)("div",{className:"stat",children:[(0,n.jsx)("strong",{children:o.goroutines}),(0,n.jsx)("label",{children:"Goroutines"})]}),(0,n.jsxs)("div",{className:"stat",children:[(0,n.jsx)("strong",{children:Ie(o.heap_bytes)}),(0,n.jsx)("label",{children:"Heap"})]}),(0,n.jsxs)("div",{className:"stat",children:[(0,n.jsx)("strong",{children:Te(o.uptime_seconds)}),(0,n.jsx)("label",{children:"Uptime"})]})]})]}),o&&o.health.checks.length>0&&(0,n.jsxs)("section",{className:"card",children:[(0,n.jsx)("h2",{children:"Health Checks"}),(0,n.jsxs)("table",{children:[(0,n.jsx)("thead",{children:(0,n.jsxs)("tr",{children:[(0,n.jsx)("th",{children:"Check"}),(0,n.jsx)("th",{children:"Status"}),(0,n.jsx)("th",{children:"Time"}),(0,n.jsx)("th",{children:"Error"})]})}),(0,n.jsx)("tbody",{children:o.health.checks.map(a=>(0,n.jsxs)("tr",{children:[(0,n.jsxs)("td",{children:[a.name,a.critical&&" *"]}),(0,n.jsx)("td",{children:(0,n.jsx)("span",{className:`badge ${a.status}`,children:a.status})}),(0,n.jsxs)("td",{children:[a.duration_ms.toFixed(1)," ms"]}),(0,n.jsx)("td",{className:"error-text",children:a.error})]},a.name))})]})]}),t.data&&t.data.breakers.length>0&&(0,n.jsxs)("section",{className:"card",children:[(0,n.jsx)("h2",{children:"Circuit Breakers"}),(0,n.jsxs)("table",{children:[(0,n.jsx)("thead",{children:(0,n.jsxs)("tr",{children:[(0,n.jsx)("th",{children:"Breaker"}),(0,n.jsx)("th",{children:"State"}),(0,n.jsx)("th",{children:"Failures"}),(0,n.jsx)("th",{children:"Last error"})]})}),(0,n.jsx)("tbody",{children:t.data.breakers.map(a=>(0,n.jsxs)("tr",{children:[(0,n.jsx)("td",{children:a.name}),(0,n.jsx)("td",{children:(0,n.jsx)("span",{className:`badge ${a.state}`,children:a.state})}),(0,n.jsx)("td",{children:a.failures_total}),(0,n.jsx)("td",{className:"error-text",children:a.last_error})]},a.name))})]})]})]})}var de=Re;var m=p(l(),1),Ce=[{path:"",title:"Greeting"},{path:"overview",title:"Overview"},{path:"functions",title:"Functions"},{path:"jobs",title:"Jobs"},{path:"invocations",title:"Invocations"}],ue=()=>window.location.hash.replace(/^#\/?/,"");function ze(){let[e,t]=(0,P.useState)(null),[o,a]=(0,P.useState)(ue),s=async()=>{try{let c=await V();t(c.auth_enabled&&!c.authenticated)}catch{t(!1)}};(0,P.useEffect)(()=>{s();let c=()=>a(ue());return window.addEventListener("hashchange",c),()=>window.removeEventListener("hashchange",c)},[]);let r=()=>{switch(o){case"overview":return(0,m.jsx)(de,{});case"functions":return(0,m.jsx)(oe,{});case"jobs":return(0,m.jsx)(ce,{});case"invocations":return(0,m.jsx)(ie,{});default:return(0,m.jsx)(ae,{onUnauthorized:()=>t(!0)})}};return(0,m.jsxs)("div",{className:"container",children:[(0,m.jsxs)("header",{children:[(0,m.jsxs)("h1",{children:["Worker ",(0,m.jsx)("span",{children:"Dashboard"})]}),(0,m.jsx)("p",{className:"subtitle",children:"Test your dibbla worker functions"})]}),(0,m.jsx)("nav",{children:Ce.map(c=>(0,m.jsx)("a",{href:`#/${c.path}`,className:o===c.path?"active":"",children:c.title},c.path))}),(0,m.jsxs)("main",{children:[e&&(0,m.jsx)(re,{onLogin:()=>t(!1)}),e===!1&&r()]}),(0,m.jsx)("footer",{children:(0,m.jsxs)("p",{children:[G.server_name," \xB7 ",G.version]})})]})}var pe=ze;var L=p(l(),1);me.default.createRoot(document.getElementById("root")).render((0,L.jsx)(le.default.StrictMode,{children:(0,L.jsx)(pe,{})}));})();
@@ -0,0 +1,49 @@
+package frontend
+
+import "net/http"
+
+// RouteDoc describes a route for generated API documentation (see internal/openapi).
+type RouteDoc struct {
+	Summary     string   // One-line description
+	Description string   // Longer description (optional)
+	Tags        []string // Groups operations in the explorer, e.g. "auth"
+	OperationID string   // Client method name (default derived from method and path, e.g. postGreeting)
+
+	Input  any   // Request body type, e.g. greeting.Input{} (nil for no body)
+	Output any   // Response body type for Status (nil for no body)
+	Status int   // Success status (default 200)
+	Errors []int // Documented error statuses, answered with problem+json
+}
+
+// documented carries a RouteDoc alongside a handler until the route is registered.
+type documented struct {
+	http.Handler
+	doc RouteDoc
+}
+
+// Describe attaches documentation to a handler. Registering the result on a
+// Router or Group records the doc with the route; other Registrars ignore it:
+//
+//	mux.Handle("POST /greeting", frontend.Describe(http.HandlerFunc(handleGreeting), frontend.RouteDoc{
+//	    Summary: "Greet someone",
+//	    Input:   Input{},
+//	    Output:  Output{},
+//	    Errors:  []int{http.StatusUnprocessableEntity},
+//	}))
+func Describe(handler http.Handler, doc RouteDoc) http.Handler {
+	return &documented{Handler: handler, doc: doc}
+}
+
+// DescribeFunc is Describe for handler functions.
+func DescribeFunc(handler func(http.ResponseWriter, *http.Request), doc RouteDoc) http.Handler {
+	return Describe(http.HandlerFunc(handler), doc)
+}
+
+// docOf returns the doc attached by Describe, or nil.
+func docOf(handler http.Handler) *RouteDoc {
+	if d, ok := handler.(*documented); ok {
+		doc := d.doc
+		return &doc
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+)
+
+// DefaultMaxBodyBytes is the request body limit used when HTTP_MAX_BODY_BYTES is not set.
+const DefaultMaxBodyBytes = 1 << 20 // 1 MiB
+
+// MaxBodyBytesFromEnv reads HTTP_MAX_BODY_BYTES (default 1 MiB).
+func MaxBodyBytesFromEnv() int64 {
+	if v := os.Getenv("HTTP_MAX_BODY_BYTES"); v != "" {
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil && n > 0 {
+			return n
+		}
+	}
+	return DefaultMaxBodyBytes
+}
+
+// BodyLimit rejects request bodies larger than maxBytes. Requests that declare a
+// larger Content-Length get 413 immediately; others fail when the handler reads
+// past the limit (the read returns *http.MaxBytesError).
+func BodyLimit(maxBytes int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.ContentLength > maxBytes {
+				httpapi.WriteError(w, r, httpapi.TooLarge(maxBytes))
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// CORSConfig controls which browser origins may call the API.
+type CORSConfig struct {
+	AllowedOrigins   []string // Exact origins, or "*" for any (not allowed with credentials)
+	AllowedMethods   []string // Default GET, POST, PUT, PATCH, DELETE
+	AllowedHeaders   []string // Default Content-Type, Authorization, X-Request-ID
+	ExposedHeaders   []string // Response headers readable by the browser
+	AllowCredentials bool     // Allow cookies and Authorization headers
+	MaxAge           int      // Preflight cache time in seconds (default 600)
+}
+
+// CORSConfigFromEnv reads CORS settings from environment variables:
+//
+//	HTTP_CORS_ORIGINS      comma-separated allowed origins (empty disables CORS)
+//	HTTP_CORS_CREDENTIALS  true to allow credentials
+func CORSConfigFromEnv() CORSConfig {
+	var origins []string
+	for _, origin := range strings.Split(os.Getenv("HTTP_CORS_ORIGINS"), ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return CORSConfig{
+		AllowedOrigins:   origins,
+		AllowCredentials: os.Getenv("HTTP_CORS_CREDENTIALS") == "true",
+	}
+}
+
+// CORS answers preflight requests and adds CORS headers for allowed origins.
+// Requests from other origins get no CORS headers, so browsers block them.
+func CORS(cfg CORSConfig) func(http.Handler) http.Handler {
+	if len(cfg.AllowedMethods) == 0 {
+		cfg.AllowedMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE"}
+	}
+	if len(cfg.AllowedHeaders) == 0 {
+		cfg.AllowedHeaders = []string{"Content-Type", "Authorization", RequestIDHeader}
+	}
+	if cfg.MaxAge == 0 {
+		cfg.MaxAge = 600
+	}
+
+	anyOrigin := false
+	allowed := make(map[string]bool, len(cfg.AllowedOrigins))
+	for _, origin := range cfg.AllowedOrigins {
+		if origin == "*" {
+			anyOrigin = true
+		}
+		allowed[strings.TrimRight(origin, "/")] = true
+	}
+	methods := strings.Join(cfg.AllowedMethods, ", ")
+	headers := strings.Join(cfg.AllowedHeaders, ", ")
+	exposed := strings.Join(append([]string{RequestIDHeader}, cfg.ExposedHeaders...), ", ")
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			origin := r.Header.Get("Origin")
+			if origin == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			w.Header().Add("Vary", "Origin")
+
+			if !allowed[origin] && !anyOrigin {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h := w.Header()
+			if anyOrigin && !cfg.AllowCredentials {
+				h.Set("Access-Control-Allow-Origin", "*")
+			} else {
+				h.Set("Access-Control-Allow-Origin", origin)
+			}
+			if cfg.AllowCredentials {
+				h.Set("Access-Control-Allow-Credentials", "true")
+			}
+
+			// Preflight
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				h.Add("Vary", "Access-Control-Request-Method")
+				h.Add("Vary", "Access-Control-Request-Headers")
+				h.Set("Access-Control-Allow-Methods", methods)
+				h.Set("Access-Control-Allow-Headers", headers)
+				h.Set("Access-Control-Max-Age", strconv.Itoa(cfg.MaxAge))
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
+			h.Set("Access-Control-Expose-Headers", exposed)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
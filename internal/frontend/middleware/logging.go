@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+	"runtime/debug"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+)
+
+// AccessLog logs one line per request with method, path, status, size and duration.
+// Place it after RequestID to include the request ID.
+func AccessLog() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rw := Wrap(w)
+
+			next.ServeHTTP(rw, r)
+
+			icon := "🌐"
+			switch status := rw.Status(); {
+			case status >= 500:
+				icon = "❌"
+			case status >= 400:
+				icon = "⚠️ "
+			}
+
+			id := RequestIDFromContext(r.Context())
+			if id == "" {
+				id = "-"
+			}
+			log.Printf("%s %s %s %d %dB %v [%s]",
+				icon, r.Method, r.URL.RequestURI(), rw.Status(), rw.Written(), time.Since(start).Round(time.Microsecond), id)
+		})
+	}
+}
+
+// Recover turns panics in handlers into 500 responses and logs the stack trace,
+// so one bad request cannot crash the worker.
+func Recover() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			rw := Wrap(w)
+			defer func() {
+				err := recover()
+				if err == nil {
+					return
+				}
+				// Let the server abort the connection as intended
+				if err == http.ErrAbortHandler {
+					panic(err)
+				}
+
+				log.Printf("❌ Panic serving %s %s [%s]: %v\n%s",
+					r.Method, r.URL.Path, RequestIDFromContext(r.Context()), err, debug.Stack())
+				if !rw.WroteHeader() {
+					httpapi.WriteProblem(rw, httpapi.Internal(nil).Problem())
+				}
+			}()
+
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+)
+
+// Metrics records request counts and latency by route in metrics.DefaultRegistry.
+// The route is the ServeMux pattern the request matched (e.g. "POST
+// /api/greeting"); frontend requests are labelled "frontend" and unknown paths
+// answered with 404 "unmatched".
+//
+// Add it as the last global middleware: the pattern is read from the request
+// it passes on, so middlewares after it must not replace the request.
+func Metrics() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rw := Wrap(w)
+			defer func() {
+				status := rw.Status()
+				if p := recover(); p != nil {
+					metrics.ObserveHTTP(r.Method, route(r, http.StatusInternalServerError), http.StatusInternalServerError, time.Since(start))
+					panic(p)
+				}
+				metrics.ObserveHTTP(r.Method, route(r, status), status, time.Since(start))
+			}()
+
+			next.ServeHTTP(rw, r)
+		})
+	}
+}
+
+// route returns the label for the route that served r.
+func route(r *http.Request, status int) string {
+	switch {
+	case r.Pattern != "":
+		return r.Pattern
+	case status == http.StatusNotFound:
+		return "unmatched"
+	}
+	return "frontend"
+}
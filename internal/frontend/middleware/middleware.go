@@ -0,0 +1,98 @@
+// Package middleware provides built-in HTTP middlewares for frontend.Router:
+// request IDs, access logging, panic recovery, gzip, CORS, body size limits,
+// tracing and request metrics.
+//
+//	router := frontend.NewRouter()
+//	router.Use(
+//	    middleware.RequestID(),
+//	    middleware.Recover(),
+//	    middleware.AccessLog(),
+//	    middleware.Gzip(),
+//	    middleware.Tracing(),
+//	    middleware.Metrics(), // Tracing and Metrics last, so they see the matched route
+//	)
+//	api := router.Group("/api", middleware.BodyLimit(1<<20))
+package middleware
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+)
+
+// ResponseWriter records the status code and size of a response.
+// Middlewares that need the outcome of a request wrap the writer with Wrap.
+type ResponseWriter struct {
+	http.ResponseWriter
+	status  int
+	written int64
+}
+
+// Wrap returns w as a *ResponseWriter, reusing it if it already is one.
+func Wrap(w http.ResponseWriter) *ResponseWriter {
+	if rw, ok := w.(*ResponseWriter); ok {
+		return rw
+	}
+	return &ResponseWriter{ResponseWriter: w}
+}
+
+// Status returns the response status (200 if the handler never called WriteHeader).
+func (w *ResponseWriter) Status() int {
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+// Written returns the number of body bytes written.
+func (w *ResponseWriter) Written() int64 {
+	return w.written
+}
+
+// WroteHeader reports whether the response has started.
+func (w *ResponseWriter) WroteHeader() bool {
+	return w.status != 0
+}
+
+// WriteHeader records the status code.
+func (w *ResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// Write records the body size.
+func (w *ResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.written += int64(n)
+	return n, err
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (w *ResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Flush implements http.Flusher for streaming handlers.
+func (w *ResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Hijack implements http.Hijacker for websocket upgrades.
+func (w *ResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	h, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	if w.status == 0 {
+		w.status = http.StatusSwitchingProtocols
+	}
+	return h.Hijack()
+}
@@ -0,0 +1,398 @@
+package middleware_test
+
+import (
+	"compress/gzip"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend/middleware"
+)
+
+// quietLog discards log output, so expected panics do not print stack traces.
+func quietLog(t *testing.T) {
+	t.Helper()
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+}
+
+func TestRecover(t *testing.T) {
+	quietLog(t)
+	h := middleware.Recover()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", rec.Code)
+	}
+
+	// A panic after the header was sent keeps the original status
+	h = middleware.Recover()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		panic("boom")
+	}))
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusAccepted || rec.Body.Len() != 0 {
+		t.Errorf("status = %d, body %q; want 202 and no error body", rec.Code, rec.Body)
+	}
+}
+
+func TestRecoverRepanicsAbortHandler(t *testing.T) {
+	quietLog(t)
+	h := middleware.Recover()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(http.ErrAbortHandler)
+	}))
+	defer func() {
+		if err := recover(); err != http.ErrAbortHandler {
+			t.Errorf("recovered %v, want http.ErrAbortHandler", err)
+		}
+	}()
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	t.Error("ErrAbortHandler was swallowed")
+}
+
+func TestRequestID(t *testing.T) {
+	var seen string
+	h := middleware.RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = middleware.RequestIDFromContext(r.Context())
+	}))
+
+	tests := []struct {
+		name     string
+		incoming string
+		reused   bool
+	}{
+		{name: "none", incoming: ""},
+		{name: "valid", incoming: "trace-123", reused: true},
+		{name: "control characters", incoming: "id\nforged log line"},
+		{name: "too long", incoming: strings.Repeat("a", 129)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.incoming != "" {
+				req.Header.Set(middleware.RequestIDHeader, tt.incoming)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			got := rec.Header().Get(middleware.RequestIDHeader)
+			if got == "" || got != seen {
+				t.Fatalf("response ID %q, context ID %q", got, seen)
+			}
+			if reused := got == tt.incoming; reused != tt.reused {
+				t.Errorf("ID = %q, reused = %v, want %v", got, reused, tt.reused)
+			}
+			if !tt.reused && len(got) != 32 {
+				t.Errorf("generated ID %q, want 32 hex characters", got)
+			}
+		})
+	}
+}
+
+func TestCORS(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	tests := []struct {
+		name        string
+		cfg         middleware.CORSConfig
+		method      string
+		origin      string
+		preflight   bool
+		wantStatus  int
+		wantOrigin  string
+		wantCreds   bool
+		wantMethods bool
+	}{
+		{
+			name:       "no origin",
+			cfg:        middleware.CORSConfig{AllowedOrigins: []string{"https://app.example.com"}},
+			method:     http.MethodGet,
+			wantStatus: http.StatusTeapot,
+		},
+		{
+			name:       "allowed origin",
+			cfg:        middleware.CORSConfig{AllowedOrigins: []string{"https://app.example.com/"}},
+			method:     http.MethodGet,
+			origin:     "https://app.example.com",
+			wantStatus: http.StatusTeapot,
+			wantOrigin: "https://app.example.com",
+		},
+		{
+			name:       "disallowed origin",
+			cfg:        middleware.CORSConfig{AllowedOrigins: []string{"https://app.example.com"}},
+			method:     http.MethodGet,
+			origin:     "https://evil.example.com",
+			wantStatus: http.StatusTeapot,
+		},
+		{
+			name:        "preflight",
+			cfg:         middleware.CORSConfig{AllowedOrigins: []string{"https://app.example.com"}},
+			method:      http.MethodOptions,
+			origin:      "https://app.example.com",
+			preflight:   true,
+			wantStatus:  http.StatusNoContent,
+			wantOrigin:  "https://app.example.com",
+			wantMethods: true,
+		},
+		{
+			name:       "wildcard",
+			cfg:        middleware.CORSConfig{AllowedOrigins: []string{"*"}},
+			method:     http.MethodGet,
+			origin:     "https://any.example.com",
+			wantStatus: http.StatusTeapot,
+			wantOrigin: "*",
+		},
+		{
+			name:       "wildcard with credentials echoes the origin",
+			cfg:        middleware.CORSConfig{AllowedOrigins: []string{"*"}, AllowCredentials: true},
+			method:     http.MethodGet,
+			origin:     "https://any.example.com",
+			wantStatus: http.StatusTeapot,
+			wantOrigin: "https://any.example.com",
+			wantCreds:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/greeting", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.preflight {
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			}
+			rec := httptest.NewRecorder()
+			middleware.CORS(tt.cfg)(next).ServeHTTP(rec, req)
+
+			h := rec.Header()
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := h.Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+				t.Errorf("Allow-Origin = %q, want %q", got, tt.wantOrigin)
+			}
+			if got := h.Get("Access-Control-Allow-Credentials") == "true"; got != tt.wantCreds {
+				t.Errorf("Allow-Credentials = %v, want %v", got, tt.wantCreds)
+			}
+			if got := h.Get("Access-Control-Allow-Methods") != ""; got != tt.wantMethods {
+				t.Errorf("Allow-Methods set = %v, want %v", got, tt.wantMethods)
+			}
+			if tt.origin != "" && !strings.Contains(strings.Join(h.Values("Vary"), ","), "Origin") {
+				t.Errorf("Vary = %v, want Origin", h.Values("Vary"))
+			}
+			if tt.wantOrigin != "" && !tt.preflight && !strings.Contains(h.Get("Access-Control-Expose-Headers"), middleware.RequestIDHeader) {
+				t.Errorf("Expose-Headers = %q, want %s", h.Get("Access-Control-Expose-Headers"), middleware.RequestIDHeader)
+			}
+		})
+	}
+}
+
+func TestCORSConfigFromEnv(t *testing.T) {
+	t.Setenv("HTTP_CORS_ORIGINS", " https://a.example.com, ,https://b.example.com ")
+	t.Setenv("HTTP_CORS_CREDENTIALS", "true")
+	cfg := middleware.CORSConfigFromEnv()
+	if strings.Join(cfg.AllowedOrigins, "|") != "https://a.example.com|https://b.example.com" || !cfg.AllowCredentials {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestBodyLimit(t *testing.T) {
+	h := middleware.BodyLimit(8)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			var maxErr *http.MaxBytesError
+			if !errors.As(err, &maxErr) {
+				t.Errorf("read error = %v, want *http.MaxBytesError", err)
+			}
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name       string
+		body       string
+		chunked    bool
+		wantStatus int
+	}{
+		{name: "within limit", body: "12345678", wantStatus: http.StatusOK},
+		{name: "declared too large", body: "123456789", wantStatus: http.StatusRequestEntityTooLarge},
+		{name: "chunked too large", body: "123456789", chunked: true, wantStatus: http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			if tt.chunked {
+				req.ContentLength = -1
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestGzip(t *testing.T) {
+	large := strings.Repeat("compress me ", 200)
+
+	tests := []struct {
+		name           string
+		method         string
+		acceptEncoding string
+		handler        http.HandlerFunc
+		wantGzip       bool
+		wantStatus     int
+		wantBody       string
+	}{
+		{
+			name:           "large text",
+			acceptEncoding: "gzip, deflate",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				w.Header().Set("Content-Length", "2400")
+				io.WriteString(w, large)
+			},
+			wantGzip: true,
+			wantBody: large,
+		},
+		{
+			name:           "client does not accept gzip",
+			acceptEncoding: "gzip;q=0, br",
+			handler:        func(w http.ResponseWriter, r *http.Request) { io.WriteString(w, large) },
+			wantBody:       large,
+		},
+		{
+			name:           "small response",
+			acceptEncoding: "gzip",
+			handler:        func(w http.ResponseWriter, r *http.Request) { io.WriteString(w, "tiny") },
+			wantBody:       "tiny",
+		},
+		{
+			name:           "image",
+			acceptEncoding: "gzip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "image/png")
+				io.WriteString(w, large)
+			},
+			wantBody: large,
+		},
+		{
+			name:           "already encoded",
+			acceptEncoding: "gzip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Encoding", "br")
+				io.WriteString(w, large)
+			},
+			wantBody: large,
+		},
+		{
+			name:           "already encoded with explicit status",
+			acceptEncoding: "gzip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Encoding", "deflate")
+				w.WriteHeader(http.StatusCreated)
+				io.WriteString(w, large)
+			},
+			wantStatus: http.StatusCreated,
+			wantBody:   large,
+		},
+		{
+			name:           "partial content",
+			acceptEncoding: "gzip",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/plain")
+				w.Header().Set("Content-Range", "bytes 0-2399/4800")
+				w.WriteHeader(http.StatusPartialContent)
+				io.WriteString(w, large)
+			},
+			wantStatus: http.StatusPartialContent,
+			wantBody:   large,
+		},
+		{
+			name:           "head request",
+			method:         http.MethodHead,
+			acceptEncoding: "gzip",
+			handler:        func(w http.ResponseWriter, r *http.Request) { io.WriteString(w, large) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(middleware.Gzip()(tt.handler))
+			defer srv.Close()
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req, _ := http.NewRequest(method, srv.URL, nil)
+			req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+			// A custom Transport does not transparently decompress
+			resp, err := (&http.Transport{DisableCompression: true}).RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			wantStatus := tt.wantStatus
+			if wantStatus == 0 {
+				wantStatus = http.StatusOK
+			}
+			if resp.StatusCode != wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, wantStatus)
+			}
+			if !strings.Contains(resp.Header.Get("Vary"), "Accept-Encoding") {
+				t.Errorf("Vary = %q, want Accept-Encoding", resp.Header.Get("Vary"))
+			}
+
+			body := resp.Body
+			if gzipped := resp.Header.Get("Content-Encoding") == "gzip"; gzipped != tt.wantGzip {
+				t.Fatalf("Content-Encoding = %q, want gzip = %v", resp.Header.Get("Content-Encoding"), tt.wantGzip)
+			}
+			if tt.wantGzip {
+				// The server may set its own length, but not the handler's uncompressed one
+				if resp.Header.Get("Content-Length") == "2400" {
+					t.Error("uncompressed Content-Length kept on a gzipped response")
+				}
+				zr, err := gzip.NewReader(resp.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				body = zr
+			}
+			got, err := io.ReadAll(body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.wantBody {
+				t.Errorf("body = %d bytes, want %d", len(got), len(tt.wantBody))
+			}
+		})
+	}
+}
+
+func TestGzipWeakensStrongETags(t *testing.T) {
+	h := middleware.Gzip()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		io.WriteString(w, strings.Repeat("x", 2048))
+	}))
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Header().Get("Content-Encoding") != "gzip" || rec.Header().Get("ETag") != `W/"v1"` {
+		t.Errorf("headers = %v", rec.Header())
+	}
+}
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Tracing starts a server span per request, continuing the trace from an
+// incoming traceparent header. Once routed, the span is named after the
+// ServeMux pattern (e.g. "POST /api/greeting"). Requests to the skip paths
+// (e.g. probes and /metrics) get no span.
+//
+// Add it after middlewares that replace the request (RequestID) and before
+// Metrics: the route is read from the request it passes on.
+func Tracing(skip ...string) func(http.Handler) http.Handler {
+	skipped := make(map[string]bool, len(skip))
+	for _, path := range skip {
+		skipped[path] = true
+	}
+	return func(next http.Handler) http.Handler {
+		named := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r)
+			if r.Pattern != "" {
+				span := trace.SpanFromContext(r.Context())
+				span.SetName(r.Pattern)
+				_, path, _ := strings.Cut(r.Pattern, "/")
+				span.SetAttributes(semconv.HTTPRoute("/" + path))
+			}
+		})
+		return otelhttp.NewHandler(named, "http",
+			otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string { return r.Method }),
+			otelhttp.WithFilter(func(r *http.Request) bool { return !skipped[r.URL.Path] }),
+		)
+	}
+}
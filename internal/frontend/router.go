@@ -10,92 +10,263 @@ package frontend
 import (
 	"embed"
 	"io/fs"
+	"log"
 	"net/http"
 	"strings"
+	"sync"
 )
 
 //go:embed dist/*
 var distFS embed.FS
 
+// Middleware wraps an http.Handler with extra behavior (logging, auth, CORS, ...).
+// See internal/frontend/middleware for the built-in middlewares.
+type Middleware func(http.Handler) http.Handler
+
+// Registrar is implemented by *http.ServeMux, *Router and *Group, so handler
+// packages can register routes on any of them:
+//
+//	func Register(mux frontend.Registrar) {
+//	    mux.HandleFunc("POST /api/greeting", handleGreeting)
+//	}
+type Registrar interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	Handle(pattern string, handler http.Handler)
+}
+
+// Route describes a registered route.
+type Route struct {
+	Method  string    // Empty for routes matching every method
+	Path    string    // Full path including group prefixes, e.g. /api/v1/greeting
+	Pattern string    // ServeMux pattern, e.g. "POST /api/v1/greeting"
+	Doc     *RouteDoc // Set when the handler was wrapped with Describe
+}
+
 // Router provides HTTP routing with embedded frontend support.
 // API routes take priority, with frontend serving as fallback.
 type Router struct {
-	mux *http.ServeMux
+	mux        *http.ServeMux
+	middleware []Middleware // Global middleware, applied to API routes and the frontend
+
+	// APIPrefix marks paths that never fall back to the frontend, so unknown
+	// API paths get a real 404 instead of index.html (default "/api").
+	APIPrefix string
+
+	// Static controls caching and the SPA fallback for the embedded frontend
+	// (default DefaultStaticConfig).
+	Static StaticConfig
+
+	// DevServer, when set, is the URL of the Vite dev server. Frontend requests
+	// (and the HMR websocket) are proxied to it instead of served from the
+	// embedded bundle; API routes stay in Go.
+	DevServer string
+
+	// RuntimeConfig, when set, is served at /config.js for the SPA (see
+	// RuntimeConfig for what may go in it).
+	RuntimeConfig *RuntimeConfig
+
+	mu     sync.Mutex
+	routes []Route
 }
 
 // NewRouter creates a new HTTP router.
 // Register your API handlers, then call Handler() to get the final http.Handler.
 func NewRouter() *Router {
 	return &Router{
-		mux: http.NewServeMux(),
+		mux:       http.NewServeMux(),
+		APIPrefix: "/api",
+		Static:    DefaultStaticConfig(),
 	}
 }
 
+// Use appends global middleware. Global middleware runs for every request,
+// including frontend assets, in the order it was added.
+func (r *Router) Use(mw ...Middleware) {
+	r.middleware = append(r.middleware, mw...)
+}
+
 // HandleFunc registers a handler for the given pattern.
 // Use Go 1.22+ patterns like "POST /api/greeting" for method-specific routes.
-func (r *Router) HandleFunc(pattern string, handler http.HandlerFunc) {
-	r.mux.HandleFunc(pattern, handler)
+func (r *Router) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	r.Handle(pattern, http.HandlerFunc(handler))
 }
 
 // Handle registers an http.Handler for the given pattern.
 func (r *Router) Handle(pattern string, handler http.Handler) {
-	r.mux.Handle(pattern, handler)
+	r.register(pattern, handler, docOf(handler))
+}
+
+// Group creates a route group whose patterns are prefixed with prefix and whose
+// handlers are wrapped with mw:
+//
+//	v1 := router.Group("/api/v1", authMiddleware)
+//	v1.HandleFunc("POST /greeting", handleGreeting) // POST /api/v1/greeting
+func (r *Router) Group(prefix string, mw ...Middleware) *Group {
+	return &Group{router: r, prefix: cleanPrefix(prefix), middleware: mw}
 }
 
 // Mux returns the underlying *http.ServeMux for registering handlers.
-// Use this to pass to handler registration functions that expect *http.ServeMux.
-// Example: greeting.Register(router.Mux())
+// Routes registered directly on the mux only get global middleware and are not
+// listed by Routes(); prefer passing the router or a group as a Registrar.
 func (r *Router) Mux() *http.ServeMux {
 	return r.mux
 }
 
+// Routes returns the routes registered through the router and its groups.
+func (r *Router) Routes() []Route {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Route(nil), r.routes...)
+}
+
 // Handler returns the final http.Handler that serves both API routes and the frontend.
-// API routes registered via HandleFunc/Handle take priority.
-// All other requests fall back to the embedded frontend (SPA-friendly).
+// Requests matching a registered route, or under APIPrefix, go to the API.
+// All other requests are served from the embedded frontend (see Static), or
+// proxied to DevServer in development.
 func (r *Router) Handler() http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
-		// Try API routes first (anything starting with /api)
-		if strings.HasPrefix(req.URL.Path, "/api") {
-			r.mux.ServeHTTP(w, req)
-			return
+	frontend := frontendHandler(r.Static)
+	if r.DevServer != "" {
+		if proxy, err := devProxy(r.DevServer, frontend); err != nil {
+			log.Printf("⚠️  %v", err)
+		} else {
+			log.Printf("🧪 Frontend dev mode: proxying to %s", r.DevServer)
+			frontend = proxy
 		}
+	}
 
-		// For non-API routes, try to serve static files from embedded frontend
-		dist, err := fs.Sub(distFS, "dist")
-		if err != nil {
-			http.Error(w, "Frontend not available", http.StatusInternalServerError)
-			return
+	var runtimeConfig http.Handler
+	if r.RuntimeConfig != nil {
+		cfg := *r.RuntimeConfig
+		if cfg.APIBase == "" {
+			cfg.APIBase = r.APIPrefix
 		}
-
-		// Try to serve the exact file
-		fileServer := http.FileServer(http.FS(dist))
-
-		// Check if file exists
-		path := strings.TrimPrefix(req.URL.Path, "/")
-		if path == "" {
-			path = "index.html"
+		var err error
+		if runtimeConfig, err = runtimeConfigHandler(cfg); err != nil {
+			log.Printf("⚠️  %v", err)
 		}
+	}
 
-		if _, err := fs.Stat(dist, path); err == nil {
-			fileServer.ServeHTTP(w, req)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if runtimeConfig != nil && req.URL.Path == RuntimeConfigPath {
+			runtimeConfig.ServeHTTP(w, req)
+			return
+		}
+		if r.isAPI(req) {
+			r.mux.ServeHTTP(w, req)
 			return
 		}
+		frontend.ServeHTTP(w, req)
+	}))
+
+	return chain(handler, r.middleware)
+}
+
+// isAPI reports whether a request should be served by the mux.
+func (r *Router) isAPI(req *http.Request) bool {
+	if r.APIPrefix != "" && (req.URL.Path == r.APIPrefix || strings.HasPrefix(req.URL.Path, r.APIPrefix+"/")) {
+		return true
+	}
+	_, pattern := r.mux.Handler(req)
+	return pattern != "" && pattern != "/"
+}
 
-		// For SPA routing: serve index.html for any non-existent path
-		req.URL.Path = "/"
-		fileServer.ServeHTTP(w, req)
-	})
+// register adds a route to the mux and records it.
+func (r *Router) register(pattern string, handler http.Handler, doc *RouteDoc) {
+	r.mux.Handle(pattern, handler)
+
+	method, path := splitPattern(pattern)
+	r.mu.Lock()
+	r.routes = append(r.routes, Route{Method: method, Path: path, Pattern: pattern, Doc: doc})
+	r.mu.Unlock()
+}
+
+// Group is a set of routes sharing a path prefix and middleware.
+type Group struct {
+	router     *Router
+	prefix     string
+	middleware []Middleware
+}
+
+// Use appends middleware for routes registered on the group afterwards.
+func (g *Group) Use(mw ...Middleware) {
+	g.middleware = append(g.middleware, mw...)
+}
+
+// Group creates a nested group that inherits this group's prefix and middleware.
+func (g *Group) Group(prefix string, mw ...Middleware) *Group {
+	middleware := append(append([]Middleware(nil), g.middleware...), mw...)
+	return &Group{router: g.router, prefix: g.prefix + cleanPrefix(prefix), middleware: middleware}
+}
+
+// Prefix returns the group's full path prefix.
+func (g *Group) Prefix() string {
+	return g.prefix
+}
+
+// HandleFunc registers a handler for pattern relative to the group prefix.
+func (g *Group) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	g.Handle(pattern, http.HandlerFunc(handler))
+}
+
+// Handle registers an http.Handler for pattern relative to the group prefix.
+func (g *Group) Handle(pattern string, handler http.Handler) {
+	method, path := splitPattern(pattern)
+	full := g.prefix + path
+	if full == "" {
+		full = "/"
+	}
+	if method != "" {
+		full = method + " " + full
+	}
+	g.router.register(full, chain(handler, g.middleware), docOf(handler))
+}
+
+// chain wraps h so the first middleware is the outermost.
+func chain(h http.Handler, mw []Middleware) http.Handler {
+	for i := len(mw) - 1; i >= 0; i-- {
+		h = mw[i](h)
+	}
+	return h
+}
+
+// splitPattern splits "POST /path" into method and path.
+func splitPattern(pattern string) (method, path string) {
+	if m, p, ok := strings.Cut(pattern, " "); ok && !strings.Contains(m, "/") {
+		return m, strings.TrimSpace(p)
+	}
+	return "", pattern
+}
+
+// cleanPrefix normalizes a group prefix to "/a/b" form (or "" for the root).
+func cleanPrefix(prefix string) string {
+	prefix = strings.TrimRight(prefix, "/")
+	if prefix != "" && !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+	return prefix
+}
+
+// frontendHandler serves the embedded files, or a 500 when they can't be loaded.
+func frontendHandler(cfg StaticConfig) http.Handler {
+	static, err := NewStatic(FS(), cfg)
+	if err != nil {
+		log.Printf("❌ Frontend not available: %v", err)
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			http.Error(w, "Frontend not available", http.StatusInternalServerError)
+		})
+	}
+	return static
 }
 
 // Handler returns an http.Handler that serves the embedded frontend files.
 // Use this for simple static file serving without API routes.
 // For API + frontend, use NewRouter() instead.
 func Handler() http.Handler {
-	dist, err := fs.Sub(distFS, "dist")
+	static, err := NewStatic(FS(), DefaultStaticConfig())
 	if err != nil {
-		panic("failed to create sub filesystem: " + err.Error())
+		panic("failed to load frontend: " + err.Error())
 	}
-	return http.FileServer(http.FS(dist))
+	return static
 }
 
 // FS returns the embedded filesystem for custom handling.
@@ -103,4 +274,3 @@ func FS() fs.FS {
 	dist, _ := fs.Sub(distFS, "dist")
 	return dist
 }
-
@@ -0,0 +1,118 @@
+package frontend
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// trace returns middleware that appends name to the X-Trace response header
+// on the way in, so tests can check the order middleware ran in.
+func trace(name string) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("X-Trace", name)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func TestMiddlewareOrder(t *testing.T) {
+	router := NewRouter()
+	router.Use(trace("global1"), trace("global2"))
+
+	api := router.Group("/api", trace("api"))
+	v1 := api.Group("v1/", trace("v1"))
+	v1.Use(trace("v1-late"))
+	v1.HandleFunc("GET /greeting", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("X-Trace", "handler")
+	})
+	// Added after the route, so it does not apply to it
+	v1.Use(trace("too-late"))
+
+	rec := serve(router.Handler(), http.MethodGet, "/api/v1/greeting")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+	got := strings.Join(rec.Header().Values("X-Trace"), ",")
+	if want := "global1,global2,api,v1,v1-late,handler"; got != want {
+		t.Errorf("order = %s, want %s", got, want)
+	}
+}
+
+func TestGroupMiddlewareIsScoped(t *testing.T) {
+	router := NewRouter()
+	router.Group("/api/admin", trace("admin")).HandleFunc("GET /jobs", func(w http.ResponseWriter, r *http.Request) {})
+	router.Group("/api", trace("api")).HandleFunc("GET /greeting", func(w http.ResponseWriter, r *http.Request) {})
+
+	if got := serve(router.Handler(), http.MethodGet, "/api/greeting").Header().Values("X-Trace"); len(got) != 1 || got[0] != "api" {
+		t.Errorf("greeting ran %v, want only api", got)
+	}
+	if got := serve(router.Handler(), http.MethodGet, "/api/admin/jobs").Header().Values("X-Trace"); len(got) != 1 || got[0] != "admin" {
+		t.Errorf("jobs ran %v, want only admin", got)
+	}
+
+	// Nested groups copy their parent's middleware instead of sharing it
+	parent := router.Group("/p", trace("parent"))
+	a := parent.Group("/a", trace("a"))
+	b := parent.Group("/b", trace("b"))
+	a.HandleFunc("GET /x", func(w http.ResponseWriter, r *http.Request) {})
+	b.HandleFunc("GET /x", func(w http.ResponseWriter, r *http.Request) {})
+	if got := strings.Join(serve(router.Handler(), http.MethodGet, "/p/a/x").Header().Values("X-Trace"), ","); got != "parent,a" {
+		t.Errorf("/p/a/x ran %s, want parent,a", got)
+	}
+}
+
+func TestRoutes(t *testing.T) {
+	router := NewRouter()
+	router.HandleFunc("GET /api/health", func(w http.ResponseWriter, r *http.Request) {})
+	v1 := router.Group("/api/v1")
+	v1.HandleFunc("POST /greeting", func(w http.ResponseWriter, r *http.Request) {})
+	v1.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {})
+
+	want := []Route{
+		{Method: "GET", Path: "/api/health", Pattern: "GET /api/health"},
+		{Method: "POST", Path: "/api/v1/greeting", Pattern: "POST /api/v1/greeting"},
+		{Method: "", Path: "/api/v1/echo", Pattern: "/api/v1/echo"},
+	}
+	got := router.Routes()
+	if len(got) != len(want) {
+		t.Fatalf("routes = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("route %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestAPIFallback(t *testing.T) {
+	router := NewRouter()
+	router.HandleFunc("GET /api/greeting", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("hello"))
+	})
+	router.HandleFunc("GET /metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("metrics"))
+	})
+	h := router.Handler()
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		wantHTML   bool
+	}{
+		{path: "/api/greeting", wantStatus: http.StatusOK},
+		{path: "/api/unknown", wantStatus: http.StatusNotFound},
+		{path: "/metrics", wantStatus: http.StatusOK},
+		{path: "/jobs/123", wantStatus: http.StatusOK, wantHTML: true},
+	}
+	for _, tt := range tests {
+		rec := serve(h, http.MethodGet, tt.path)
+		if rec.Code != tt.wantStatus {
+			t.Errorf("%s: status = %d, want %d", tt.path, rec.Code, tt.wantStatus)
+		}
+		if isHTML := strings.HasPrefix(rec.Header().Get("Content-Type"), "text/html"); isHTML != tt.wantHTML {
+			t.Errorf("%s: Content-Type = %q, want index.html = %v", tt.path, rec.Header().Get("Content-Type"), tt.wantHTML)
+		}
+	}
+}
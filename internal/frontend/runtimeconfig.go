@@ -0,0 +1,88 @@
+package frontend
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+// RuntimeConfigPath is where the router serves the runtime config script.
+const RuntimeConfigPath = "/config.js"
+
+// RuntimeConfig is the configuration the SPA reads from window.__APP_CONFIG__.
+// It is an allowlist: only these fields ever reach the browser. Add a field
+// here to expose a new value; never add secrets or embed a whole config struct.
+type RuntimeConfig struct {
+	ServerName string          `json:"server_name"`
+	APIBase    string          `json:"api_base"` // Router.APIPrefix when empty
+	Version    string          `json:"version"`
+	Features   map[string]bool `json:"features"` // Feature flags, all false unless listed
+}
+
+// RuntimeConfigFromEnv builds the runtime config from environment variables:
+//
+//	APP_VERSION        build version (default: VCS revision from the Go build info)
+//	FRONTEND_FEATURES  comma-separated feature flags to turn on, e.g. "jobs,admin"
+func RuntimeConfigFromEnv(serverName string) RuntimeConfig {
+	cfg := RuntimeConfig{
+		ServerName: serverName,
+		Version:    os.Getenv("APP_VERSION"),
+		Features:   make(map[string]bool),
+	}
+	if cfg.Version == "" {
+		cfg.Version = buildVersion()
+	}
+	for _, flag := range strings.Split(os.Getenv("FRONTEND_FEATURES"), ",") {
+		if flag = strings.TrimSpace(flag); flag != "" {
+			cfg.Features[flag] = true
+		}
+	}
+	return cfg
+}
+
+// buildVersion returns the module version or VCS revision of the binary.
+func buildVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "dev"
+	}
+	if v := info.Main.Version; v != "" && v != "(devel)" {
+		return v
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" && len(setting.Value) >= 12 {
+			return setting.Value[:12]
+		}
+	}
+	return "dev"
+}
+
+// runtimeConfigHandler serves cfg as a script that sets window.__APP_CONFIG__.
+// index.html loads it before the app bundle.
+func runtimeConfigHandler(cfg RuntimeConfig) (http.Handler, error) {
+	if cfg.Features == nil {
+		cfg.Features = map[string]bool{}
+	}
+	// json.Marshal escapes <, > and &, so the value can't close the script
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode runtime config: %w", err)
+	}
+	script := []byte("window.__APP_CONFIG__ = " + string(data) + ";\n")
+	sum := sha256.Sum256(script)
+	etag := `"` + hex.EncodeToString(sum[:12]) + `"`
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+		w.Header().Set("Cache-Control", cacheRevalidate)
+		w.Header().Set("ETag", etag)
+		http.ServeContent(w, r, RuntimeConfigPath, time.Time{}, bytes.NewReader(script))
+	}), nil
+}
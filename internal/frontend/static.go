@@ -0,0 +1,255 @@
+package frontend
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// StaticConfig controls how the embedded frontend is served.
+type StaticConfig struct {
+	// Fallback is served for unknown paths that don't look like files, so
+	// client-side routes like /jobs/42 load the SPA. Empty disables the fallback.
+	Fallback string
+
+	// ImmutablePrefix holds content-hashed files (Vite's assets/) that are
+	// cached for a year. Everything else is revalidated with its ETag.
+	ImmutablePrefix string
+}
+
+// DefaultStaticConfig serves a Vite build: index.html fallback, hashed assets/.
+func DefaultStaticConfig() StaticConfig {
+	return StaticConfig{Fallback: "index.html", ImmutablePrefix: "assets/"}
+}
+
+// StaticConfigFromEnv reads static serving settings from environment variables:
+//
+//	FRONTEND_SPA_FALLBACK  false to answer unknown paths with 404 instead of index.html
+func StaticConfigFromEnv() StaticConfig {
+	cfg := DefaultStaticConfig()
+	if v := os.Getenv("FRONTEND_SPA_FALLBACK"); v != "" {
+		if enabled, err := strconv.ParseBool(v); err == nil && !enabled {
+			cfg.Fallback = ""
+		}
+	}
+	return cfg
+}
+
+// Cache-Control values.
+const (
+	cacheImmutable  = "public, max-age=31536000, immutable"
+	cacheRevalidate = "no-cache"
+)
+
+// gzipMinSize is the smallest file worth compressing at startup.
+const gzipMinSize = 1024
+
+// staticFile is one file with its encodings, prepared at startup.
+type staticFile struct {
+	name        string
+	contentType string
+	cache       string
+	variants    map[string]variant // By Content-Encoding; "" is the identity encoding
+}
+
+// variant is the file body in one encoding.
+type variant struct {
+	data []byte
+	etag string
+}
+
+// Static serves files from an fs.FS. Files are read once, with ETags computed
+// and gzip variants prepared up front; precompressed name.br and name.gz files
+// next to a file are served to clients that accept them.
+type Static struct {
+	files     map[string]*staticFile
+	fallback  *staticFile
+	immutable string
+}
+
+// NewStatic reads every file in fsys.
+func NewStatic(fsys fs.FS, cfg StaticConfig) (*Static, error) {
+	s := &Static{files: make(map[string]*staticFile), immutable: cfg.ImmutablePrefix}
+
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		// Precompressed variants are attached to their original below
+		if ext := path.Ext(name); ext == ".br" || ext == ".gz" {
+			if _, err := fs.Stat(fsys, strings.TrimSuffix(name, ext)); err == nil {
+				return nil
+			}
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		f := &staticFile{
+			name:        name,
+			contentType: contentType(name, data),
+			cache:       cacheRevalidate,
+			variants:    map[string]variant{"": {data: data, etag: etag(data, "")}},
+		}
+		if cfg.ImmutablePrefix != "" && strings.HasPrefix(name, cfg.ImmutablePrefix) {
+			f.cache = cacheImmutable
+		}
+
+		for encoding, ext := range map[string]string{"br": ".br", "gzip": ".gz"} {
+			if compressed, err := fs.ReadFile(fsys, name+ext); err == nil {
+				f.variants[encoding] = variant{data: compressed, etag: etag(data, encoding)}
+			}
+		}
+		if _, ok := f.variants["gzip"]; !ok && len(data) >= gzipMinSize && compressibleType(f.contentType) {
+			if compressed, err := gzipBytes(data); err == nil && len(compressed) < len(data) {
+				f.variants["gzip"] = variant{data: compressed, etag: etag(data, "gzip")}
+			}
+		}
+
+		s.files[name] = f
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read frontend files: %w", err)
+	}
+
+	if cfg.Fallback != "" {
+		s.fallback = s.files[strings.TrimPrefix(cfg.Fallback, "/")]
+		if s.fallback == nil {
+			return nil, fmt.Errorf("SPA fallback %q not found", cfg.Fallback)
+		}
+	}
+	return s, nil
+}
+
+// ServeHTTP serves the file at the request path. Paths with a file extension
+// or under the immutable prefix that don't exist get 404; other unknown paths
+// get the fallback.
+func (s *Static) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	f := s.files[name]
+	if f == nil {
+		f = s.files[path.Join(name, "index.html")]
+	}
+	if f == nil && s.fallback != nil && !s.isAsset(name) {
+		f = s.fallback
+	}
+	if f == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	encoding := negotiate(r.Header.Get("Accept-Encoding"), f.variants)
+	v := f.variants[encoding]
+
+	h := w.Header()
+	h.Set("Content-Type", f.contentType)
+	h.Set("Cache-Control", f.cache)
+	h.Set("ETag", v.etag)
+	if len(f.variants) > 1 {
+		addVary(h, "Accept-Encoding")
+	}
+	if encoding != "" {
+		h.Set("Content-Encoding", encoding)
+	}
+	// ServeContent answers If-None-Match, Range and HEAD
+	http.ServeContent(w, r, f.name, time.Time{}, bytes.NewReader(v.data))
+}
+
+// isAsset reports whether a missing path must be a missing file rather than a
+// client-side route.
+func (s *Static) isAsset(name string) bool {
+	return path.Ext(name) != "" || (s.immutable != "" && strings.HasPrefix(name, s.immutable))
+}
+
+// negotiate picks br, then gzip, then the identity encoding, honoring q=0.
+func negotiate(header string, variants map[string]variant) string {
+	accepted := make(map[string]bool)
+	for _, part := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := strings.TrimSpace(params)
+		if strings.HasPrefix(q, "q=") {
+			if v, err := strconv.ParseFloat(q[2:], 64); err == nil && v == 0 {
+				continue
+			}
+		}
+		accepted[strings.ToLower(strings.TrimSpace(coding))] = true
+	}
+	for _, encoding := range []string{"br", "gzip"} {
+		if _, ok := variants[encoding]; ok && (accepted[encoding] || accepted["*"]) {
+			return encoding
+		}
+	}
+	return ""
+}
+
+// etag is a strong validator derived from the uncompressed content; encoded
+// variants get a suffix so caches don't mix them up.
+func etag(data []byte, encoding string) string {
+	sum := sha256.Sum256(data)
+	tag := hex.EncodeToString(sum[:12])
+	if encoding != "" {
+		tag += "-" + encoding
+	}
+	return `"` + tag + `"`
+}
+
+func contentType(name string, data []byte) string {
+	if ct := mime.TypeByExtension(path.Ext(name)); ct != "" {
+		return ct
+	}
+	return http.DetectContentType(data)
+}
+
+// compressibleType reports whether gzip is likely to shrink a file.
+func compressibleType(ct string) bool {
+	return strings.HasPrefix(ct, "text/") ||
+		strings.Contains(ct, "javascript") ||
+		strings.Contains(ct, "json") ||
+		strings.Contains(ct, "xml") ||
+		strings.HasPrefix(ct, "image/svg")
+}
+
+func gzipBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	gz, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := gz.Write(data); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// addVary adds a Vary value unless a middleware already did.
+func addVary(h http.Header, value string) {
+	for _, v := range h.Values("Vary") {
+		for _, existing := range strings.Split(v, ",") {
+			if strings.EqualFold(strings.TrimSpace(existing), value) {
+				return
+			}
+		}
+	}
+	h.Add("Vary", value)
+}
@@ -0,0 +1,236 @@
+package frontend
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+var (
+	testIndex  = []byte("<!doctype html><title>app</title>")
+	testScript = []byte(strings.Repeat("console.log('hello from the bundle');\n", 100))
+)
+
+func testFS() fstest.MapFS {
+	return fstest.MapFS{
+		"index.html":               {Data: testIndex},
+		"favicon.svg":              {Data: []byte("<svg/>")},
+		"assets/index-abc123.js":   {Data: testScript},
+		"assets/app-def456.css":    {Data: []byte("body{margin:0}")},
+		"assets/app-def456.css.br": {Data: []byte("fake-brotli")},
+		"assets/app-def456.css.gz": {Data: []byte("fake-gzip")},
+		"docs/index.html":          {Data: []byte("<h1>docs</h1>")},
+	}
+}
+
+func newTestStatic(t *testing.T, cfg StaticConfig) *Static {
+	t.Helper()
+	s, err := NewStatic(testFS(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// serve sends a request with the given headers ("Key: value").
+func serve(h http.Handler, method, target string, headers ...string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, target, nil)
+	for _, header := range headers {
+		key, value, _ := strings.Cut(header, ": ")
+		r.Header.Set(key, value)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, r)
+	return rec
+}
+
+func TestStaticETag(t *testing.T) {
+	s := newTestStatic(t, DefaultStaticConfig())
+
+	rec := serve(s, http.MethodGet, "/index.html")
+	if rec.Code != http.StatusOK || !bytes.Equal(rec.Body.Bytes(), testIndex) {
+		t.Fatalf("GET /index.html = %d %q", rec.Code, rec.Body.String())
+	}
+	tag := rec.Header().Get("ETag")
+	if !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		t.Fatalf("ETag = %q, want a strong validator", tag)
+	}
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		want        int
+	}{
+		{"matching", tag, http.StatusNotModified},
+		{"in a list", `"other", ` + tag, http.StatusNotModified},
+		{"wildcard", "*", http.StatusNotModified},
+		{"stale", `"stale"`, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(s, http.MethodGet, "/index.html", "If-None-Match: "+tt.ifNoneMatch)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if tt.want == http.StatusNotModified && rec.Body.Len() != 0 {
+				t.Errorf("304 has a body: %q", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestStaticEncodingNegotiation(t *testing.T) {
+	s := newTestStatic(t, DefaultStaticConfig())
+
+	tests := []struct {
+		name           string
+		target         string
+		acceptEncoding string
+		wantEncoding   string
+		wantBody       string
+	}{
+		{"br preferred", "/assets/app-def456.css", "gzip, deflate, br", "br", "fake-brotli"},
+		{"gzip without br", "/assets/app-def456.css", "gzip", "gzip", "fake-gzip"},
+		{"br refused with q=0", "/assets/app-def456.css", "br;q=0, gzip", "gzip", "fake-gzip"},
+		{"wildcard", "/assets/app-def456.css", "*", "br", "fake-brotli"},
+		{"identity", "/assets/app-def456.css", "", "", "body{margin:0}"},
+		{"everything refused", "/assets/app-def456.css", "br;q=0, gzip;q=0", "", "body{margin:0}"},
+		{"br not available", "/assets/index-abc123.js", "br", "", string(testScript)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serve(s, http.MethodGet, tt.target, "Accept-Encoding: "+tt.acceptEncoding)
+			if got := rec.Header().Get("Content-Encoding"); got != tt.wantEncoding {
+				t.Errorf("Content-Encoding = %q, want %q", got, tt.wantEncoding)
+			}
+			if rec.Body.String() != tt.wantBody {
+				t.Errorf("body = %.40q, want %.40q", rec.Body.String(), tt.wantBody)
+			}
+			if vary := rec.Header().Get("Vary"); vary != "Accept-Encoding" {
+				t.Errorf("Vary = %q", vary)
+			}
+			if ct := rec.Header().Get("Content-Type"); ct == "" || strings.Contains(ct, "brotli") {
+				t.Errorf("Content-Type = %q, want the original file's type", ct)
+			}
+		})
+	}
+
+	// The precompressed files themselves are not served as separate files
+	if rec := serve(s, http.MethodGet, "/assets/app-def456.css.br"); rec.Code != http.StatusNotFound {
+		t.Errorf("GET .br file = %d, want 404", rec.Code)
+	}
+}
+
+func TestStaticGzipsLargeFilesAtStartup(t *testing.T) {
+	s := newTestStatic(t, DefaultStaticConfig())
+
+	identity := serve(s, http.MethodGet, "/assets/index-abc123.js")
+	rec := serve(s, http.MethodGet, "/assets/index-abc123.js", "Accept-Encoding: gzip")
+	if rec.Header().Get("Content-Encoding") != "gzip" {
+		t.Fatal("large script was not gzipped")
+	}
+	if rec.Header().Get("ETag") == identity.Header().Get("ETag") {
+		t.Error("gzip and identity variants share an ETag")
+	}
+
+	gz, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(gz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, testScript) {
+		t.Error("gzip variant does not decompress to the original")
+	}
+
+	// Small files are served as is, without Vary
+	small := serve(s, http.MethodGet, "/favicon.svg", "Accept-Encoding: gzip")
+	if small.Header().Get("Content-Encoding") != "" || small.Header().Get("Vary") != "" {
+		t.Errorf("small file headers = %v", small.Header())
+	}
+}
+
+func TestStaticCacheControl(t *testing.T) {
+	s := newTestStatic(t, DefaultStaticConfig())
+
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"/assets/index-abc123.js", cacheImmutable},
+		{"/assets/app-def456.css", cacheImmutable},
+		{"/index.html", cacheRevalidate},
+		{"/", cacheRevalidate},
+		{"/favicon.svg", cacheRevalidate},
+		{"/jobs/42", cacheRevalidate}, // SPA fallback
+	}
+	for _, tt := range tests {
+		if got := serve(s, http.MethodGet, tt.target).Header().Get("Cache-Control"); got != tt.want {
+			t.Errorf("GET %s Cache-Control = %q, want %q", tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestStaticRouting(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      StaticConfig
+		method   string
+		target   string
+		want     int
+		wantBody string
+	}{
+		{"root serves index.html", DefaultStaticConfig(), "GET", "/", 200, string(testIndex)},
+		{"directory index", DefaultStaticConfig(), "GET", "/docs", 200, "<h1>docs</h1>"},
+		{"client-side route falls back", DefaultStaticConfig(), "GET", "/jobs/42", 200, string(testIndex)},
+		{"path traversal stays inside", DefaultStaticConfig(), "GET", "/../index.html", 200, string(testIndex)},
+		{"missing asset", DefaultStaticConfig(), "GET", "/assets/index-old.js", 404, ""},
+		{"missing file under assets/ without extension", DefaultStaticConfig(), "GET", "/assets/chunk", 404, ""},
+		{"missing file with extension", DefaultStaticConfig(), "GET", "/robots.txt", 404, ""},
+		{"fallback disabled", StaticConfig{ImmutablePrefix: "assets/"}, "GET", "/jobs/42", 404, ""},
+		{"HEAD has no body", DefaultStaticConfig(), "HEAD", "/index.html", 200, ""},
+		{"POST is not allowed", DefaultStaticConfig(), "POST", "/index.html", 405, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewStatic(testFS(), tt.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rec := serve(s, tt.method, tt.target)
+			if rec.Code != tt.want {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.want)
+			}
+			if tt.want == 200 && rec.Body.String() != tt.wantBody {
+				t.Errorf("body = %q, want %q", rec.Body.String(), tt.wantBody)
+			}
+			if tt.want == 405 && rec.Header().Get("Allow") != "GET, HEAD" {
+				t.Errorf("Allow = %q", rec.Header().Get("Allow"))
+			}
+		})
+	}
+}
+
+func TestNewStaticRequiresFallback(t *testing.T) {
+	if _, err := NewStatic(fstest.MapFS{"app.js": {Data: []byte("x")}}, DefaultStaticConfig()); err == nil {
+		t.Fatal("expected an error when the fallback file is missing")
+	}
+}
+
+func TestStaticConfigFromEnv(t *testing.T) {
+	t.Setenv("FRONTEND_SPA_FALLBACK", "false")
+	if cfg := StaticConfigFromEnv(); cfg.Fallback != "" {
+		t.Errorf("Fallback = %q, want disabled", cfg.Fallback)
+	}
+	t.Setenv("FRONTEND_SPA_FALLBACK", "true")
+	if cfg := StaticConfigFromEnv(); cfg.Fallback != "index.html" {
+		t.Errorf("Fallback = %q, want index.html", cfg.Fallback)
+	}
+}
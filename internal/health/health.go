@@ -0,0 +1,266 @@
+// Package health runs named health checks for the liveness and readiness
+// endpoints. Components register a check with a timeout and criticality; the
+// registry runs checks concurrently and caches results so frequent probes don't
+// hammer databases or external APIs.
+//
+//	health.DefaultRegistry.Register(health.Check{
+//	    Name:     "database",
+//	    Critical: true,
+//	    Run:      func(ctx context.Context) error { return db.PingContext(ctx) },
+//	})
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Status is the outcome of a check or a report.
+type Status string
+
+const (
+	StatusOK       Status = "ok"
+	StatusDegraded Status = "degraded" // A non-critical check failed
+	StatusFail     Status = "fail"     // A critical check failed
+)
+
+// Check is a named probe of one component.
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+
+	// Timeout bounds one run (default Config.Timeout). A check that times out fails.
+	Timeout time.Duration
+
+	// Critical checks fail readiness; other failures only report "degraded".
+	Critical bool
+
+	// Liveness checks also run for /healthz. Only use this for failures a
+	// restart fixes (e.g. a wedged event loop), never for external services.
+	Liveness bool
+
+	// CacheTTL is how long a result is reused (default Config.CacheTTL).
+	CacheTTL time.Duration
+}
+
+// Result is the outcome of one check.
+type Result struct {
+	Name       string    `json:"name"`
+	Status     Status    `json:"status"`
+	Critical   bool      `json:"critical"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs float64   `json:"duration_ms"`
+	CheckedAt  time.Time `json:"checked_at"`
+	Cached     bool      `json:"cached"`
+}
+
+// Report is the combined outcome of a set of checks.
+type Report struct {
+	Status Status   `json:"status"`
+	Checks []Result `json:"checks"`
+}
+
+// Config holds registry defaults.
+type Config struct {
+	Timeout  time.Duration // Default per-check timeout
+	CacheTTL time.Duration // Default result cache time
+}
+
+// DefaultConfig returns the defaults: 2s timeout, 5s cache.
+func DefaultConfig() Config {
+	return Config{Timeout: 2 * time.Second, CacheTTL: 5 * time.Second}
+}
+
+// ConfigFromEnv reads registry defaults from environment variables:
+//
+//	HEALTH_CHECK_TIMEOUT  per-check timeout (default 2s)
+//	HEALTH_CACHE_TTL      how long results are reused (default 5s, 0 disables caching)
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig()
+	if v := os.Getenv("HEALTH_CHECK_TIMEOUT"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			return cfg, fmt.Errorf("invalid HEALTH_CHECK_TIMEOUT %q", v)
+		}
+		cfg.Timeout = d
+	}
+	if v := os.Getenv("HEALTH_CACHE_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return cfg, fmt.Errorf("invalid HEALTH_CACHE_TTL %q", v)
+		}
+		cfg.CacheTTL = d
+	}
+	return cfg, nil
+}
+
+// entry is a registered check with its cached result.
+type entry struct {
+	check Check
+
+	mu     sync.Mutex // Held while running, so concurrent probes share one run
+	last   Result
+	expiry time.Time
+}
+
+// Registry holds the registered checks.
+type Registry struct {
+	mu     sync.RWMutex
+	cfg    Config
+	checks map[string]*entry
+}
+
+// DefaultRegistry is shared by the components that register checks and the
+// /healthz and /readyz endpoints.
+var DefaultRegistry = NewRegistry(DefaultConfig())
+
+// NewRegistry creates an empty registry.
+func NewRegistry(cfg Config) *Registry {
+	return &Registry{cfg: cfg, checks: make(map[string]*entry)}
+}
+
+// Configure changes the defaults for checks run from now on.
+func (r *Registry) Configure(cfg Config) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cfg = cfg
+}
+
+// Register adds a check, replacing any check with the same name.
+func (r *Registry) Register(c Check) {
+	if c.Name == "" || c.Run == nil {
+		panic("health: check needs a name and a Run function")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[c.Name] = &entry{check: c}
+}
+
+// Unregister removes a check.
+func (r *Registry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.checks, name)
+}
+
+// Liveness runs the checks marked Liveness. With none registered the process
+// is alive as long as it can answer.
+func (r *Registry) Liveness(ctx context.Context) Report {
+	return r.run(ctx, func(c Check) bool { return c.Liveness })
+}
+
+// Readiness runs every check.
+func (r *Registry) Readiness(ctx context.Context) Report {
+	return r.run(ctx, func(Check) bool { return true })
+}
+
+// run runs the selected checks concurrently and combines their results.
+func (r *Registry) run(ctx context.Context, include func(Check) bool) Report {
+	r.mu.RLock()
+	cfg := r.cfg
+	var entries []*entry
+	for _, e := range r.checks {
+		if include(e.check) {
+			entries = append(entries, e)
+		}
+	}
+	r.mu.RUnlock()
+
+	results := make([]Result, len(entries))
+	var wg sync.WaitGroup
+	for i, e := range entries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = e.result(ctx, cfg)
+		}()
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	report := Report{Status: StatusOK, Checks: results}
+	for _, res := range results {
+		switch {
+		case res.Status == StatusOK:
+		case res.Critical:
+			report.Status = StatusFail
+		case report.Status == StatusOK:
+			report.Status = StatusDegraded
+		}
+	}
+	return report
+}
+
+// result returns the cached result or runs the check.
+func (e *entry) result(ctx context.Context, cfg Config) Result {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	if now.Before(e.expiry) {
+		res := e.last
+		res.Cached = true
+		return res
+	}
+
+	timeout := e.check.Timeout
+	if timeout <= 0 {
+		timeout = cfg.Timeout
+	}
+	ttl := e.check.CacheTTL
+	if ttl <= 0 {
+		ttl = cfg.CacheTTL
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := runCheck(ctx, e.check.Run)
+
+	res := Result{
+		Name:       e.check.Name,
+		Status:     StatusOK,
+		Critical:   e.check.Critical,
+		DurationMs: float64(time.Since(now).Microseconds()) / 1000,
+		CheckedAt:  now,
+	}
+	if err != nil {
+		res.Status = StatusFail
+		res.Error = err.Error()
+	}
+
+	// A probe cancelled by its caller says nothing about the component
+	if ctx.Err() == nil || !errors.Is(context.Cause(ctx), context.Canceled) {
+		e.last = res
+		e.expiry = now.Add(ttl)
+	}
+	return res
+}
+
+// runCheck runs fn, returning when it finishes or the context is done, so a
+// check that ignores its context can't block the probe. Panics become failures.
+func runCheck(ctx context.Context, fn func(context.Context) error) error {
+	done := make(chan error, 1)
+	go func() {
+		defer func() {
+			if p := recover(); p != nil {
+				done <- fmt.Errorf("check panicked: %v", p)
+			}
+		}()
+		done <- fn(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return errors.New("timed out")
+		}
+		return ctx.Err()
+	}
+}
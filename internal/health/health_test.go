@@ -0,0 +1,248 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/health"
+	httphealth "github.com/dibbla-agents/go-worker-starter-template/internal/http_handlers/health"
+)
+
+func ok(context.Context) error { return nil }
+
+func failing(context.Context) error { return errors.New("connection refused") }
+
+func TestReadinessAggregation(t *testing.T) {
+	tests := []struct {
+		name   string
+		checks []health.Check
+		want   health.Status
+	}{
+		{name: "no checks", want: health.StatusOK},
+		{
+			name:   "all ok",
+			checks: []health.Check{{Name: "db", Critical: true, Run: ok}, {Name: "cache", Run: ok}},
+			want:   health.StatusOK,
+		},
+		{
+			name:   "non-critical failure degrades",
+			checks: []health.Check{{Name: "db", Critical: true, Run: ok}, {Name: "cache", Run: failing}},
+			want:   health.StatusDegraded,
+		},
+		{
+			name:   "critical failure fails",
+			checks: []health.Check{{Name: "db", Critical: true, Run: failing}, {Name: "cache", Run: ok}},
+			want:   health.StatusFail,
+		},
+		{
+			name:   "critical failure wins over degraded",
+			checks: []health.Check{{Name: "a", Run: failing}, {Name: "b", Critical: true, Run: failing}, {Name: "c", Run: failing}},
+			want:   health.StatusFail,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := health.NewRegistry(health.DefaultConfig())
+			for _, c := range tt.checks {
+				r.Register(c)
+			}
+			report := r.Readiness(context.Background())
+			if report.Status != tt.want {
+				t.Errorf("status = %s, want %s (%+v)", report.Status, tt.want, report.Checks)
+			}
+			if len(report.Checks) != len(tt.checks) {
+				t.Errorf("got %d results, want %d", len(report.Checks), len(tt.checks))
+			}
+		})
+	}
+}
+
+func TestCheckResults(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+
+	r := health.NewRegistry(health.Config{Timeout: 20 * time.Millisecond, CacheTTL: time.Minute})
+	r.Register(health.Check{Name: "a-ok", Run: ok})
+	r.Register(health.Check{Name: "b-failing", Critical: true, Run: failing})
+	r.Register(health.Check{Name: "c-slow", Run: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+	r.Register(health.Check{Name: "d-ignores-context", Timeout: 10 * time.Millisecond, Run: func(context.Context) error {
+		<-block
+		return nil
+	}})
+	r.Register(health.Check{Name: "e-panics", Run: func(context.Context) error { panic("nil map") }})
+
+	start := time.Now()
+	report := r.Readiness(context.Background())
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("readiness took %v; checks should run concurrently and time out", elapsed)
+	}
+
+	want := []struct {
+		name   string
+		status health.Status
+		err    string
+	}{
+		{"a-ok", health.StatusOK, ""},
+		{"b-failing", health.StatusFail, "connection refused"},
+		{"c-slow", health.StatusFail, "timed out"},
+		{"d-ignores-context", health.StatusFail, "timed out"},
+		{"e-panics", health.StatusFail, "check panicked: nil map"},
+	}
+	if len(report.Checks) != len(want) {
+		t.Fatalf("checks = %+v", report.Checks)
+	}
+	for i, w := range want {
+		got := report.Checks[i]
+		if got.Name != w.name || got.Status != w.status || got.Error != w.err || got.Cached {
+			t.Errorf("check %d = %+v, want %s %s %q", i, got, w.name, w.status, w.err)
+		}
+	}
+}
+
+func TestResultsAreCached(t *testing.T) {
+	var runs atomic.Int32
+	count := func(context.Context) error {
+		runs.Add(1)
+		return nil
+	}
+
+	r := health.NewRegistry(health.Config{Timeout: time.Second, CacheTTL: time.Hour})
+	r.Register(health.Check{Name: "cached", Run: count})
+	r.Register(health.Check{Name: "uncached", Run: count, CacheTTL: time.Nanosecond})
+
+	r.Readiness(context.Background())
+	report := r.Readiness(context.Background())
+	if got := runs.Load(); got != 3 {
+		t.Errorf("runs = %d, want 3 (cached once, uncached twice)", got)
+	}
+	if !report.Checks[0].Cached || report.Checks[1].Cached {
+		t.Errorf("cached flags = %v, %v; want true, false", report.Checks[0].Cached, report.Checks[1].Cached)
+	}
+
+	// Concurrent probes share one run of an expired check
+	runs.Store(0)
+	r.Register(health.Check{Name: "cached", Run: count}) // Re-registering drops the cached result
+	r.Unregister("uncached")
+	done := make(chan struct{})
+	for i := 0; i < 10; i++ {
+		go func() {
+			r.Readiness(context.Background())
+			done <- struct{}{}
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		<-done
+	}
+	if got := runs.Load(); got != 1 {
+		t.Errorf("10 concurrent probes ran the check %d times, want 1", got)
+	}
+}
+
+func TestCancelledProbeIsNotCached(t *testing.T) {
+	var runs atomic.Int32
+	r := health.NewRegistry(health.Config{Timeout: time.Second, CacheTTL: time.Hour})
+	r.Register(health.Check{Name: "db", Critical: true, Run: func(ctx context.Context) error {
+		if runs.Add(1) == 1 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return nil
+	}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	if report := r.Readiness(ctx); report.Status != health.StatusFail {
+		t.Fatalf("cancelled probe status = %s, want fail", report.Status)
+	}
+
+	// The next probe runs the check again instead of reusing the cancelled result
+	if report := r.Readiness(context.Background()); report.Status != health.StatusOK || report.Checks[0].Cached {
+		t.Errorf("report after a cancelled probe = %+v", report)
+	}
+}
+
+func TestProbeEndpoints(t *testing.T) {
+	r := health.NewRegistry(health.DefaultConfig())
+	r.Register(health.Check{Name: "event-loop", Liveness: true, Run: ok})
+	r.Register(health.Check{Name: "database", Critical: true, Run: failing})
+	r.Register(health.Check{Name: "openai", Run: failing})
+
+	mux := http.NewServeMux()
+	httphealth.Register(mux, r)
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		wantReport health.Status
+		wantChecks []string
+	}{
+		// /healthz only runs liveness checks, so a database outage does not
+		// get the container restarted
+		{"/healthz", http.StatusOK, health.StatusOK, []string{"event-loop"}},
+		{"/readyz", http.StatusServiceUnavailable, health.StatusFail, []string{"database", "event-loop", "openai"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if rec.Code != tt.wantStatus || rec.Header().Get("Cache-Control") != "no-store" {
+				t.Errorf("status = %d, Cache-Control %q", rec.Code, rec.Header().Get("Cache-Control"))
+			}
+
+			var report health.Report
+			if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, c := range report.Checks {
+				names = append(names, c.Name)
+			}
+			if report.Status != tt.wantReport || len(names) != len(tt.wantChecks) {
+				t.Fatalf("report = %+v, want %s with %v", report, tt.wantReport, tt.wantChecks)
+			}
+			for i := range names {
+				if names[i] != tt.wantChecks[i] {
+					t.Errorf("checks = %v, want %v", names, tt.wantChecks)
+				}
+			}
+		})
+	}
+
+	// Degraded readiness still answers 200
+	r.Unregister("database")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("degraded /readyz = %d, want 200", rec.Code)
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("HEALTH_CHECK_TIMEOUT", "500ms")
+	t.Setenv("HEALTH_CACHE_TTL", "0")
+	cfg, err := health.ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Timeout != 500*time.Millisecond || cfg.CacheTTL != 0 {
+		t.Errorf("config = %+v", cfg)
+	}
+
+	for key, value := range map[string]string{"HEALTH_CHECK_TIMEOUT": "0s", "HEALTH_CACHE_TTL": "-1s"} {
+		t.Run(key, func(t *testing.T) {
+			t.Setenv(key, value)
+			if _, err := health.ConfigFromEnv(); err == nil {
+				t.Errorf("%s=%s: expected an error", key, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Redacted replaces secret values.
+const Redacted = "[redacted]"
+
+// settingPrefixes select the environment variables the worker reads; the rest
+// of the environment (PATH, HOME, ...) is not reported.
+var settingPrefixes = []string{
+	"APP_", "AUTH_", "AWS_", "CHAT_", "CIRCUIT_BREAKER_", "DATABASE_", "DB_", "EMBEDDING_",
+	"ENVIRONMENT", "FRONTEND_", "GRPC_", "HEALTH_", "HTTP_", "LLM_", "LOG_", "OPENAI_", "OTEL_",
+	"PROMPTS_", "RATE_LIMIT", "RBAC_", "REDIS_", "SERVER_", "TRACING_", "VECTOR_STORE_", "WORKER_",
+}
+
+// dsnPassword matches the password in a key=value connection string.
+var dsnPassword = regexp.MustCompile(`(?i)\bpassword=\S+`)
+
+// secretMarkers mark variables whose whole value is secret.
+var secretMarkers = []string{"TOKEN", "SECRET", "PASSWORD", "KEY", "HEADERS", "CREDENTIAL"}
+
+// Setting is one environment variable.
+type Setting struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Redacted bool   `json:"redacted"`
+}
+
+// Settings returns the worker's settings from environ ("KEY=value" pairs),
+// sorted by name. Secret values are replaced by Redacted, and passwords in
+// URLs and connection strings (e.g. DATABASE_URL) are masked.
+func Settings(environ []string) []Setting {
+	settings := []Setting{}
+	for _, kv := range environ {
+		name, value, _ := strings.Cut(kv, "=")
+		if !isSetting(name) {
+			continue
+		}
+		setting := Setting{Name: name, Value: value}
+		if isSecret(name) {
+			setting.Value, setting.Redacted = Redacted, true
+		} else if masked := maskPassword(value); masked != value {
+			setting.Value, setting.Redacted = masked, true
+		}
+		settings = append(settings, setting)
+	}
+	sort.Slice(settings, func(i, j int) bool { return settings[i].Name < settings[j].Name })
+	return settings
+}
+
+func isSetting(name string) bool {
+	for _, prefix := range settingPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSecret(name string) bool {
+	for _, marker := range secretMarkers {
+		if strings.Contains(name, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskPassword hides the password of a URL with credentials or of a
+// "host=... password=..." connection string; other values are returned unchanged.
+func maskPassword(value string) string {
+	if dsnPassword.MatchString(value) {
+		return dsnPassword.ReplaceAllString(value, "password=xxxxx")
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.User == nil {
+		return value
+	}
+	if _, ok := u.User.Password(); !ok {
+		return value
+	}
+	return u.Redacted()
+}
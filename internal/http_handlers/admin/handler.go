@@ -0,0 +1,403 @@
+// Package admin provides the endpoints behind the operator dashboard: worker
+// functions with their schemas, invocation counts and a "try it" call, tracked
+// jobs with cancel, schedules, recent invocations, configuration (with secrets
+// redacted), build info and Go runtime stats.
+package admin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"runtime"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/health"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/jobs"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/openapi"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/rbac"
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
+)
+
+// startedAt is when the process started, for uptime.
+var startedAt = time.Now()
+
+// Options lists what the endpoints report on.
+type Options struct {
+	ServerName  string
+	Mode        string // Worker mode: connected or offline
+	Functions   []workerfunctions.Definition
+	Invoker     workerfunctions.Invoker // Runs the registered functions; defaults to all of Functions
+	Policy      *rbac.Enforcer          // Decides who may invoke functions
+	Jobs        *jobs.Tracker
+	Schedules   *jobs.Scheduler
+	Invocations *workerfunctions.InvocationLog
+	Health      *health.Registry
+}
+
+// Function describes a worker function and its invocations since startup
+type Function struct {
+	Name         string                `json:"name"`
+	Version      string                `json:"version"`
+	Description  string                `json:"description"`
+	Tags         []string              `json:"tags"`
+	Exposed      bool                  `json:"exposed"` // False when the policy keeps it from the platform and the HTTP API
+	Invocations  metrics.FunctionStats `json:"invocations"`
+	InputSchema  *openapi.Schema       `json:"input_schema,omitempty"`
+	OutputSchema *openapi.Schema       `json:"output_schema,omitempty"`
+}
+
+// FunctionsOutput defines the GET /api/admin/functions response body.
+// Schemas holds the named types the function schemas refer to with
+// "#/components/schemas/<name>".
+type FunctionsOutput struct {
+	Functions []Function                 `json:"functions"`
+	Schemas   map[string]*openapi.Schema `json:"schemas"`
+}
+
+// InvokeOutput defines the POST /api/admin/functions/{name}/invoke response body
+type InvokeOutput struct {
+	Output     json.RawMessage `json:"output"`          // null when the function failed
+	Error      string          `json:"error,omitempty"` // The function's error
+	DurationMs float64         `json:"duration_ms"`
+}
+
+// JobsOutput defines the GET /api/admin/jobs response body
+type JobsOutput struct {
+	Jobs []jobs.Job `json:"jobs"` // Queued, running and recently finished, newest first
+}
+
+// SchedulesOutput defines the GET /api/admin/schedules response body
+type SchedulesOutput struct {
+	Schedules []jobs.ScheduleStatus `json:"schedules"`
+}
+
+// InvocationsOutput defines the GET /api/admin/invocations response body
+type InvocationsOutput struct {
+	Invocations []workerfunctions.Invocation `json:"invocations"` // Newest first
+}
+
+// SummaryOutput defines the GET /api/admin/summary response body
+type SummaryOutput struct {
+	Mode          string        `json:"mode"` // connected or offline
+	Health        health.Report `json:"health"`
+	Invocations   uint64        `json:"invocations"`
+	Errors        uint64        `json:"errors"` // Including panics
+	InFlight      int           `json:"in_flight"`
+	RunningJobs   int           `json:"running_jobs"`
+	QueuedJobs    int           `json:"queued_jobs"`
+	Goroutines    int           `json:"goroutines"`
+	HeapBytes     uint64        `json:"heap_bytes"`
+	UptimeSeconds float64       `json:"uptime_seconds"`
+}
+
+// ConfigOutput defines the GET /api/admin/config response body
+type ConfigOutput struct {
+	Settings []Setting `json:"settings"`
+}
+
+// InfoOutput defines the GET /api/admin/info response body
+type InfoOutput struct {
+	ServerName    string    `json:"server_name"`
+	Mode          string    `json:"mode"` // connected or offline (no Dibbla gRPC connection)
+	Build         Build     `json:"build"`
+	Runtime       Runtime   `json:"runtime"`
+	StartedAt     time.Time `json:"started_at"`
+	UptimeSeconds float64   `json:"uptime_seconds"`
+}
+
+// Build describes the binary
+type Build struct {
+	Version     string `json:"version"` // APP_VERSION, else the module version
+	GoVersion   string `json:"go_version"`
+	Module      string `json:"module"`
+	VCSRevision string `json:"vcs_revision,omitempty"`
+	VCSTime     string `json:"vcs_time,omitempty"`
+	VCSModified bool   `json:"vcs_modified"`
+}
+
+// Runtime holds Go runtime stats
+type Runtime struct {
+	Goroutines     int     `json:"goroutines"`
+	GOMAXPROCS     int     `json:"gomaxprocs"`
+	HeapAllocBytes uint64  `json:"heap_alloc_bytes"`
+	HeapSysBytes   uint64  `json:"heap_sys_bytes"`
+	HeapObjects    uint64  `json:"heap_objects"`
+	GCCycles       uint32  `json:"gc_cycles"`
+	GCPauseTotalMs float64 `json:"gc_pause_total_ms"`
+}
+
+// Register registers the admin endpoints. Call it with a group that requires
+// the admin scope, e.g. api.Group("/admin", authn.Require("admin")):
+//
+//	GET  /api/admin/summary                 health, invocation totals, running and queued jobs
+//	GET  /api/admin/functions               worker functions with schemas and counts
+//	POST /api/admin/functions/{name}/invoke run a function in this process
+//	GET  /api/admin/invocations             recent invocations, newest first
+//	GET  /api/admin/jobs                    queued, running and recently finished jobs
+//	POST /api/admin/jobs/{id}/cancel        cancel a queued or running job
+//	GET  /api/admin/schedules               scheduled jobs with their last and next run
+//	GET  /api/admin/config                  settings from the environment, secrets redacted
+//	GET  /api/admin/info                    build info, uptime and Go runtime stats
+func Register(mux frontend.Registrar, opts Options) {
+	if opts.Invoker == nil {
+		local := workerfunctions.NewLocalServer()
+		for _, fn := range opts.Functions {
+			local.Register(fn)
+		}
+		opts.Invoker = local
+	}
+	if opts.Jobs == nil {
+		opts.Jobs = jobs.DefaultTracker
+	}
+	if opts.Schedules == nil {
+		opts.Schedules = jobs.DefaultScheduler
+	}
+	if opts.Invocations == nil {
+		opts.Invocations = workerfunctions.DefaultInvocationLog
+	}
+	if opts.Health == nil {
+		opts.Health = health.DefaultRegistry
+	}
+	// Schemas only depend on the Go types, so build them once
+	schemas := &openapi.Document{}
+	functions := make([]Function, len(opts.Functions))
+	for i, fn := range opts.Functions {
+		functions[i] = Function{
+			Name:         fn.Name,
+			Version:      fn.Version,
+			Description:  fn.Description,
+			Tags:         fn.Tags,
+			InputSchema:  schemas.SchemaFor(fn.Input),
+			OutputSchema: schemas.SchemaFor(fn.Output),
+		}
+		if functions[i].Tags == nil {
+			functions[i].Tags = []string{}
+		}
+	}
+	if schemas.Components.Schemas == nil {
+		schemas.Components.Schemas = map[string]*openapi.Schema{}
+	}
+	errs := []int{http.StatusUnauthorized, http.StatusForbidden}
+
+	mux.Handle("GET /summary", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		handleSummary(w, r, opts)
+	}, frontend.RouteDoc{
+		Summary: "Health, invocation totals and running and queued jobs for the dashboard",
+		Tags:    []string{"admin"},
+		Output:  SummaryOutput{},
+		Errors:  errs,
+	}))
+	mux.Handle("GET /functions", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		output := FunctionsOutput{Functions: make([]Function, len(functions)), Schemas: schemas.Components.Schemas}
+		registered := opts.Invoker.Functions()
+		for i, fn := range functions {
+			fn.Exposed = slices.Contains(registered, fn.Name)
+			fn.Invocations = metrics.Function(fn.Name, fn.Version)
+			output.Functions[i] = fn
+		}
+		httpapi.Encode(w, r, http.StatusOK, output)
+	}, frontend.RouteDoc{
+		Summary: "Worker functions with schemas and invocation counts since startup",
+		Tags:    []string{"admin"},
+		Output:  FunctionsOutput{},
+		Errors:  errs,
+	}))
+	mux.Handle("POST /functions/{name}/invoke", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		handleInvoke(w, r, opts)
+	}, frontend.RouteDoc{
+		Summary:     "Run a worker function in this process",
+		Description: "The body is the function input. Only registered functions run, so those the policy doesn't expose answer 404. A function error is reported in the error field of a 200 response.",
+		Tags:        []string{"admin"},
+		Input:       json.RawMessage{},
+		Output:      InvokeOutput{},
+		Errors:      []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound},
+	}))
+	mux.Handle("GET /invocations", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		httpapi.Encode(w, r, http.StatusOK, InvocationsOutput{Invocations: opts.Invocations.Recent(limit)})
+	}, frontend.RouteDoc{
+		Summary:     "Recent worker function invocations, newest first",
+		Description: "Pass ?limit=n to return fewer.",
+		Tags:        []string{"admin"},
+		Output:      InvocationsOutput{},
+		Errors:      errs,
+	}))
+	mux.Handle("GET /jobs", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpapi.Encode(w, r, http.StatusOK, JobsOutput{Jobs: opts.Jobs.Jobs()})
+	}, frontend.RouteDoc{
+		Summary:     "Queued, running and recently finished jobs",
+		Description: "Jobs run inline with the function call or schedule that starts them. With JOBS_MAX_CONCURRENT set, jobs beyond the limit are queued until a running job finishes.",
+		Tags:        []string{"admin"},
+		Output:      JobsOutput{},
+		Errors:      errs,
+	}))
+	mux.Handle("POST /jobs/{id}/cancel", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		handleCancel(w, r, opts.Jobs)
+	}, frontend.RouteDoc{
+		Summary:     "Cancel a queued or running job",
+		Description: "A queued job is canceled right away. A running job's context is canceled; it is marked canceled once it stops.",
+		Tags:        []string{"admin"},
+		Output:      jobs.Job{},
+		Errors:      []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound, http.StatusConflict},
+	}))
+	mux.Handle("GET /schedules", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpapi.Encode(w, r, http.StatusOK, SchedulesOutput{Schedules: opts.Schedules.Schedules()})
+	}, frontend.RouteDoc{
+		Summary:     "Scheduled jobs with their last and next run",
+		Description: "Schedules are listed in cmd/worker/schedules.go. A run still going when the next is due skips that run.",
+		Tags:        []string{"admin"},
+		Output:      SchedulesOutput{},
+		Errors:      errs,
+	}))
+	mux.Handle("GET /config", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpapi.Encode(w, r, http.StatusOK, ConfigOutput{Settings: Settings(os.Environ())})
+	}, frontend.RouteDoc{
+		Summary: "Worker settings from the environment, with secrets redacted",
+		Tags:    []string{"admin"},
+		Output:  ConfigOutput{},
+		Errors:  errs,
+	}))
+	mux.Handle("GET /info", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpapi.Encode(w, r, http.StatusOK, info(opts.ServerName, opts.Mode))
+	}, frontend.RouteDoc{
+		Summary: "Build info, uptime and Go runtime stats",
+		Tags:    []string{"admin"},
+		Output:  InfoOutput{},
+		Errors:  errs,
+	}))
+}
+
+// handleSummary adds up the counts of every function
+func handleSummary(w http.ResponseWriter, r *http.Request, opts Options) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	output := SummaryOutput{
+		Mode:          opts.Mode,
+		Health:        opts.Health.Readiness(r.Context()),
+		Goroutines:    runtime.NumGoroutine(),
+		HeapBytes:     mem.HeapAlloc,
+		UptimeSeconds: time.Since(startedAt).Seconds(),
+	}
+	for _, job := range opts.Jobs.Jobs() {
+		switch job.Status {
+		case jobs.StatusRunning:
+			output.RunningJobs++
+		case jobs.StatusQueued:
+			output.QueuedJobs++
+		}
+	}
+	for _, fn := range opts.Functions {
+		stats := metrics.Function(fn.Name, fn.Version)
+		output.Invocations += stats.Success + stats.Errors + stats.Panics
+		output.Errors += stats.Errors + stats.Panics
+		output.InFlight += stats.InFlight
+	}
+	httpapi.Encode(w, r, http.StatusOK, output)
+}
+
+// handleInvoke runs a function for the caller, if the policy lets them
+func handleInvoke(w http.ResponseWriter, r *http.Request, opts Options) {
+	name := r.PathValue("name")
+	if !slices.Contains(opts.Invoker.Functions(), name) {
+		httpapi.WriteError(w, r, httpapi.NotFound("unknown function: %s", name))
+		return
+	}
+	if err := opts.Policy.Authorize(r.Context(), name); err != nil {
+		httpapi.WriteError(w, r, err)
+		return
+	}
+
+	input, err := httpapi.Decode[json.RawMessage](w, r)
+	if err != nil {
+		httpapi.WriteError(w, r, err)
+		return
+	}
+	start := time.Now()
+	output, err := opts.Invoker.Invoke(name, input)
+	if errors.Is(err, workerfunctions.ErrInvalidInput) {
+		httpapi.WriteError(w, r, httpapi.BadRequest("%v", err))
+		return
+	}
+	// The call itself worked, so a function error is part of the result
+	result := InvokeOutput{Output: output, DurationMs: float64(time.Since(start).Microseconds()) / 1000}
+	if err != nil {
+		result.Output = json.RawMessage("null")
+		result.Error = strings.TrimPrefix(err.Error(), "handler error: ")
+	}
+	httpapi.Encode(w, r, http.StatusOK, result)
+}
+
+// handleCancel cancels a job and returns its state
+func handleCancel(w http.ResponseWriter, r *http.Request, tracker *jobs.Tracker) {
+	id := r.PathValue("id")
+	switch err := tracker.Cancel(id); {
+	case errors.Is(err, jobs.ErrUnknownJob):
+		httpapi.WriteError(w, r, httpapi.NotFound("unknown job: %s", id))
+		return
+	case errors.Is(err, jobs.ErrNotRunning):
+		httpapi.WriteError(w, r, httpapi.Conflict("job %s is not running", id))
+		return
+	}
+	job, err := tracker.Get(id)
+	if err != nil {
+		httpapi.WriteError(w, r, httpapi.NotFound("unknown job: %s", id))
+		return
+	}
+	httpapi.Encode(w, r, http.StatusOK, job)
+}
+
+// info collects build and runtime details
+func info(serverName, mode string) InfoOutput {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+
+	return InfoOutput{
+		ServerName: serverName,
+		Mode:       mode,
+		Build:      buildInfo(),
+		Runtime: Runtime{
+			Goroutines:     runtime.NumGoroutine(),
+			GOMAXPROCS:     runtime.GOMAXPROCS(0),
+			HeapAllocBytes: mem.HeapAlloc,
+			HeapSysBytes:   mem.HeapSys,
+			HeapObjects:    mem.HeapObjects,
+			GCCycles:       mem.NumGC,
+			GCPauseTotalMs: float64(mem.PauseTotalNs) / 1e6,
+		},
+		StartedAt:     startedAt,
+		UptimeSeconds: time.Since(startedAt).Seconds(),
+	}
+}
+
+// buildInfo reads the module and VCS details stamped by the Go toolchain
+func buildInfo() Build {
+	build := Build{GoVersion: runtime.Version(), Version: os.Getenv("APP_VERSION")}
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return build
+	}
+	build.Module = bi.Main.Path
+	if build.Version == "" {
+		build.Version = bi.Main.Version
+	}
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			build.VCSRevision = s.Value
+		case "vcs.time":
+			build.VCSTime = s.Value
+		case "vcs.modified":
+			build.VCSModified = s.Value == "true"
+		}
+	}
+	return build
+}
@@ -0,0 +1,111 @@
+// Package auth provides the login, logout and current-identity endpoints used by the SPA.
+package auth
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/auth"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+)
+
+// LoginInput defines the login request body
+type LoginInput struct {
+	Token string `json:"token"` // A static API token from AUTH_TOKENS
+}
+
+// LoginOutput defines the login response body
+type LoginOutput struct {
+	Subject   string    `json:"subject"`
+	Scopes    []string  `json:"scopes"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// MeOutput describes the current caller
+type MeOutput struct {
+	AuthEnabled   bool           `json:"auth_enabled"`
+	Authenticated bool           `json:"authenticated"`
+	Identity      *auth.Identity `json:"identity,omitempty"`
+}
+
+// Register registers the auth endpoints on your router's /api group:
+//
+//	POST /api/auth/login   exchange an API token for a session cookie (404 without AUTH_SESSION_SECRET)
+//	POST /api/auth/logout  clear the session cookie
+//	GET  /api/auth/me      describe the current caller
+func Register(mux frontend.Registrar, a *auth.Auth) {
+	// Always registered so the API (and generated client) doesn't depend on configuration
+	mux.Handle("POST /auth/login", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		handleLogin(w, r, a)
+	}, frontend.RouteDoc{
+		Summary:     "Exchange an API token for a session cookie",
+		Description: "Answers 404 unless AUTH_TOKENS and AUTH_SESSION_SECRET are set.",
+		Tags:        []string{"auth"},
+		Input:       LoginInput{},
+		Output:      LoginOutput{},
+		Errors:      []int{http.StatusUnauthorized, http.StatusNotFound},
+	}))
+	mux.Handle("POST /auth/logout", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		if a.Sessions() != nil {
+			a.Sessions().Clear(w)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}, frontend.RouteDoc{
+		Summary: "Clear the session cookie",
+		Tags:    []string{"auth"},
+		Status:  http.StatusNoContent,
+	}))
+
+	mux.Handle("GET /auth/me", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		handleMe(w, r, a)
+	}, frontend.RouteDoc{
+		Summary: "Describe the current caller",
+		Tags:    []string{"auth"},
+		Output:  MeOutput{},
+	}))
+}
+
+// handleLogin validates an API token and issues a session cookie carrying its scopes
+func handleLogin(w http.ResponseWriter, r *http.Request, a *auth.Auth) {
+	if a.Sessions() == nil || a.Tokens() == nil {
+		httpapi.WriteError(w, r, httpapi.NotFound("session login is not enabled"))
+		return
+	}
+
+	input, err := httpapi.Decode[LoginInput](w, r)
+	if err != nil {
+		httpapi.WriteError(w, r, err)
+		return
+	}
+
+	token, ok := a.Tokens().Lookup(input.Token)
+	if input.Token == "" || !ok {
+		log.Printf("🔒 Failed login from %s", r.RemoteAddr)
+		httpapi.WriteError(w, r, httpapi.Unauthorized("invalid token"))
+		return
+	}
+
+	identity := &auth.Identity{Subject: token.Name, Method: auth.MethodSession, Scopes: token.Scopes}
+	expires, err := a.Sessions().Issue(w, identity)
+	if err != nil {
+		httpapi.WriteError(w, r, httpapi.Internal(fmt.Errorf("failed to create session: %w", err)))
+		return
+	}
+
+	log.Printf("🔑 Session started for %s", token.Name)
+	httpapi.Encode(w, r, http.StatusOK, LoginOutput{Subject: token.Name, Scopes: token.Scopes, ExpiresAt: expires})
+}
+
+// handleMe reports who the caller is, so the SPA can decide whether to show the login form
+func handleMe(w http.ResponseWriter, r *http.Request, a *auth.Auth) {
+	output := MeOutput{AuthEnabled: a.Enabled()}
+	if id, err := a.Authenticate(r); err == nil {
+		output.Authenticated = true
+		output.Identity = id
+	}
+
+	httpapi.Encode(w, r, http.StatusOK, output)
+}
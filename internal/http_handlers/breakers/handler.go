@@ -0,0 +1,41 @@
+// Package breakers provides an HTTP endpoint reporting circuit breaker state.
+package breakers
+
+import (
+	"net/http"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/circuitbreaker"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+)
+
+// Output defines the response body structure
+type Output struct {
+	Status   string                  `json:"status"` // "ok", or "degraded" when a breaker is open
+	Breakers []circuitbreaker.Status `json:"breakers"`
+}
+
+// Register registers the breaker health endpoint.
+// Call this with your router's /api group to expose GET /api/health/breakers
+func Register(mux frontend.Registrar, registry *circuitbreaker.Registry) {
+	mux.Handle("GET /health/breakers", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		handleBreakers(w, r, registry)
+	}, frontend.RouteDoc{
+		Summary: "Circuit breaker state for external dependencies",
+		Tags:    []string{"health"},
+		Output:  Output{},
+		Errors:  []int{http.StatusUnauthorized, http.StatusForbidden},
+	}))
+}
+
+// handleBreakers reports every breaker's state.
+// An open breaker is reported as degraded rather than failing the request,
+// since the worker itself is still up.
+func handleBreakers(w http.ResponseWriter, r *http.Request, registry *circuitbreaker.Registry) {
+	output := Output{Status: "ok", Breakers: registry.Statuses()}
+	if !registry.Healthy() {
+		output.Status = "degraded"
+	}
+
+	httpapi.Encode(w, r, http.StatusOK, output)
+}
@@ -0,0 +1,75 @@
+// Package functions lets HTTP clients call worker functions without the Dibbla
+// platform, e.g. in offline mode (WORKER_MODE=offline). Calls run in this
+// process through the functions the worker registered, and the RBAC policy
+// decides who may invoke each one.
+package functions
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/rbac"
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
+)
+
+// ListOutput defines the GET /api/functions response body
+type ListOutput struct {
+	Functions []string `json:"functions"` // Registered functions, sorted
+}
+
+// Register exposes the registered functions when called with the /api group:
+//
+//	GET  /api/functions               names of the registered functions
+//	POST /api/functions/{name}/invoke run a function with the body as input
+func Register(mux frontend.Registrar, invoker workerfunctions.Invoker, policy *rbac.Enforcer) {
+	mux.Handle("GET /functions", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpapi.Encode(w, r, http.StatusOK, ListOutput{Functions: invoker.Functions()})
+	}, frontend.RouteDoc{
+		Summary: "Worker functions that can be invoked over HTTP",
+		Tags:    []string{"functions"},
+		Output:  ListOutput{},
+		Errors:  []int{http.StatusUnauthorized, http.StatusForbidden},
+	}))
+	mux.Handle("POST /functions/{name}/invoke", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		handleInvoke(w, r, invoker, policy)
+	}, frontend.RouteDoc{
+		Summary:     "Run a worker function in this process",
+		Description: "The body is the function input and the response body is its output. Function errors are answered with problem+json.",
+		Tags:        []string{"functions"},
+		Input:       json.RawMessage{},
+		Output:      json.RawMessage{},
+		Errors:      []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound},
+	}))
+}
+
+// handleInvoke runs a function for the caller, if the policy lets them
+func handleInvoke(w http.ResponseWriter, r *http.Request, invoker workerfunctions.Invoker, policy *rbac.Enforcer) {
+	name := r.PathValue("name")
+	if !slices.Contains(invoker.Functions(), name) {
+		httpapi.WriteError(w, r, httpapi.NotFound("unknown function: %s", name))
+		return
+	}
+	if err := policy.Authorize(r.Context(), name); err != nil {
+		httpapi.WriteError(w, r, err)
+		return
+	}
+
+	input, err := httpapi.Decode[json.RawMessage](w, r)
+	if err != nil {
+		httpapi.WriteError(w, r, err)
+		return
+	}
+	output, err := invoker.Invoke(name, input)
+	switch {
+	case errors.Is(err, workerfunctions.ErrInvalidInput):
+		httpapi.WriteError(w, r, httpapi.BadRequest("%v", err))
+	case err != nil:
+		httpapi.WriteError(w, r, err) // status from httpapi.StatusFor
+	default:
+		httpapi.Encode(w, r, http.StatusOK, json.RawMessage(output))
+	}
+}
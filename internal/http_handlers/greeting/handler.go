@@ -3,9 +3,11 @@
 package greeting
 
 import (
-	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
 )
 
 // Input defines the request body structure
@@ -13,38 +15,39 @@ type Input struct {
 	Name string `json:"name"`
 }
 
+// Validate checks the request fields (called by httpapi.Decode)
+func (in Input) Validate() error {
+	var errs httpapi.FieldErrors
+	if in.Name == "" {
+		errs.Add("name", "is required")
+	}
+	return errs.Err()
+}
+
 // Output defines the response body structure
 type Output struct {
 	Message string `json:"message"`
 }
 
 // Register registers the greeting HTTP endpoint.
-// Call this with your router's mux to expose POST /api/greeting
-func Register(mux *http.ServeMux) {
-	mux.HandleFunc("POST /api/greeting", handleGreeting)
+// Call this with your router's /api group to expose POST /api/greeting
+func Register(mux frontend.Registrar) {
+	mux.Handle("POST /greeting", frontend.DescribeFunc(handleGreeting, frontend.RouteDoc{
+		Summary: "Greet someone by name",
+		Tags:    []string{"greeting"},
+		Input:   Input{},
+		Output:  Output{},
+		Errors:  []int{http.StatusUnauthorized, http.StatusForbidden},
+	}))
 }
 
-// handleGreeting processes greeting requests
+// handleGreeting processes greeting requests.
+// Invalid JSON, unknown fields and a missing name are answered with problem+json.
 func handleGreeting(w http.ResponseWriter, r *http.Request) {
-	// Set JSON content type
-	w.Header().Set("Content-Type", "application/json")
-
-	// Parse request body
-	var input Input
-	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{
-			"error": "Invalid JSON: " + err.Error(),
-		})
-		return
-	}
-
-	// Validate input
-	if input.Name == "" {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(map[string]string{
-			"error": "name is required",
-		})
+	// Parse and validate request body
+	input, err := httpapi.Decode[Input](w, r)
+	if err != nil {
+		httpapi.WriteError(w, r, err)
 		return
 	}
 
@@ -54,6 +57,5 @@ func handleGreeting(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Send response
-	json.NewEncoder(w).Encode(output)
+	httpapi.Encode(w, r, http.StatusOK, output)
 }
-
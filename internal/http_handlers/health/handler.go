@@ -0,0 +1,48 @@
+// Package health provides the liveness and readiness endpoints used by Docker
+// and orchestrators.
+package health
+
+import (
+	"net/http"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/health"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+)
+
+// Register registers the probe endpoints. Call it with the router itself (not
+// the /api group) so probes need no credentials:
+//
+//	GET /healthz  liveness: the process is up (plus checks marked Liveness)
+//	GET /readyz   readiness: every registered check
+//
+// Both answer 200 for "ok" and "degraded" and 503 when a critical check fails.
+func Register(mux frontend.Registrar, registry *health.Registry) {
+	mux.Handle("GET /healthz", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, r, registry.Liveness(r.Context()))
+	}, frontend.RouteDoc{
+		Summary:     "Liveness probe",
+		Description: "Answers 503 with the same body when a critical liveness check fails.",
+		Tags:        []string{"health"},
+		Output:      health.Report{},
+	}))
+	mux.Handle("GET /readyz", frontend.DescribeFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, r, registry.Readiness(r.Context()))
+	}, frontend.RouteDoc{
+		Summary:     "Readiness probe with per-check detail",
+		Description: "Answers 503 with the same body when a critical check fails.",
+		Tags:        []string{"health"},
+		Output:      health.Report{},
+	}))
+}
+
+// writeReport sends the report; a failed report keeps its body so the
+// failing checks are visible.
+func writeReport(w http.ResponseWriter, r *http.Request, report health.Report) {
+	status := http.StatusOK
+	if report.Status == health.StatusFail {
+		status = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	httpapi.Encode(w, r, status, report)
+}
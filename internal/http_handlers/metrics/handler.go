@@ -0,0 +1,17 @@
+// Package metrics provides the Prometheus scrape endpoint.
+package metrics
+
+import (
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+)
+
+// Register registers GET /metrics. Call it with the router itself (not the /api
+// group) so scrapers need no credentials; keep the port off the public
+// internet, or put /metrics behind your ingress' auth.
+func Register(mux frontend.Registrar, registry *metrics.Registry) {
+	mux.Handle("GET /metrics", frontend.Describe(registry.Handler(), frontend.RouteDoc{
+		Summary: "Prometheus metrics (text exposition format)",
+		Tags:    []string{"health"},
+	}))
+}
@@ -0,0 +1,31 @@
+// Package openapi provides the API description endpoints.
+package openapi
+
+import (
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/openapi"
+)
+
+// Register registers the API description endpoints on your router's /api group:
+//
+//	GET /api/openapi.json  OpenAPI 3.1 document for every route registered on router
+//	GET /api/docs          API explorer page
+func Register(mux frontend.Registrar, router *frontend.Router, info openapi.Info) {
+	mux.Handle("GET /openapi.json", frontend.Describe(openapi.SpecHandler(router, info), frontend.RouteDoc{
+		Summary: "OpenAPI document for this API",
+		Tags:    []string{"meta"},
+	}))
+	mux.Handle("GET /docs", frontend.Describe(openapi.ExplorerHandler(), frontend.RouteDoc{
+		Summary: "API explorer page (HTML)",
+		Tags:    []string{"meta"},
+	}))
+}
+
+// DefaultInfo returns the document info used by the worker.
+func DefaultInfo(serverName string) openapi.Info {
+	return openapi.Info{
+		Title:       serverName + " API",
+		Version:     "1.0.0",
+		Description: "HTTP API of the " + serverName + " worker. Errors are RFC 9457 problem details.",
+	}
+}
@@ -0,0 +1,216 @@
+// Package httpapi provides the JSON conventions shared by HTTP handlers:
+// typed errors rendered as RFC 9457 problem details (application/problem+json),
+// request decoding with size limits and unknown-field rejection, and response
+// encoding.
+//
+//	func handleCreate(w http.ResponseWriter, r *http.Request) {
+//		input, err := httpapi.Decode[Input](w, r)
+//		if err != nil {
+//			httpapi.WriteError(w, r, err)
+//			return
+//		}
+//		httpapi.Encode(w, r, http.StatusCreated, output)
+//	}
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/circuitbreaker"
+)
+
+// Sentinel errors for worker functions and tasks that should map to a specific
+// HTTP status without depending on HTTP types. Wrap them with %w:
+//
+//	return fmt.Errorf("job %s: %w", id, httpapi.ErrNotFound)
+var (
+	ErrBadRequest   = errors.New("bad request")
+	ErrValidation   = errors.New("validation failed")
+	ErrUnauthorized = errors.New("unauthorized")
+	ErrForbidden    = errors.New("forbidden")
+	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
+	ErrUnavailable  = errors.New("service unavailable")
+)
+
+// sentinelStatus maps the sentinel errors to HTTP statuses, in match order.
+var sentinelStatus = []struct {
+	err    error
+	status int
+}{
+	{ErrValidation, http.StatusUnprocessableEntity},
+	{ErrBadRequest, http.StatusBadRequest},
+	{ErrUnauthorized, http.StatusUnauthorized},
+	{ErrForbidden, http.StatusForbidden},
+	{ErrNotFound, http.StatusNotFound},
+	{ErrConflict, http.StatusConflict},
+	{ErrUnavailable, http.StatusServiceUnavailable},
+}
+
+// FieldError describes one invalid request field.
+type FieldError struct {
+	Field   string `json:"field"`   // JSON field name or path, e.g. "items[2].id"
+	Message string `json:"message"` // e.g. "is required"
+}
+
+// Error is an error with an HTTP status, rendered as a problem detail.
+// Create it with the constructors below.
+type Error struct {
+	Status     int
+	Code       string        // Machine-readable code, e.g. "validation_failed"
+	Detail     string        // Client-facing explanation
+	Fields     []FieldError  // Invalid fields (validation errors)
+	RetryAfter time.Duration // Sent as Retry-After when > 0
+	Err        error         // Underlying cause, logged but never sent to clients
+}
+
+func (e *Error) Error() string {
+	msg := e.Detail
+	if msg == "" {
+		msg = http.StatusText(e.Status)
+	}
+	if e.Err != nil {
+		return msg + ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is makes errors.Is(err, ErrNotFound) work for an Error with status 404, and
+// likewise for the other sentinels.
+func (e *Error) Is(target error) bool {
+	for _, s := range sentinelStatus {
+		if target == s.err {
+			return e.Status == s.status
+		}
+	}
+	return false
+}
+
+// HTTPStatus returns the error's status.
+func (e *Error) HTTPStatus() int {
+	return e.Status
+}
+
+// BadRequest reports a malformed request (400).
+func BadRequest(format string, args ...any) *Error {
+	return &Error{Status: http.StatusBadRequest, Code: "bad_request", Detail: fmt.Sprintf(format, args...)}
+}
+
+// Validation reports invalid fields (422).
+func Validation(fields ...FieldError) *Error {
+	detail := "request validation failed"
+	if len(fields) == 1 {
+		detail = fields[0].Field + " " + fields[0].Message
+	}
+	return &Error{Status: http.StatusUnprocessableEntity, Code: "validation_failed", Detail: detail, Fields: fields}
+}
+
+// Unauthorized reports missing or invalid credentials (401).
+func Unauthorized(format string, args ...any) *Error {
+	return &Error{Status: http.StatusUnauthorized, Code: "unauthorized", Detail: fmt.Sprintf(format, args...)}
+}
+
+// Forbidden reports a caller without permission (403).
+func Forbidden(format string, args ...any) *Error {
+	return &Error{Status: http.StatusForbidden, Code: "forbidden", Detail: fmt.Sprintf(format, args...)}
+}
+
+// NotFound reports a missing resource (404).
+func NotFound(format string, args ...any) *Error {
+	return &Error{Status: http.StatusNotFound, Code: "not_found", Detail: fmt.Sprintf(format, args...)}
+}
+
+// Conflict reports a request that conflicts with current state (409).
+func Conflict(format string, args ...any) *Error {
+	return &Error{Status: http.StatusConflict, Code: "conflict", Detail: fmt.Sprintf(format, args...)}
+}
+
+// TooLarge reports a request body over limit bytes (413).
+func TooLarge(limit int64) *Error {
+	return &Error{Status: http.StatusRequestEntityTooLarge, Code: "body_too_large",
+		Detail: fmt.Sprintf("request body exceeds %d bytes", limit)}
+}
+
+// UnsupportedMediaType reports a request body that is not JSON (415).
+func UnsupportedMediaType(contentType string) *Error {
+	return &Error{Status: http.StatusUnsupportedMediaType, Code: "unsupported_media_type",
+		Detail: fmt.Sprintf("content type %q is not supported, send application/json", contentType)}
+}
+
+// Internal wraps an unexpected error (500). Its message is logged, not sent.
+func Internal(err error) *Error {
+	return &Error{Status: http.StatusInternalServerError, Code: "internal", Err: err}
+}
+
+// StatusFor maps any error, including worker function and task errors, to an
+// HTTP status:
+//
+//   - *Error and errors with an HTTPStatus() int method use their own status
+//   - the sentinel errors above map to 400/422/401/403/404/409/503
+//   - an open circuit breaker maps to 503, an expired deadline to 504
+//   - anything else is 500
+func StatusFor(err error) int {
+	var withStatus interface{ HTTPStatus() int }
+	if errors.As(err, &withStatus) {
+		return withStatus.HTTPStatus()
+	}
+	for _, s := range sentinelStatus {
+		if errors.Is(err, s.err) {
+			return s.status
+		}
+	}
+
+	var maxBytes *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytes):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, circuitbreaker.ErrOpen):
+		return http.StatusServiceUnavailable
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	}
+	return http.StatusInternalServerError
+}
+
+// asError converts any error into an *Error for rendering.
+func asError(err error) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	status := StatusFor(err)
+	e := &Error{Status: status, Code: codeFor(status), Err: err}
+
+	var maxBytes *http.MaxBytesError
+	var open *circuitbreaker.OpenError
+	switch {
+	case errors.As(err, &maxBytes):
+		return TooLarge(maxBytes.Limit)
+	case errors.As(err, &open):
+		e.Detail = "dependency " + open.Name + " is unavailable"
+		e.RetryAfter = open.RetryAfter
+	case status < 500:
+		// Client errors from sentinels or HTTPStatus() are meant for the caller
+		e.Detail = err.Error()
+		e.Err = nil
+	}
+	return e
+}
+
+// codeFor derives a code from a status, e.g. 404 -> "not_found".
+func codeFor(status int) string {
+	if status == http.StatusInternalServerError {
+		return "internal"
+	}
+	return strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+}
@@ -0,0 +1,169 @@
+package httpapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/circuitbreaker"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+)
+
+// statusError is a foreign error type that carries its own status.
+type statusError struct{}
+
+func (statusError) Error() string   { return "teapot" }
+func (statusError) HTTPStatus() int { return http.StatusTeapot }
+
+func TestStatusFor(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"api error", httpapi.Conflict("job %s is running", "j1"), http.StatusConflict},
+		{"wrapped api error", fmt.Errorf("handler: %w", httpapi.NotFound("no job")), http.StatusNotFound},
+		{"HTTPStatus method", fmt.Errorf("policy: %w", statusError{}), http.StatusTeapot},
+		{"bad request sentinel", fmt.Errorf("parse: %w", httpapi.ErrBadRequest), http.StatusBadRequest},
+		{"validation sentinel", fmt.Errorf("input: %w", httpapi.ErrValidation), http.StatusUnprocessableEntity},
+		{"unauthorized sentinel", httpapi.ErrUnauthorized, http.StatusUnauthorized},
+		{"forbidden sentinel", httpapi.ErrForbidden, http.StatusForbidden},
+		{"not found sentinel", fmt.Errorf("job j1: %w", httpapi.ErrNotFound), http.StatusNotFound},
+		{"conflict sentinel", httpapi.ErrConflict, http.StatusConflict},
+		{"unavailable sentinel", httpapi.ErrUnavailable, http.StatusServiceUnavailable},
+		{"body too large", fmt.Errorf("read: %w", &http.MaxBytesError{Limit: 10}), http.StatusRequestEntityTooLarge},
+		{"open breaker", fmt.Errorf("embed: %w", &circuitbreaker.OpenError{Name: "openai"}), http.StatusServiceUnavailable},
+		{"deadline", fmt.Errorf("llm: %w", context.DeadlineExceeded), http.StatusGatewayTimeout},
+		{"unknown", errors.New("boom"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := httpapi.StatusFor(tt.err); got != tt.want {
+				t.Errorf("StatusFor(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorMatchesSentinels(t *testing.T) {
+	err := fmt.Errorf("lookup: %w", httpapi.NotFound("no job %s", "j1"))
+	if !errors.Is(err, httpapi.ErrNotFound) {
+		t.Error("NotFound does not match ErrNotFound")
+	}
+	if errors.Is(err, httpapi.ErrConflict) {
+		t.Error("NotFound matches ErrConflict")
+	}
+	if !errors.Is(httpapi.Validation(httpapi.FieldError{Field: "name", Message: "is required"}), httpapi.ErrValidation) {
+		t.Error("Validation does not match ErrValidation")
+	}
+}
+
+// writeError runs WriteError for err and decodes the problem response.
+func writeError(t *testing.T, err error) (*httptest.ResponseRecorder, httpapi.Problem) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	rec.Header().Set("X-Request-ID", "req-1")
+	httpapi.WriteError(rec, httptest.NewRequest(http.MethodPost, "/api/jobs", nil), err)
+
+	if ct := rec.Header().Get("Content-Type"); ct != httpapi.ContentTypeProblem {
+		t.Errorf("Content-Type = %q, want %s", ct, httpapi.ContentTypeProblem)
+	}
+	var p httpapi.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+		t.Fatalf("invalid problem body %q: %v", rec.Body, err)
+	}
+	if p.Status != rec.Code || p.Type != "about:blank" || p.Instance != "/api/jobs" || p.RequestID != "req-1" {
+		t.Errorf("problem = %+v (status %d)", p, rec.Code)
+	}
+	return rec, p
+}
+
+func TestWriteError(t *testing.T) {
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+		wantDetail string
+	}{
+		{
+			name:       "validation",
+			err:        httpapi.Validation(httpapi.FieldError{Field: "name", Message: "is required"}),
+			wantStatus: http.StatusUnprocessableEntity,
+			wantCode:   "validation_failed",
+			wantDetail: "name is required",
+		},
+		{
+			name:       "sentinel detail is sent to the client",
+			err:        fmt.Errorf("job j1: %w", httpapi.ErrNotFound),
+			wantStatus: http.StatusNotFound,
+			wantCode:   "not_found",
+			wantDetail: "job j1: not found",
+		},
+		{
+			name:       "internal cause is hidden",
+			err:        fmt.Errorf("query failed: password=hunter2"),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   "internal",
+			wantDetail: "internal server error",
+		},
+		{
+			name:       "wrapped internal error is hidden",
+			err:        httpapi.Internal(errors.New("db down")),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   "internal",
+			wantDetail: "internal server error",
+		},
+		{
+			name:       "deadline",
+			err:        context.DeadlineExceeded,
+			wantStatus: http.StatusGatewayTimeout,
+			wantCode:   "gateway_timeout",
+			wantDetail: "internal server error",
+		},
+		{
+			name:       "max bytes",
+			err:        &http.MaxBytesError{Limit: 64},
+			wantStatus: http.StatusRequestEntityTooLarge,
+			wantCode:   "body_too_large",
+			wantDetail: "request body exceeds 64 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec, p := writeError(t, tt.err)
+			if rec.Code != tt.wantStatus || p.Code != tt.wantCode || p.Detail != tt.wantDetail {
+				t.Errorf("got %d %+v, want %d code %q detail %q", rec.Code, p, tt.wantStatus, tt.wantCode, tt.wantDetail)
+			}
+			if strings.Contains(rec.Body.String(), "hunter2") || strings.Contains(rec.Body.String(), "db down") {
+				t.Errorf("internal error leaked: %s", rec.Body)
+			}
+		})
+	}
+}
+
+func TestWriteErrorOpenBreaker(t *testing.T) {
+	err := fmt.Errorf("embed: %w", &circuitbreaker.OpenError{Name: "openai", State: circuitbreaker.Open, RetryAfter: 1500 * time.Millisecond})
+	log.SetOutput(io.Discard)
+	t.Cleanup(func() { log.SetOutput(os.Stderr) })
+
+	rec, p := writeError(t, err)
+	if rec.Code != http.StatusServiceUnavailable || p.Detail != "dependency openai is unavailable" {
+		t.Errorf("got %d %+v", rec.Code, p)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "2" {
+		t.Errorf("Retry-After = %q, want 2 (rounded up)", got)
+	}
+}
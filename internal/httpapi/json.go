@@ -0,0 +1,148 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxBodyBytes limits request bodies read by Decode (1 MiB). A smaller
+// limit set earlier, e.g. by middleware.BodyLimit, still applies.
+const DefaultMaxBodyBytes = 1 << 20
+
+// Validator is implemented by request types that check their own fields.
+// Decode calls Validate after a successful decode.
+type Validator interface {
+	Validate() error
+}
+
+// FieldErrors collects field problems during validation:
+//
+//	func (in Input) Validate() error {
+//		var errs httpapi.FieldErrors
+//		if in.Name == "" {
+//			errs.Add("name", "is required")
+//		}
+//		return errs.Err()
+//	}
+type FieldErrors []FieldError
+
+// Add records a problem with field.
+func (f *FieldErrors) Add(field, message string) {
+	*f = append(*f, FieldError{Field: field, Message: message})
+}
+
+// Err returns a validation error (422), or nil when nothing was added.
+func (f FieldErrors) Err() error {
+	if len(f) == 0 {
+		return nil
+	}
+	return Validation(f...)
+}
+
+// Decode reads a JSON request body into T with DefaultMaxBodyBytes.
+func Decode[T any](w http.ResponseWriter, r *http.Request) (T, error) {
+	return DecodeLimit[T](w, r, DefaultMaxBodyBytes)
+}
+
+// DecodeLimit reads a JSON request body of at most maxBytes into T. It rejects
+// non-JSON content types, unknown fields and trailing data, then runs
+// Validate when T implements Validator. Errors are *Error values ready for
+// WriteError.
+func DecodeLimit[T any](w http.ResponseWriter, r *http.Request, maxBytes int64) (T, error) {
+	var v T
+
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		mediaType, _, err := mime.ParseMediaType(ct)
+		if err != nil || (mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")) {
+			return v, UnsupportedMediaType(ct)
+		}
+	}
+
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&v); err != nil {
+		return v, decodeError(err)
+	}
+	// Read one more token so trailing garbage after the value is reported
+	if _, err := dec.Token(); err != io.EOF {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return v, TooLarge(maxBytesErr.Limit)
+		}
+		return v, BadRequest("request body must contain a single JSON value")
+	}
+
+	if validator, ok := any(v).(Validator); ok {
+		if err := validator.Validate(); err != nil {
+			var apiErr *Error
+			if errors.As(err, &apiErr) {
+				return v, apiErr
+			}
+			return v, &Error{Status: http.StatusUnprocessableEntity, Code: "validation_failed", Detail: err.Error()}
+		}
+	}
+	return v, nil
+}
+
+// decodeError turns encoding/json errors into client-facing errors.
+func decodeError(err error) *Error {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	var maxBytesErr *http.MaxBytesError
+
+	switch {
+	case errors.As(err, &maxBytesErr):
+		return TooLarge(maxBytesErr.Limit)
+	case errors.Is(err, io.EOF):
+		return BadRequest("request body is empty")
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return BadRequest("request body is truncated JSON")
+	case errors.As(err, &syntaxErr):
+		return BadRequest("invalid JSON at offset %d: %v", syntaxErr.Offset, err)
+	case errors.As(err, &typeErr):
+		field := typeErr.Field
+		if field == "" {
+			field = "(body)"
+		}
+		return Validation(FieldError{Field: field, Message: fmt.Sprintf("must be %s", typeErr.Type)})
+	case strings.HasPrefix(err.Error(), "json: unknown field "):
+		field := strings.Trim(strings.TrimPrefix(err.Error(), "json: unknown field "), `"`)
+		return Validation(FieldError{Field: field, Message: "is not a known field"})
+	}
+	return BadRequest("invalid JSON: %v", err)
+}
+
+// Encode writes v as JSON with status. Encoding errors are logged since the
+// status has already been sent.
+func Encode(w http.ResponseWriter, r *http.Request, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("⚠️  Failed to encode response for %s %s: %v", r.Method, r.URL.Path, err)
+	}
+}
+
+// Handler adapts a typed function to an HTTP handler: the body is decoded and
+// validated into In, and the result is encoded with 200. Errors, including
+// worker function errors, are written with WriteError.
+func Handler[In, Out any](fn func(r *http.Request, in In) (Out, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		input, err := Decode[In](w, r)
+		if err != nil {
+			WriteError(w, r, err)
+			return
+		}
+		output, err := fn(r, input)
+		if err != nil {
+			WriteError(w, r, err)
+			return
+		}
+		Encode(w, r, http.StatusOK, output)
+	}
+}
@@ -0,0 +1,138 @@
+package httpapi_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+)
+
+type greetingInput struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func (in greetingInput) Validate() error {
+	var errs httpapi.FieldErrors
+	if in.Name == "" {
+		errs.Add("name", "is required")
+	}
+	if in.Count < 0 {
+		errs.Add("count", "must not be negative")
+	}
+	return errs.Err()
+}
+
+func newRequest(contentType, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/api/greeting", strings.NewReader(body))
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+	return r
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        greetingInput
+		wantStatus  int // 0 when Decode succeeds
+		wantField   string
+	}{
+		{name: "valid", contentType: "application/json", body: `{"name":"Ada","count":2}`, want: greetingInput{Name: "Ada", Count: 2}},
+		{name: "json suffix with charset", contentType: "application/vnd.api+json; charset=utf-8", body: `{"name":"Ada"}`, want: greetingInput{Name: "Ada"}},
+		{name: "no content type", body: `{"name":"Ada"}`, want: greetingInput{Name: "Ada"}},
+		{name: "wrong content type", contentType: "text/plain", body: `{"name":"Ada"}`, wantStatus: http.StatusUnsupportedMediaType},
+		{name: "unknown field", body: `{"name":"Ada","nmae":"x"}`, wantStatus: http.StatusUnprocessableEntity, wantField: "nmae"},
+		{name: "wrong type", body: `{"name":"Ada","count":"two"}`, wantStatus: http.StatusUnprocessableEntity, wantField: "count"},
+		{name: "empty body", body: ``, wantStatus: http.StatusBadRequest},
+		{name: "truncated", body: `{"name":"Ada"`, wantStatus: http.StatusBadRequest},
+		{name: "syntax error", body: `{"name":Ada}`, wantStatus: http.StatusBadRequest},
+		{name: "trailing data", body: `{"name":"Ada"} {"name":"Bob"}`, wantStatus: http.StatusBadRequest},
+		{name: "validate", body: `{"count":-1}`, wantStatus: http.StatusUnprocessableEntity, wantField: "name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := httpapi.Decode[greetingInput](httptest.NewRecorder(), newRequest(tt.contentType, tt.body))
+			if tt.wantStatus == 0 {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != tt.want {
+					t.Errorf("decoded %+v, want %+v", got, tt.want)
+				}
+				return
+			}
+
+			var apiErr *httpapi.Error
+			if !errors.As(err, &apiErr) || apiErr.Status != tt.wantStatus {
+				t.Fatalf("err = %v, want a %d *httpapi.Error", err, tt.wantStatus)
+			}
+			if tt.wantField != "" && (len(apiErr.Fields) == 0 || apiErr.Fields[0].Field != tt.wantField) {
+				t.Errorf("fields = %+v, want %s first", apiErr.Fields, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestDecodeLimit(t *testing.T) {
+	body := `{"name":"` + strings.Repeat("a", 100) + `"}`
+	tests := map[string]int64{
+		"declared length": int64(len(body)),
+		"chunked":         -1,
+	}
+	for name, contentLength := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := newRequest("application/json", body)
+			r.ContentLength = contentLength
+			_, err := httpapi.DecodeLimit[greetingInput](httptest.NewRecorder(), r, 64)
+			if status := httpapi.StatusFor(err); status != http.StatusRequestEntityTooLarge {
+				t.Fatalf("err = %v (status %d), want 413", err, status)
+			}
+		})
+	}
+
+	// A body that fits exactly, followed by nothing, is accepted
+	exact := `{"name":"Ada"}`
+	if _, err := httpapi.DecodeLimit[greetingInput](httptest.NewRecorder(), newRequest("", exact), int64(len(exact))); err != nil {
+		t.Errorf("exact-size body: %v", err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	h := httpapi.Handler(func(r *http.Request, in greetingInput) (map[string]string, error) {
+		if in.Name == "Mallory" {
+			return nil, httpapi.Forbidden("not you")
+		}
+		return map[string]string{"message": "Hello, " + in.Name}, nil
+	})
+
+	tests := []struct {
+		body       string
+		wantStatus int
+		wantType   string
+	}{
+		{body: `{"name":"Ada"}`, wantStatus: http.StatusOK, wantType: "application/json"},
+		{body: `{"name":"Mallory"}`, wantStatus: http.StatusForbidden, wantType: httpapi.ContentTypeProblem},
+		{body: `{}`, wantStatus: http.StatusUnprocessableEntity, wantType: httpapi.ContentTypeProblem},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, newRequest("application/json", tt.body))
+		if rec.Code != tt.wantStatus || rec.Header().Get("Content-Type") != tt.wantType {
+			t.Errorf("%s: %d %s, want %d %s", tt.body, rec.Code, rec.Header().Get("Content-Type"), tt.wantStatus, tt.wantType)
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, newRequest("application/json", `{"name":"Ada"}`))
+	var out map[string]string
+	if err := json.Unmarshal(rec.Body.Bytes(), &out); err != nil || out["message"] != "Hello, Ada" {
+		t.Errorf("body = %s (%v)", rec.Body, err)
+	}
+}
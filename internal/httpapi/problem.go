@@ -0,0 +1,75 @@
+package httpapi
+
+import (
+	"encoding/json"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+)
+
+// ContentTypeProblem is the media type of problem detail responses.
+const ContentTypeProblem = "application/problem+json"
+
+// requestIDHeader is set on the response by middleware.RequestID before
+// handlers run; problems echo it so clients can quote it in bug reports.
+const requestIDHeader = "X-Request-ID"
+
+// Problem is an RFC 9457 problem detail. Type is always "about:blank", so
+// Title is the status text; Code, Errors and RequestID are extensions.
+type Problem struct {
+	Type      string       `json:"type"`
+	Title     string       `json:"title"`
+	Status    int          `json:"status"`
+	Detail    string       `json:"detail,omitempty"`
+	Instance  string       `json:"instance,omitempty"` // Request path
+	Code      string       `json:"code,omitempty"`
+	Errors    []FieldError `json:"errors,omitempty"`
+	RequestID string       `json:"request_id,omitempty"`
+}
+
+// Problem renders the error as a problem detail. 5xx errors without a Detail
+// get a generic one so internal messages never reach clients.
+func (e *Error) Problem() Problem {
+	p := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(e.Status),
+		Status: e.Status,
+		Detail: e.Detail,
+		Code:   e.Code,
+		Errors: e.Fields,
+	}
+	if p.Detail == "" && e.Status >= 500 {
+		p.Detail = "internal server error"
+	}
+	return p
+}
+
+// WriteError writes err as problem+json with the status from StatusFor.
+// Server errors are logged with their underlying cause.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) {
+	e := asError(err)
+	if e.Status >= 500 {
+		log.Printf("❌ %s %s failed [%s]: %v", r.Method, r.URL.Path, w.Header().Get(requestIDHeader), err)
+	}
+	if e.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(e.RetryAfter.Seconds()))))
+	}
+
+	p := e.Problem()
+	p.Instance = r.URL.Path
+	WriteProblem(w, p)
+}
+
+// WriteProblem writes a problem detail with its status.
+func WriteProblem(w http.ResponseWriter, p Problem) {
+	if p.RequestID == "" {
+		p.RequestID = w.Header().Get(requestIDHeader)
+	}
+	w.Header().Set("Content-Type", ContentTypeProblem)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(p.Status)
+	if err := json.NewEncoder(w).Encode(p); err != nil {
+		log.Printf("⚠️  Failed to encode problem response: %v", err)
+	}
+}
@@ -1,12 +1,17 @@
 package jobs
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+
 	"github.com/dibbla-agents/go-worker-starter-template/internal/jobs/tasks"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
 	"github.com/dibbla-agents/go-worker-starter-template/internal/state"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tracing"
 )
 
 // ExampleJob demonstrates the standard job pattern.
@@ -27,27 +32,53 @@ type ExampleJobResult struct {
 
 // Execute runs the job
 func (j *ExampleJob) Execute() *ExampleJobResult {
+	return j.ExecuteContext(context.Background())
+}
+
+// ExecuteContext runs the job as a child span of the span in ctx, with a span
+// per phase. It stops before processing when ctx is canceled.
+func (j *ExampleJob) ExecuteContext(ctx context.Context) *ExampleJobResult {
 	result := &ExampleJobResult{
 		Success: false,
 	}
 
 	start := time.Now()
+	ctx, run := DefaultTracker.Start(ctx, j.JobType, "", j.ItemCount)
+	ctx, span := tracing.Start(ctx, "job "+j.JobType, attribute.Int("job.item_count", j.ItemCount))
+	defer func() {
+		run.Finish(result.Error)
+		metrics.ObserveJob(j.JobType, result.ExecutionTime, result.Error)
+		span.SetAttributes(attribute.Int("job.items_processed", result.ItemsProcessed))
+		tracing.End(span, result.Error)
+	}()
 
 	log.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 	log.Printf("🚀 Starting %s Job", j.JobType)
 	log.Println("━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
 
 	// Phase 1: Validate inputs
-	if err := j.validate(); err != nil {
+	_, phase := tracing.Start(ctx, "validate")
+	err := j.validate()
+	tracing.End(phase, err)
+	if err != nil {
 		result.Error = fmt.Errorf("validation failed: %w", err)
 		result.ExecutionTime = time.Since(start)
 		j.logError(result)
 		return result
 	}
 
+	if err := ctx.Err(); err != nil {
+		result.Error = fmt.Errorf("canceled before processing: %w", err)
+		result.ExecutionTime = time.Since(start)
+		j.logError(result)
+		return result
+	}
+
 	// Phase 2: Execute main logic (using tasks)
 	log.Println("📋 Processing items...")
-	processed, err := j.process()
+	phaseCtx, phase := tracing.Start(ctx, "process")
+	processed, err := j.process(phaseCtx)
+	tracing.End(phase, err)
 	if err != nil {
 		result.Error = fmt.Errorf("processing failed: %w", err)
 		result.ExecutionTime = time.Since(start)
@@ -56,6 +87,7 @@ func (j *ExampleJob) Execute() *ExampleJobResult {
 	}
 
 	result.ItemsProcessed = processed
+	run.Progress(processed)
 	log.Printf("   ✓ Processed %d items", processed)
 
 	// Job completed successfully
@@ -75,14 +107,14 @@ func (j *ExampleJob) validate() error {
 }
 
 // process implements the main job logic
-func (j *ExampleJob) process() (int, error) {
+func (j *ExampleJob) process(ctx context.Context) (int, error) {
 	// Example 1: Simple inline logic
 	// time.Sleep(100 * time.Millisecond)
 	// return j.ItemCount, nil
 
 	// Example 2: Using a task (recommended for complex operations)
 	task := tasks.NewExampleTask(j.AGS, j.ItemCount)
-	result, err := task.Execute()
+	result, err := task.ExecuteContext(ctx)
 	if err != nil {
 		return 0, fmt.Errorf("task execution failed: %w", err)
 	}
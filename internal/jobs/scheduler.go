@@ -0,0 +1,182 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// Schedule runs a function at a fixed interval, e.g. a job's ExecuteContext:
+//
+//	jobs.Schedule{
+//	    Name:  "nightly_cleanup",
+//	    Every: 24 * time.Hour,
+//	    Run: func(ctx context.Context) error {
+//	        return jobs.NewSimpleJob("cleanup", 100).ExecuteContext(ctx).Error
+//	    },
+//	}
+type Schedule struct {
+	Name       string
+	Every      time.Duration
+	RunOnStart bool // Run once when the scheduler starts instead of after the first interval
+	Run        func(ctx context.Context) error
+}
+
+// ScheduleStatus is a snapshot of a schedule for the admin API and dashboard.
+type ScheduleStatus struct {
+	Name            string     `json:"name"`
+	IntervalSeconds float64    `json:"interval_seconds"`
+	Running         bool       `json:"running"`
+	Runs            int        `json:"runs"`               // Completed runs since startup
+	Skipped         int        `json:"skipped"`            // Ticks skipped because the previous run was still going
+	NextRun         *time.Time `json:"next_run,omitempty"` // Unset until the scheduler starts
+	LastRun         *time.Time `json:"last_run,omitempty"`
+	LastError       string     `json:"last_error,omitempty"` // Error of the last run, if it failed
+}
+
+// Scheduler runs schedules in the background. A run that is still going when
+// the next one is due makes the scheduler skip that tick, so runs of one
+// schedule never overlap. Jobs started by a run are tracked (and queued) by
+// their Tracker as usual.
+type Scheduler struct {
+	mu        sync.Mutex
+	schedules []*scheduled
+	started   bool
+}
+
+// scheduled is a schedule with its run state.
+type scheduled struct {
+	Schedule
+	status ScheduleStatus
+}
+
+// DefaultScheduler runs the schedules listed in cmd/worker.
+var DefaultScheduler = NewScheduler()
+
+// NewScheduler creates a scheduler without schedules.
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// Add registers a schedule. Schedules must be added before Start.
+func (s *Scheduler) Add(schedule Schedule) error {
+	if schedule.Name == "" {
+		return fmt.Errorf("schedule name is required")
+	}
+	if schedule.Every <= 0 {
+		return fmt.Errorf("schedule %s: interval must be positive", schedule.Name)
+	}
+	if schedule.Run == nil {
+		return fmt.Errorf("schedule %s: Run is required", schedule.Name)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return fmt.Errorf("schedule %s: scheduler already started", schedule.Name)
+	}
+	for _, existing := range s.schedules {
+		if existing.Name == schedule.Name {
+			return fmt.Errorf("duplicate schedule %s", schedule.Name)
+		}
+	}
+	s.schedules = append(s.schedules, &scheduled{
+		Schedule: schedule,
+		status:   ScheduleStatus{Name: schedule.Name, IntervalSeconds: schedule.Every.Seconds()},
+	})
+	return nil
+}
+
+// Start runs every schedule in its own goroutine until ctx is canceled.
+// Calls after the first do nothing.
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return
+	}
+	s.started = true
+	for _, sch := range s.schedules {
+		go s.loop(ctx, sch)
+	}
+}
+
+// Schedules returns the status of every schedule, in the order they were added.
+func (s *Scheduler) Schedules() []ScheduleStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	statuses := make([]ScheduleStatus, len(s.schedules))
+	for i, sch := range s.schedules {
+		statuses[i] = sch.status
+	}
+	return statuses
+}
+
+// loop ticks every sch.Every and starts a run unless one is still going.
+func (s *Scheduler) loop(ctx context.Context, sch *scheduled) {
+	ticker := time.NewTicker(sch.Every)
+	defer ticker.Stop()
+
+	s.setNextRun(sch, time.Now().Add(sch.Every))
+	if sch.RunOnStart {
+		s.tick(ctx, sch)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.setNextRun(sch, time.Now().Add(sch.Every))
+			s.tick(ctx, sch)
+		}
+	}
+}
+
+// tick starts a run in the background, or skips it if the last one is still going.
+func (s *Scheduler) tick(ctx context.Context, sch *scheduled) {
+	s.mu.Lock()
+	if sch.status.Running {
+		sch.status.Skipped++
+		s.mu.Unlock()
+		log.Printf("⏭️  Schedule %s skipped: previous run still going", sch.Name)
+		return
+	}
+	sch.status.Running = true
+	s.mu.Unlock()
+
+	go func() {
+		start := time.Now()
+		err := s.run(ctx, sch)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		sch.status.Running = false
+		sch.status.Runs++
+		sch.status.LastRun = &start
+		sch.status.LastError = ""
+		if err != nil {
+			sch.status.LastError = err.Error()
+			log.Printf("❌ Schedule %s failed: %v", sch.Name, err)
+		}
+	}()
+}
+
+// run calls the schedule's function, turning a panic into an error so one bad
+// run doesn't stop the worker.
+func (s *Scheduler) run(ctx context.Context, sch *scheduled) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return sch.Run(ctx)
+}
+
+// setNextRun records when the schedule is next due.
+func (s *Scheduler) setNextRun(sch *scheduled, next time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sch.status.NextRun = &next
+}
@@ -0,0 +1,114 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// waitForSchedule polls until cond holds for the named schedule's status.
+func waitForSchedule(t *testing.T, s *Scheduler, name string, cond func(ScheduleStatus) bool) ScheduleStatus {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, status := range s.Schedules() {
+			if status.Name == name && cond(status) {
+				return status
+			}
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("schedule %s never reached the expected state: %+v", name, s.Schedules())
+	return ScheduleStatus{}
+}
+
+func TestSchedulerRunsAndRecordsErrors(t *testing.T) {
+	s := NewScheduler()
+	calls := 0
+	err := s.Add(Schedule{Name: "flaky", Every: 5 * time.Millisecond, Run: func(ctx context.Context) error {
+		calls++ // Runs of one schedule never overlap
+		if calls == 1 {
+			return errors.New("first run fails")
+		}
+		return nil
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	failed := waitForSchedule(t, s, "flaky", func(st ScheduleStatus) bool { return st.Runs == 1 && !st.Running })
+	if failed.LastError != "first run fails" || failed.LastRun == nil || failed.NextRun == nil {
+		t.Errorf("after the first run: %+v", failed)
+	}
+	waitForSchedule(t, s, "flaky", func(st ScheduleStatus) bool { return st.Runs >= 2 && st.LastError == "" })
+
+	if err := s.Add(Schedule{Name: "late", Every: time.Second, Run: func(context.Context) error { return nil }}); err == nil {
+		t.Error("expected an error adding a schedule after Start")
+	}
+}
+
+func TestSchedulerSkipsOverlappingRuns(t *testing.T) {
+	s := NewScheduler()
+	release := make(chan struct{})
+	err := s.Add(Schedule{Name: "slow", Every: 2 * time.Millisecond, RunOnStart: true, Run: func(ctx context.Context) error {
+		<-release
+		return nil
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	waitForSchedule(t, s, "slow", func(st ScheduleStatus) bool { return st.Running && st.Skipped >= 2 })
+	close(release)
+	waitForSchedule(t, s, "slow", func(st ScheduleStatus) bool { return st.Runs >= 1 })
+}
+
+func TestSchedulerRecoversPanics(t *testing.T) {
+	s := NewScheduler()
+	err := s.Add(Schedule{Name: "panics", Every: time.Hour, RunOnStart: true, Run: func(context.Context) error {
+		panic("bad run")
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	status := waitForSchedule(t, s, "panics", func(st ScheduleStatus) bool { return st.Runs == 1 })
+	if status.LastError != "panic: bad run" {
+		t.Errorf("last error = %q", status.LastError)
+	}
+}
+
+func TestSchedulerAddValidates(t *testing.T) {
+	run := func(context.Context) error { return nil }
+	s := NewScheduler()
+	if err := s.Add(Schedule{Name: "ok", Every: time.Minute, Run: run}); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, schedule := range map[string]Schedule{
+		"no name":       {Every: time.Minute, Run: run},
+		"zero interval": {Name: "zero", Run: run},
+		"no func":       {Name: "nofunc", Every: time.Minute},
+		"duplicate":     {Name: "ok", Every: time.Minute, Run: run},
+	} {
+		if err := s.Add(schedule); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if n := len(s.Schedules()); n != 1 {
+		t.Errorf("%d schedules, want 1", n)
+	}
+}
@@ -5,9 +5,15 @@
 package jobs
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tracing"
 )
 
 // SimpleJob demonstrates a minimal job structure.
@@ -35,8 +41,23 @@ func NewSimpleJob(name string, count int) *SimpleJob {
 
 // Execute runs the job and returns the result.
 func (j *SimpleJob) Execute() *SimpleJobResult {
+	return j.ExecuteContext(context.Background())
+}
+
+// ExecuteContext runs the job as a child span of the span in ctx. It stops
+// early when ctx is canceled (e.g. from the dashboard).
+func (j *SimpleJob) ExecuteContext(ctx context.Context) *SimpleJobResult {
 	start := time.Now()
 	result := &SimpleJobResult{}
+	ctx, run := DefaultTracker.Start(ctx, "simple", j.Name, j.Count)
+	// Labelled by job type, not Name, which varies per batch
+	ctx, span := tracing.Start(ctx, "job simple", attribute.String("job.name", j.Name))
+	defer func() {
+		run.Finish(result.Error)
+		metrics.ObserveJob("simple", result.Duration, result.Error)
+		span.SetAttributes(attribute.Int("job.items_processed", result.ItemsProcessed))
+		tracing.End(span, result.Error)
+	}()
 
 	log.Printf("🚀 Starting job: %s", j.Name)
 
@@ -50,11 +71,21 @@ func (j *SimpleJob) Execute() *SimpleJobResult {
 
 	// Step 2: Process
 	log.Printf("   Processing %d items...", j.Count)
+	_, phase := tracing.Start(ctx, "process")
 	for i := 0; i < j.Count; i++ {
+		if err := ctx.Err(); err != nil {
+			result.Error = fmt.Errorf("stopped after %d items: %w", result.ItemsProcessed, err)
+			result.Duration = time.Since(start)
+			tracing.End(phase, result.Error)
+			log.Printf("❌ Job failed: %v", result.Error)
+			return result
+		}
 		// Simulate work
 		time.Sleep(10 * time.Millisecond)
 		result.ItemsProcessed++
+		run.Progress(result.ItemsProcessed)
 	}
+	phase.End()
 
 	// Success
 	result.Success = true
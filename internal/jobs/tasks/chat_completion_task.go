@@ -0,0 +1,179 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sashabaranov/go-openai"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/embeddings"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/ratelimit"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/state"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tracing"
+)
+
+// ChatCompletionTask renders a versioned prompt template and sends it to a chat model.
+// The result records the prompt version, token usage and latency so runs can be
+// compared when prompts change.
+type ChatCompletionTask struct {
+	AGS     *state.AsyncGlobalState
+	Prompt  string      // Template name in AGS.Prompts (e.g. "summarize")
+	Version string      // Template version (empty = latest)
+	Inputs  interface{} // Data passed to the template
+
+	Model       string        // Chat model (empty = AGS.ChatModel)
+	Temperature float32       // Sampling temperature (0 = model default)
+	MaxTokens   int           // Completion token limit (0 = model default)
+	Tools       []openai.Tool // Optional function-calling tools
+	Timeout     time.Duration // API call timeout (default 60s)
+}
+
+// ChatCompletionTaskResult contains the model reply and run metadata
+type ChatCompletionTaskResult struct {
+	Content      string
+	ToolCalls    []openai.ToolCall
+	FinishReason string
+
+	Model         string
+	PromptName    string
+	PromptVersion string
+	Usage         openai.Usage
+	Latency       time.Duration // Time spent in the API call
+	ExecutionTime time.Duration // Total task time including rendering
+}
+
+// Execute renders the prompt and calls the chat model
+func (t *ChatCompletionTask) Execute() (*ChatCompletionTaskResult, error) {
+	return t.ExecuteContext(context.Background())
+}
+
+// ExecuteContext is Execute as a child span of the span in ctx
+func (t *ChatCompletionTask) ExecuteContext(ctx context.Context) (result *ChatCompletionTaskResult, err error) {
+	start := time.Now()
+	ctx, span := tracing.Start(ctx, "task chat_completion")
+	defer func() {
+		metrics.ObserveTask("chat_completion", time.Since(start), err)
+		tracing.End(span, err)
+	}()
+
+	// 1. Validate inputs
+	if err := t.validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// 2. Render the prompt template
+	prompt, err := t.AGS.Prompts.Get(t.Prompt, t.Version)
+	if err != nil {
+		return nil, fmt.Errorf("prompt lookup failed: %w", err)
+	}
+	rendered, err := prompt.Render(t.Inputs)
+	if err != nil {
+		return nil, fmt.Errorf("prompt rendering failed: %w", err)
+	}
+
+	messages := make([]openai.ChatCompletionMessage, 0, 2)
+	if rendered.System != "" {
+		messages = append(messages, openai.ChatCompletionMessage{Role: openai.ChatMessageRoleSystem, Content: rendered.System})
+	}
+	messages = append(messages, openai.ChatCompletionMessage{Role: openai.ChatMessageRoleUser, Content: rendered.User})
+
+	model := t.Model
+	if model == "" {
+		model = t.AGS.ChatModel
+	}
+	timeout := t.Timeout
+	if timeout <= 0 {
+		timeout = 60 * time.Second
+	}
+
+	// 3. Call the chat model
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Charge the model's rate limit budget (prompt estimate plus the completion cap)
+	var limiter *ratelimit.Limiter
+	estimated := embeddings.EstimateTokens(rendered.System+rendered.User) + t.MaxTokens
+	if t.AGS.RateLimits != nil {
+		limiter = t.AGS.RateLimits.Limiter("openai", model)
+		ctx = ratelimit.WithLimiter(ratelimit.WithTokens(ctx, estimated), limiter)
+	}
+
+	callStart := time.Now()
+	resp, err := t.AGS.ChatClient.CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model:               model,
+		Messages:            messages,
+		Temperature:         t.Temperature,
+		MaxCompletionTokens: t.MaxTokens,
+		Tools:               t.Tools,
+	})
+	latency := time.Since(callStart)
+	if err != nil {
+		return nil, fmt.Errorf("chat completion failed (prompt %s/%s): %w", prompt.Name, prompt.Version, err)
+	}
+	limiter.Report(estimated, resp.Usage.TotalTokens)
+	span.SetAttributes(
+		attribute.String("gen_ai.request.model", model),
+		attribute.String("prompt", prompt.Name+"/"+prompt.Version),
+		attribute.Int("gen_ai.usage.input_tokens", resp.Usage.PromptTokens),
+		attribute.Int("gen_ai.usage.output_tokens", resp.Usage.CompletionTokens),
+	)
+	if len(resp.Choices) == 0 {
+		return nil, fmt.Errorf("chat completion returned no choices")
+	}
+
+	// 4. Return results
+	choice := resp.Choices[0]
+	return &ChatCompletionTaskResult{
+		Content:       choice.Message.Content,
+		ToolCalls:     choice.Message.ToolCalls,
+		FinishReason:  string(choice.FinishReason),
+		Model:         resp.Model,
+		PromptName:    prompt.Name,
+		PromptVersion: prompt.Version,
+		Usage:         resp.Usage,
+		Latency:       latency,
+		ExecutionTime: time.Since(start),
+	}, nil
+}
+
+// validate checks task preconditions
+func (t *ChatCompletionTask) validate() error {
+	if t.AGS == nil || t.AGS.ChatClient == nil {
+		return fmt.Errorf("chat client is not initialized (set OPENAI_API_KEY)")
+	}
+	if t.AGS.Prompts == nil {
+		return fmt.Errorf("prompt library is not initialized")
+	}
+	if t.Prompt == "" {
+		return fmt.Errorf("prompt name is required")
+	}
+	if t.Temperature < 0 || t.Temperature > 2 {
+		return fmt.Errorf("temperature must be between 0 and 2")
+	}
+	if t.MaxTokens < 0 {
+		return fmt.Errorf("max tokens cannot be negative")
+	}
+	return nil
+}
+
+// NewChatCompletionTask creates a task for the latest version of a prompt
+func NewChatCompletionTask(ags *state.AsyncGlobalState, prompt string, inputs interface{}) *ChatCompletionTask {
+	return &ChatCompletionTask{
+		AGS:    ags,
+		Prompt: prompt,
+		Inputs: inputs,
+	}
+}
+
+// NewChatCompletionTaskWithVersion creates a task pinned to a prompt version
+func NewChatCompletionTaskWithVersion(ags *state.AsyncGlobalState, prompt, version string, inputs interface{}) *ChatCompletionTask {
+	return &ChatCompletionTask{
+		AGS:     ags,
+		Prompt:  prompt,
+		Version: version,
+		Inputs:  inputs,
+	}
+}
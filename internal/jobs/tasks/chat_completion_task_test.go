@@ -0,0 +1,195 @@
+package tasks
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sashabaranov/go-openai"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/prompts"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/state"
+)
+
+// fakeChatServer answers /v1/chat/completions with reply and records each request.
+func fakeChatServer(t *testing.T, status int, reply string) (*httptest.Server, *[]openai.ChatCompletionRequest) {
+	t.Helper()
+	var requests []openai.ChatCompletionRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			http.NotFound(w, r)
+			return
+		}
+		var req openai.ChatCompletionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("invalid request body: %v", err)
+		}
+		requests = append(requests, req)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"error": map[string]string{"message": reply, "type": "server_error"},
+			})
+			return
+		}
+		json.NewEncoder(w).Encode(openai.ChatCompletionResponse{
+			Model: req.Model,
+			Choices: []openai.ChatCompletionChoice{{
+				Message:      openai.ChatCompletionMessage{Role: openai.ChatMessageRoleAssistant, Content: reply},
+				FinishReason: openai.FinishReasonStop,
+			}},
+			Usage: openai.Usage{PromptTokens: 12, CompletionTokens: 5, TotalTokens: 17},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &requests
+}
+
+func newChatState(t *testing.T, srv *httptest.Server) *state.AsyncGlobalState {
+	t.Helper()
+	lib := prompts.NewLibrary()
+	for version, content := range map[string]string{
+		"v1": `{{define "system"}}Be brief.{{end}}{{define "user"}}Summarize: {{.Text}}{{end}}`,
+		"v2": `Summarize in {{.MaxSentences}} sentences: {{.Text}}`,
+	} {
+		p, err := prompts.Parse("summarize", version, content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lib.Add(p)
+	}
+
+	cfg := openai.DefaultConfig("test-key")
+	cfg.BaseURL = srv.URL + "/v1"
+	return &state.AsyncGlobalState{
+		ChatClient: openai.NewClientWithConfig(cfg),
+		ChatModel:  "gpt-test",
+		Prompts:    lib,
+	}
+}
+
+func TestChatCompletionTaskPinnedVersion(t *testing.T) {
+	srv, requests := fakeChatServer(t, http.StatusOK, "A short summary.")
+	ags := newChatState(t, srv)
+
+	task := NewChatCompletionTaskWithVersion(ags, "summarize", "v1", map[string]string{"Text": "Long text."})
+	task.Temperature = 0.2
+	task.MaxTokens = 50
+	result, err := task.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result.Content != "A short summary." || result.FinishReason != "stop" {
+		t.Errorf("result = %+v", result)
+	}
+	if result.PromptName != "summarize" || result.PromptVersion != "v1" || result.Model != "gpt-test" {
+		t.Errorf("result records %s/%s on %s", result.PromptName, result.PromptVersion, result.Model)
+	}
+	if result.Usage.TotalTokens != 17 {
+		t.Errorf("usage = %+v", result.Usage)
+	}
+
+	if len(*requests) != 1 {
+		t.Fatalf("server got %d requests, want 1", len(*requests))
+	}
+	req := (*requests)[0]
+	if req.Model != "gpt-test" || req.Temperature != 0.2 || req.MaxCompletionTokens != 50 {
+		t.Errorf("request = %+v", req)
+	}
+	if len(req.Messages) != 2 ||
+		req.Messages[0].Role != openai.ChatMessageRoleSystem || req.Messages[0].Content != "Be brief." ||
+		req.Messages[1].Role != openai.ChatMessageRoleUser || req.Messages[1].Content != "Summarize: Long text." {
+		t.Errorf("messages = %+v", req.Messages)
+	}
+}
+
+func TestChatCompletionTaskLatestVersion(t *testing.T) {
+	srv, requests := fakeChatServer(t, http.StatusOK, "Summary.")
+	ags := newChatState(t, srv)
+
+	task := NewChatCompletionTask(ags, "summarize", map[string]interface{}{"Text": "Long text.", "MaxSentences": 2})
+	task.Model = "gpt-override"
+	result, err := task.Execute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.PromptVersion != "v2" {
+		t.Errorf("prompt version = %s, want v2", result.PromptVersion)
+	}
+
+	req := (*requests)[0]
+	if req.Model != "gpt-override" {
+		t.Errorf("model = %s, want gpt-override", req.Model)
+	}
+	if len(req.Messages) != 1 || req.Messages[0].Content != "Summarize in 2 sentences: Long text." {
+		t.Errorf("messages = %+v", req.Messages)
+	}
+}
+
+func TestChatCompletionTaskErrors(t *testing.T) {
+	okServer, _ := fakeChatServer(t, http.StatusOK, "unused")
+	failServer, _ := fakeChatServer(t, http.StatusBadRequest, "model overloaded")
+
+	tests := []struct {
+		name    string
+		ags     *state.AsyncGlobalState
+		task    func(ags *state.AsyncGlobalState) *ChatCompletionTask
+		wantErr string
+	}{
+		{
+			name: "no chat client",
+			ags:  &state.AsyncGlobalState{},
+			task: func(ags *state.AsyncGlobalState) *ChatCompletionTask {
+				return NewChatCompletionTask(ags, "summarize", nil)
+			},
+			wantErr: "set OPENAI_API_KEY",
+		},
+		{
+			name: "unknown version",
+			ags:  newChatState(t, okServer),
+			task: func(ags *state.AsyncGlobalState) *ChatCompletionTask {
+				return NewChatCompletionTaskWithVersion(ags, "summarize", "v9", map[string]string{"Text": "x"})
+			},
+			wantErr: "prompt lookup failed",
+		},
+		{
+			name: "missing input",
+			ags:  newChatState(t, okServer),
+			task: func(ags *state.AsyncGlobalState) *ChatCompletionTask {
+				return NewChatCompletionTaskWithVersion(ags, "summarize", "v1", map[string]string{"Body": "x"})
+			},
+			wantErr: "prompt rendering failed",
+		},
+		{
+			name: "temperature out of range",
+			ags:  newChatState(t, okServer),
+			task: func(ags *state.AsyncGlobalState) *ChatCompletionTask {
+				task := NewChatCompletionTask(ags, "summarize", map[string]string{"Text": "x"})
+				task.Temperature = 3
+				return task
+			},
+			wantErr: "temperature must be between 0 and 2",
+		},
+		{
+			name: "api error names the prompt version",
+			ags:  newChatState(t, failServer),
+			task: func(ags *state.AsyncGlobalState) *ChatCompletionTask {
+				return NewChatCompletionTaskWithVersion(ags, "summarize", "v1", map[string]string{"Text": "x"})
+			},
+			wantErr: "chat completion failed (prompt summarize/v1)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.task(tt.ags).Execute()
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Execute = %+v, %v; want an error containing %q", result, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,10 +1,13 @@
 package tasks
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
 	"github.com/dibbla-agents/go-worker-starter-template/internal/state"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tracing"
 )
 
 // ExampleTask demonstrates the task pattern.
@@ -24,7 +27,17 @@ type ExampleTaskResult struct {
 
 // Execute runs the task and returns results
 func (t *ExampleTask) Execute() (*ExampleTaskResult, error) {
+	return t.ExecuteContext(context.Background())
+}
+
+// ExecuteContext is Execute as a child span of the span in ctx
+func (t *ExampleTask) ExecuteContext(ctx context.Context) (result *ExampleTaskResult, err error) {
 	start := time.Now()
+	_, span := tracing.Start(ctx, "task example")
+	defer func() {
+		metrics.ObserveTask("example", time.Since(start), err)
+		tracing.End(span, err)
+	}()
 
 	// 1. Validate inputs
 	if err := t.validate(); err != nil {
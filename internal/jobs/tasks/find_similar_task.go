@@ -0,0 +1,94 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/state"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tracing"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/vectorstore"
+)
+
+// FindSimilarTask finds the stored items closest to an embedding.
+// Its matches carry a similarity_distance, ready to be sent to an LLM task
+// as the "similar_in_production" context (see docs/how_to/create_llm_task).
+type FindSimilarTask struct {
+	AGS       *state.AsyncGlobalState
+	Embedding []float32          // Query vector (e.g. from embeddings.Client)
+	TopK      int                // Number of matches to return
+	Filter    vectorstore.Filter // Optional metadata filter
+}
+
+// FindSimilarTaskResult contains the nearest matches
+type FindSimilarTaskResult struct {
+	Matches       []vectorstore.Match
+	ExecutionTime time.Duration
+}
+
+// Execute runs the similarity search
+func (t *FindSimilarTask) Execute() (*FindSimilarTaskResult, error) {
+	return t.ExecuteContext(context.Background())
+}
+
+// ExecuteContext is Execute as a child span of the span in ctx
+func (t *FindSimilarTask) ExecuteContext(ctx context.Context) (result *FindSimilarTaskResult, err error) {
+	start := time.Now()
+	ctx, span := tracing.Start(ctx, "task find_similar")
+	defer func() {
+		metrics.ObserveTask("find_similar", time.Since(start), err)
+		tracing.End(span, err)
+	}()
+
+	if err := t.validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	matches, err := t.AGS.Vectors.Query(ctx, vectorstore.Query{
+		Vector: t.Embedding,
+		TopK:   t.TopK,
+		Filter: t.Filter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("similarity search failed: %w", err)
+	}
+
+	return &FindSimilarTaskResult{
+		Matches:       matches,
+		ExecutionTime: time.Since(start),
+	}, nil
+}
+
+// validate checks task preconditions
+func (t *FindSimilarTask) validate() error {
+	if t.AGS == nil || t.AGS.Vectors == nil {
+		return fmt.Errorf("vector store is not initialized")
+	}
+	if len(t.Embedding) == 0 {
+		return fmt.Errorf("embedding is required")
+	}
+	if t.TopK <= 0 {
+		return fmt.Errorf("topK must be positive")
+	}
+	return nil
+}
+
+// NewFindSimilarTask creates a new similarity search task
+func NewFindSimilarTask(ags *state.AsyncGlobalState, embedding []float32, topK int) *FindSimilarTask {
+	return &FindSimilarTask{
+		AGS:       ags,
+		Embedding: embedding,
+		TopK:      topK,
+	}
+}
+
+// NewFindSimilarTaskWithFilter creates a similarity search restricted by metadata
+func NewFindSimilarTaskWithFilter(ags *state.AsyncGlobalState, embedding []float32, topK int, filter vectorstore.Filter) *FindSimilarTask {
+	return &FindSimilarTask{
+		AGS:       ags,
+		Embedding: embedding,
+		TopK:      topK,
+		Filter:    filter,
+	}
+}
@@ -0,0 +1,277 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Job statuses.
+const (
+	StatusQueued    = "queued" // Waiting for a free slot (see SetConcurrency)
+	StatusRunning   = "running"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+	StatusCanceled  = "canceled"
+)
+
+// ErrNotRunning is returned when canceling a job that has already finished.
+var ErrNotRunning = errors.New("job is not running")
+
+// ErrUnknownJob is returned for IDs the tracker doesn't know (or no longer keeps).
+var ErrUnknownJob = errors.New("unknown job")
+
+// Job is a snapshot of a tracked job run.
+type Job struct {
+	ID         string     `json:"id"`
+	Type       string     `json:"type"`
+	Name       string     `json:"name,omitempty"`
+	Status     string     `json:"status"`
+	Done       int        `json:"done"`
+	Total      int        `json:"total"` // 0 when the job doesn't report progress
+	Error      string     `json:"error,omitempty"`
+	QueuedAt   time.Time  `json:"queued_at"`            // When Start was called
+	StartedAt  *time.Time `json:"started_at,omitempty"` // Unset while queued
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	seq int // Start order
+}
+
+// Run is a job run in progress. Report progress with Progress and end it with
+// Finish.
+type Run struct {
+	tracker *Tracker
+	id      string
+	cancel  context.CancelFunc
+}
+
+// Tracker keeps the jobs running in this process and the last finished ones,
+// for the admin API and dashboard. With a concurrency limit, jobs beyond it
+// wait in Start, in order, and are reported as queued.
+type Tracker struct {
+	mu       sync.Mutex
+	nextID   int
+	keep     int
+	limit    int // Running jobs at once (0 = unlimited)
+	running  int
+	jobs     map[string]*Job
+	cancels  map[string]context.CancelFunc
+	queue    []string                 // IDs of queued jobs, oldest first
+	wake     map[string]chan struct{} // Closed when a queued job may run
+	finished []string                 // IDs of finished jobs, oldest first
+}
+
+// DefaultTracker is used by the example jobs.
+var DefaultTracker = NewTracker(50)
+
+// NewTracker creates a tracker that keeps the last keep finished jobs
+// (default 50).
+func NewTracker(keep int) *Tracker {
+	if keep <= 0 {
+		keep = 50
+	}
+	return &Tracker{
+		keep:    keep,
+		jobs:    make(map[string]*Job),
+		cancels: make(map[string]context.CancelFunc),
+		wake:    make(map[string]chan struct{}),
+	}
+}
+
+// ConcurrencyFromEnv reads JOBS_MAX_CONCURRENT, the number of jobs that may
+// run at once (default 0, unlimited).
+func ConcurrencyFromEnv() (int, error) {
+	v := os.Getenv("JOBS_MAX_CONCURRENT")
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid JOBS_MAX_CONCURRENT %q: want a number >= 0", v)
+	}
+	return n, nil
+}
+
+// SetConcurrency limits how many jobs run at once (0 = unlimited). Raising
+// the limit starts queued jobs right away.
+func (t *Tracker) SetConcurrency(limit int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.limit = max(limit, 0)
+	t.startQueued()
+}
+
+// Start records a job and returns once it may run. The returned context is
+// canceled by Cancel; pass it to the job's work so it stops early. total is
+// the number of items the job will process (0 if unknown):
+//
+//	ctx, run := jobs.DefaultTracker.Start(ctx, "my_job", "", len(items))
+//	defer func() { run.Finish(err) }()
+//
+// When the concurrency limit is reached the job is queued and Start blocks
+// until a running job finishes. A job canceled while queued is marked canceled
+// and Start returns its canceled context, so the work stops right away.
+func (t *Tracker) Start(ctx context.Context, jobType, name string, total int) (context.Context, *Run) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	t.mu.Lock()
+	t.nextID++
+	id := strconv.Itoa(t.nextID)
+	job := &Job{ID: id, Type: jobType, Name: name, Status: StatusQueued, Total: total, QueuedAt: time.Now(), seq: t.nextID}
+	t.jobs[id] = job
+	t.cancels[id] = cancel
+	run := &Run{tracker: t, id: id, cancel: cancel}
+
+	if len(t.queue) == 0 && (t.limit == 0 || t.running < t.limit) {
+		t.begin(job)
+		t.mu.Unlock()
+		return ctx, run
+	}
+	wake := make(chan struct{})
+	t.queue = append(t.queue, id)
+	t.wake[id] = wake
+	t.mu.Unlock()
+
+	select {
+	case <-wake:
+	case <-ctx.Done():
+		t.mu.Lock()
+		defer t.mu.Unlock()
+		if job.Status == StatusQueued {
+			t.cancelQueued(job, ctx.Err())
+		}
+	}
+	return ctx, run
+}
+
+// begin marks a job running. The caller must hold t.mu.
+func (t *Tracker) begin(job *Job) {
+	now := time.Now()
+	job.Status = StatusRunning
+	job.StartedAt = &now
+	t.running++
+}
+
+// startQueued starts queued jobs while there are free slots. The caller must
+// hold t.mu.
+func (t *Tracker) startQueued() {
+	for len(t.queue) > 0 && (t.limit == 0 || t.running < t.limit) {
+		id := t.queue[0]
+		t.queue = t.queue[1:]
+		t.begin(t.jobs[id])
+		close(t.wake[id])
+		delete(t.wake, id)
+	}
+}
+
+// ID returns the job's ID.
+func (r *Run) ID() string {
+	return r.id
+}
+
+// Progress records how many items are done.
+func (r *Run) Progress(done int) {
+	r.tracker.mu.Lock()
+	defer r.tracker.mu.Unlock()
+	if job := r.tracker.jobs[r.id]; job != nil {
+		job.Done = done
+	}
+}
+
+// Finish marks the job succeeded, failed or (when err is a context
+// cancellation) canceled. Calls after the first are ignored.
+func (r *Run) Finish(err error) {
+	r.cancel()
+
+	t := r.tracker
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	job := t.jobs[r.id]
+	if job == nil || job.Status != StatusRunning {
+		return
+	}
+	t.running--
+	t.finish(job, err)
+	t.startQueued()
+}
+
+// cancelQueued takes a job out of the queue and marks it canceled. The
+// caller must hold t.mu.
+func (t *Tracker) cancelQueued(job *Job, err error) {
+	t.queue = slices.DeleteFunc(t.queue, func(id string) bool { return id == job.ID })
+	delete(t.wake, job.ID)
+	t.finish(job, err)
+}
+
+// finish records the outcome of a running or queued job. The caller must
+// hold t.mu.
+func (t *Tracker) finish(job *Job, err error) {
+	now := time.Now()
+	job.FinishedAt = &now
+	switch {
+	case err == nil:
+		job.Status = StatusSucceeded
+	case errors.Is(err, context.Canceled):
+		job.Status = StatusCanceled
+		job.Error = err.Error()
+	default:
+		job.Status = StatusFailed
+		job.Error = err.Error()
+	}
+	delete(t.cancels, job.ID)
+
+	t.finished = append(t.finished, job.ID)
+	if len(t.finished) > t.keep {
+		delete(t.jobs, t.finished[0])
+		t.finished = t.finished[1:]
+	}
+}
+
+// Cancel cancels a running or queued job's context. A running job is marked
+// canceled when it returns; a queued one right away.
+func (t *Tracker) Cancel(id string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.jobs[id] == nil {
+		return ErrUnknownJob
+	}
+	cancel := t.cancels[id]
+	if cancel == nil {
+		return ErrNotRunning
+	}
+	if job := t.jobs[id]; job.Status == StatusQueued {
+		t.cancelQueued(job, context.Canceled)
+	}
+	cancel()
+	return nil
+}
+
+// Get returns a snapshot of one job.
+func (t *Tracker) Get(id string) (Job, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	job := t.jobs[id]
+	if job == nil {
+		return Job{}, ErrUnknownJob
+	}
+	return *job, nil
+}
+
+// Jobs returns queued, running and recently finished jobs, newest first.
+func (t *Tracker) Jobs() []Job {
+	t.mu.Lock()
+	jobs := make([]Job, 0, len(t.jobs))
+	for _, job := range t.jobs {
+		jobs = append(jobs, *job)
+	}
+	t.mu.Unlock()
+
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].seq > jobs[j].seq })
+	return jobs
+}
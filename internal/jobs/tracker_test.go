@@ -0,0 +1,140 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// startAsync calls Start in a goroutine and returns a channel that delivers
+// the run once Start returns.
+func startAsync(t *Tracker, ctx context.Context, name string) <-chan *Run {
+	started := make(chan *Run, 1)
+	go func() {
+		_, run := t.Start(ctx, "test", name, 0)
+		started <- run
+	}()
+	return started
+}
+
+// waitForStatus polls until the job named name has status.
+func waitForStatus(t *testing.T, tracker *Tracker, name, status string) Job {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, job := range tracker.Jobs() {
+			if job.Name == name && job.Status == status {
+				return job
+			}
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("job %s never became %s: %+v", name, status, tracker.Jobs())
+	return Job{}
+}
+
+func TestTrackerQueuesBeyondConcurrency(t *testing.T) {
+	tracker := NewTracker(10)
+	tracker.SetConcurrency(1)
+	ctx := context.Background()
+
+	_, first := tracker.Start(ctx, "test", "first", 0)
+	second := startAsync(tracker, ctx, "second")
+	queued := waitForStatus(t, tracker, "second", StatusQueued)
+	if queued.StartedAt != nil {
+		t.Error("queued job has a start time")
+	}
+
+	third := startAsync(tracker, ctx, "third")
+	waitForStatus(t, tracker, "third", StatusQueued)
+
+	// Finishing the running job starts the oldest queued one
+	first.Finish(nil)
+	run := <-second
+	job := waitForStatus(t, tracker, "second", StatusRunning)
+	if job.StartedAt == nil || job.StartedAt.Before(job.QueuedAt) {
+		t.Errorf("started_at %v, queued_at %v", job.StartedAt, job.QueuedAt)
+	}
+	waitForStatus(t, tracker, "third", StatusQueued)
+
+	run.Finish(errors.New("boom"))
+	(<-third).Finish(nil)
+	waitForStatus(t, tracker, "second", StatusFailed)
+	waitForStatus(t, tracker, "third", StatusSucceeded)
+}
+
+func TestTrackerCancelQueuedJob(t *testing.T) {
+	tracker := NewTracker(10)
+	tracker.SetConcurrency(1)
+	ctx := context.Background()
+
+	_, first := tracker.Start(ctx, "test", "first", 0)
+	second := startAsync(tracker, ctx, "second")
+	job := waitForStatus(t, tracker, "second", StatusQueued)
+
+	// Canceling a queued job takes effect immediately and Start returns
+	if err := tracker.Cancel(job.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := tracker.Get(job.ID); got.Status != StatusCanceled {
+		t.Errorf("status after cancel = %s, want canceled", got.Status)
+	}
+	run := <-second
+	run.Finish(context.Canceled) // Ignored: the job already finished
+	if err := tracker.Cancel(job.ID); !errors.Is(err, ErrNotRunning) {
+		t.Errorf("second cancel = %v, want ErrNotRunning", err)
+	}
+
+	// The canceled job never took a slot
+	third := startAsync(tracker, ctx, "third")
+	waitForStatus(t, tracker, "third", StatusQueued)
+	first.Finish(nil)
+	(<-third).Finish(nil)
+	waitForStatus(t, tracker, "third", StatusSucceeded)
+}
+
+func TestTrackerQueuedContextCanceled(t *testing.T) {
+	tracker := NewTracker(10)
+	tracker.SetConcurrency(1)
+
+	_, first := tracker.Start(context.Background(), "test", "first", 0)
+	defer first.Finish(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	second := startAsync(tracker, ctx, "second")
+	waitForStatus(t, tracker, "second", StatusQueued)
+	cancel()
+	<-second
+	waitForStatus(t, tracker, "second", StatusCanceled)
+}
+
+func TestTrackerRaisingConcurrencyStartsQueuedJobs(t *testing.T) {
+	tracker := NewTracker(10)
+	tracker.SetConcurrency(1)
+	ctx := context.Background()
+
+	_, first := tracker.Start(ctx, "test", "first", 0)
+	defer first.Finish(nil)
+	second := startAsync(tracker, ctx, "second")
+	waitForStatus(t, tracker, "second", StatusQueued)
+
+	tracker.SetConcurrency(0)
+	(<-second).Finish(nil)
+	waitForStatus(t, tracker, "second", StatusSucceeded)
+}
+
+func TestConcurrencyFromEnv(t *testing.T) {
+	for value, want := range map[string]int{"": 0, "0": 0, "4": 4} {
+		t.Setenv("JOBS_MAX_CONCURRENT", value)
+		if got, err := ConcurrencyFromEnv(); err != nil || got != want {
+			t.Errorf("JOBS_MAX_CONCURRENT=%q: got %d, %v", value, got, err)
+		}
+	}
+	for _, value := range []string{"-1", "many"} {
+		t.Setenv("JOBS_MAX_CONCURRENT", value)
+		if _, err := ConcurrencyFromEnv(); err == nil {
+			t.Errorf("JOBS_MAX_CONCURRENT=%q: expected an error", value)
+		}
+	}
+}
@@ -0,0 +1,310 @@
+// Package llm provides a client for LLM execute endpoints.
+//
+// It implements the workflow from docs/how_to/create_llm_task as reusable code:
+//  1. Build a Request whose sections are marshalled to YAML
+//  2. POST {"input": "<yaml>"} to {BaseURL}/api/execute/{endpoint}/{environment}
+//     with a Bearer SERVER_API_TOKEN
+//  3. Read {"response": "<text>"} and parse the text into a typed decision
+//
+// Requests are retried on network errors, 429 and 5xx responses.
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/circuitbreaker"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/config"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tracing"
+)
+
+// ErrEmptyResponse is returned when the endpoint replies with blank text.
+var ErrEmptyResponse = errors.New("empty LLM response")
+
+// StatusError is returned when the endpoint replies with a non-200 status.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("LLM API returned status %d: %s", e.StatusCode, e.Body)
+}
+
+// Retryable reports whether the request may succeed if sent again.
+func (e *StatusError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= 500
+}
+
+// Config configures the LLM client.
+type Config struct {
+	BaseURL     string        // Endpoint host, e.g. https://llm.example.com
+	Environment string        // Deployment suffix in the URL (default "prod")
+	Token       string        // Bearer token (SERVER_API_TOKEN)
+	Timeout     time.Duration // Per-attempt timeout (default 30s)
+	MaxRetries  int           // Total attempts (default 3)
+	RetryDelay  time.Duration // Linear backoff base: attempt * RetryDelay (default 1s)
+	LogRequests bool          // Log raw YAML input and LLM responses
+	HTTPClient  *http.Client  // Optional custom client (e.g. for recording fixtures)
+
+	// Breaker fails requests fast while the endpoint is down (nil disables).
+	// ConfigFromEnv uses the shared "llm" breaker from circuitbreaker.DefaultRegistry.
+	Breaker *circuitbreaker.Breaker
+}
+
+// ConfigFromEnv reads the client configuration from environment variables:
+//
+//	LLM_BASE_URL      endpoint host, e.g. https://llm.example.com (required)
+//	LLM_ENVIRONMENT   URL environment suffix (default prod)
+//	SERVER_API_TOKEN  bearer token (required)
+//	LLM_TIMEOUT       per-attempt timeout, e.g. 45s (default 30s)
+//	LLM_MAX_RETRIES   total attempts (default 3)
+//	LLM_LOG_REQUESTS  true to log raw input and responses
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		BaseURL:     os.Getenv("LLM_BASE_URL"),
+		Environment: config.GetEnvOrDefault("LLM_ENVIRONMENT", "prod"),
+		Token:       os.Getenv("SERVER_API_TOKEN"),
+		Breaker:     circuitbreaker.DefaultRegistry.Get("llm"),
+	}
+	if cfg.BaseURL == "" {
+		return Config{}, fmt.Errorf("LLM_BASE_URL not set: point it at your LLM endpoint host, e.g. https://llm.example.com")
+	}
+
+	if v := os.Getenv("LLM_TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid LLM_TIMEOUT %q: %w", v, err)
+		}
+		cfg.Timeout = timeout
+	}
+
+	if v := os.Getenv("LLM_MAX_RETRIES"); v != "" {
+		retries, err := strconv.Atoi(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid LLM_MAX_RETRIES %q: %w", v, err)
+		}
+		cfg.MaxRetries = retries
+	}
+
+	cfg.LogRequests = os.Getenv("LLM_LOG_REQUESTS") == "true"
+
+	return cfg, nil
+}
+
+// Client calls LLM execute endpoints.
+type Client struct {
+	baseURL     string
+	environment string
+	token       string
+	timeout     time.Duration
+	maxRetries  int
+	retryDelay  time.Duration
+	logRequests bool
+	httpClient  *http.Client
+}
+
+// NewClient creates a client from an explicit configuration.
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("SERVER_API_TOKEN not set")
+	}
+	if cfg.BaseURL == "" {
+		return nil, fmt.Errorf("LLM base URL is required")
+	}
+	if u, err := url.Parse(cfg.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid LLM base URL %q: want http(s)://host", cfg.BaseURL)
+	}
+	if cfg.Environment == "" {
+		cfg.Environment = "prod"
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = 3
+	}
+	if cfg.RetryDelay <= 0 {
+		cfg.RetryDelay = time.Second
+	}
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = &http.Client{}
+	}
+	if cfg.Breaker != nil {
+		cfg.HTTPClient = circuitbreaker.Client(cfg.HTTPClient, cfg.Breaker)
+	}
+	cfg.HTTPClient = tracing.Client(cfg.HTTPClient)
+
+	return &Client{
+		baseURL:     strings.TrimRight(cfg.BaseURL, "/"),
+		environment: cfg.Environment,
+		token:       cfg.Token,
+		timeout:     cfg.Timeout,
+		maxRetries:  cfg.MaxRetries,
+		retryDelay:  cfg.RetryDelay,
+		logRequests: cfg.LogRequests,
+		httpClient:  cfg.HTTPClient,
+	}, nil
+}
+
+// NewClientFromEnv creates a client configured from environment variables.
+func NewClientFromEnv() (*Client, error) {
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg)
+}
+
+// Response is the LLM's reply to a request.
+type Response struct {
+	Text     string        // Unstructured LLM output
+	Input    string        // YAML input that was sent
+	Attempts int           // Number of HTTP attempts made
+	Duration time.Duration // Total time including retries
+}
+
+// apiRequest is the JSON wrapper around the YAML input.
+type apiRequest struct {
+	Input string `json:"input"`
+}
+
+// apiResponse is the JSON wrapper around the LLM output.
+type apiResponse struct {
+	Response string `json:"response"`
+}
+
+// Execute sends a request and returns the raw LLM text.
+func (c *Client) Execute(ctx context.Context, req *Request) (*Response, error) {
+	input, err := req.YAML()
+	if err != nil {
+		return nil, err
+	}
+	return c.ExecuteRaw(ctx, req.Endpoint, input)
+}
+
+// ExecuteRaw sends pre-formatted input to an endpoint and returns the raw LLM text.
+func (c *Client) ExecuteRaw(ctx context.Context, endpoint, input string) (*Response, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("endpoint name is required")
+	}
+
+	body, err := json.Marshal(apiRequest{Input: input})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	if c.logRequests {
+		log.Printf("🤖 LLM request [%s]:\n%s", endpoint, input)
+	}
+
+	start := time.Now()
+	var text string
+	attempt := 1
+	for ; attempt <= c.maxRetries; attempt++ {
+		text, err = c.do(ctx, endpoint, body)
+		if err == nil || !retryable(err) || attempt == c.maxRetries {
+			break
+		}
+
+		waitTime := time.Duration(attempt) * c.retryDelay
+		log.Printf("⚠️  LLM request to %s failed (attempt %d/%d), retrying in %v: %v",
+			endpoint, attempt, c.maxRetries, waitTime, err)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(waitTime):
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("LLM request to %s failed after %d attempts: %w", endpoint, attempt, err)
+	}
+
+	if c.logRequests {
+		log.Printf("🤖 LLM response [%s]:\n%s", endpoint, text)
+	}
+
+	return &Response{
+		Text:     text,
+		Input:    input,
+		Attempts: attempt,
+		Duration: time.Since(start),
+	}, nil
+}
+
+// do performs a single HTTP attempt.
+func (c *Client) do(ctx context.Context, endpoint string, body []byte) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpointURL(endpoint), bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", &StatusError{StatusCode: resp.StatusCode, Body: truncate(string(respBody), 500)}
+	}
+
+	var apiResp apiResponse
+	if err := json.Unmarshal(respBody, &apiResp); err != nil {
+		return "", fmt.Errorf("failed to parse response JSON: %w", err)
+	}
+	if strings.TrimSpace(apiResp.Response) == "" {
+		return "", ErrEmptyResponse
+	}
+
+	return apiResp.Response, nil
+}
+
+// endpointURL builds {base}/api/execute/{endpoint}/{environment}.
+func (c *Client) endpointURL(endpoint string) string {
+	return fmt.Sprintf("%s/api/execute/%s/%s", c.baseURL, url.PathEscape(endpoint), url.PathEscape(c.environment))
+}
+
+// retryable reports whether an attempt error is worth retrying.
+func retryable(err error) bool {
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Retryable()
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, circuitbreaker.ErrOpen) {
+		return false
+	}
+	// Network errors, timeouts, empty and malformed responses
+	return true
+}
+
+// truncate shortens s for error messages.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
@@ -0,0 +1,294 @@
+package llm_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/llm"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/llm/llmtest"
+)
+
+type decision struct {
+	Approved  bool   `yaml:"approved"`
+	Reasoning string `yaml:"reasoning"`
+}
+
+type item struct {
+	Question string `yaml:"question"`
+	Answer   string `yaml:"answer,omitempty"`
+}
+
+func newClient(t *testing.T, srv *llmtest.Server) *llm.Client {
+	t.Helper()
+	client, err := llm.NewClient(srv.Config())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestExecuteSendsYAMLWithBearerToken(t *testing.T) {
+	srv := llmtest.NewServer("approved: true")
+	defer srv.Close()
+
+	req := llm.NewRequest("ct_faq_dedup").
+		With("new_item", item{Question: "How do I reset?"}).
+		With("similar_in_production", []item{{Question: "Reset password?", Answer: "Use the link"}})
+	resp, err := newClient(t, srv).Execute(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Text != "approved: true" || resp.Attempts != 1 {
+		t.Errorf("response = %+v", resp)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("server got %d requests, want 1", len(requests))
+	}
+	got := requests[0]
+	if got.Endpoint != "ct_faq_dedup" || got.Environment != "prod" {
+		t.Errorf("called %s/%s, want ct_faq_dedup/prod", got.Endpoint, got.Environment)
+	}
+	if auth := got.Header.Get("Authorization"); auth != "Bearer "+llmtest.Token {
+		t.Errorf("Authorization = %q", auth)
+	}
+	if ct := got.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+
+	// Sections keep their insertion order and omitempty fields are dropped
+	want := `new_item:
+  question: How do I reset?
+similar_in_production:
+  - question: Reset password?
+    answer: Use the link
+`
+	if got.Input != want {
+		t.Errorf("input:\n%s\nwant:\n%s", got.Input, want)
+	}
+	if resp.Input != want {
+		t.Errorf("Response.Input = %q, want the sent YAML", resp.Input)
+	}
+}
+
+func TestExecuteWrongTokenIsNotRetried(t *testing.T) {
+	srv := llmtest.NewServer("approved: true")
+	defer srv.Close()
+
+	cfg := srv.Config()
+	cfg.Token = "wrong"
+	client, err := llm.NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.ExecuteRaw(context.Background(), "ct_faq_dedup", "x: 1")
+	var statusErr *llm.StatusError
+	if !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("err = %v, want a 401 StatusError", err)
+	}
+	if n := len(srv.Requests()); n != 0 {
+		t.Errorf("server recorded %d authorized requests, want 0", n)
+	}
+}
+
+func TestExecuteRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		replies      []llmtest.Reply
+		wantAttempts int
+		wantStatus   int // StatusError code, 0 for success
+		wantErr      error
+	}{
+		{
+			name:         "5xx then 429 then success",
+			replies:      []llmtest.Reply{{StatusCode: 503}, {StatusCode: 429}, {Text: "ok"}},
+			wantAttempts: 3,
+		},
+		{
+			name:         "empty response is retried",
+			replies:      []llmtest.Reply{{Text: "  "}, {Text: "ok"}},
+			wantAttempts: 2,
+		},
+		{
+			name:         "gives up after MaxRetries",
+			replies:      []llmtest.Reply{{StatusCode: 500, Text: "boom"}},
+			wantAttempts: 3,
+			wantStatus:   500,
+		},
+		{
+			name:         "client errors are not retried",
+			replies:      []llmtest.Reply{{StatusCode: 400, Text: "bad input"}, {Text: "ok"}},
+			wantAttempts: 1,
+			wantStatus:   400,
+		},
+		{
+			name:         "persistent empty response",
+			replies:      []llmtest.Reply{{Text: ""}},
+			wantAttempts: 3,
+			wantErr:      llm.ErrEmptyResponse,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := llmtest.NewServerReplies(tt.replies...)
+			defer srv.Close()
+
+			resp, err := newClient(t, srv).ExecuteRaw(context.Background(), "ct_test", "x: 1")
+			if n := len(srv.Requests()); n != tt.wantAttempts {
+				t.Errorf("server got %d requests, want %d", n, tt.wantAttempts)
+			}
+
+			switch {
+			case tt.wantStatus != 0:
+				var statusErr *llm.StatusError
+				if !errors.As(err, &statusErr) || statusErr.StatusCode != tt.wantStatus {
+					t.Fatalf("err = %v, want status %d", err, tt.wantStatus)
+				}
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("err = %v, want %v", err, tt.wantErr)
+				}
+			default:
+				if err != nil {
+					t.Fatal(err)
+				}
+				if resp.Text != "ok" || resp.Attempts != tt.wantAttempts {
+					t.Errorf("response = %+v", resp)
+				}
+			}
+		})
+	}
+}
+
+func TestExecuteStopsRetryingWhenCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	srv := llmtest.NewServerFunc(func(llmtest.RecordedRequest) llmtest.Reply {
+		cancel()
+		return llmtest.Reply{StatusCode: 503}
+	})
+	defer srv.Close()
+
+	cfg := srv.Config()
+	cfg.RetryDelay = 0 // Default 1s: the canceled context must win
+	client, err := llm.NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.ExecuteRaw(ctx, "ct_test", "x: 1"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("server got %d requests, want 1", n)
+	}
+}
+
+func TestNewRequestFromIsTheWholeDocument(t *testing.T) {
+	srv := llmtest.NewServer("approved: false")
+	defer srv.Close()
+
+	req := llm.NewRequestFrom("ct_review", item{Question: "Q", Answer: "A"})
+	if _, err := newClient(t, srv).Execute(context.Background(), req); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := srv.Requests()[0].Input, "question: Q\nanswer: A\n"; got != want {
+		t.Errorf("input = %q, want %q", got, want)
+	}
+
+	if _, err := llm.NewRequest("ct_empty").YAML(); err == nil {
+		t.Error("expected an error for a request without sections")
+	}
+}
+
+func TestExecuteIntoParsesDecisions(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    decision
+		wantErr bool
+	}{
+		{
+			name: "fenced yaml block",
+			text: "Here is my decision:\n```yaml\napproved: true\nreasoning: unique question\n```\nThanks!",
+			want: decision{Approved: true, Reasoning: "unique question"},
+		},
+		{
+			name: "fenced block without language",
+			text: "```\napproved: false\nreasoning: duplicate\n```",
+			want: decision{Reasoning: "duplicate"},
+		},
+		{
+			name: "yaml block after a json block",
+			text: "```json\n{\"approved\": false}\n```\n```yml\napproved: true\n```",
+			want: decision{Approved: true},
+		},
+		{
+			name: "tilde fence",
+			text: "~~~yaml\napproved: true\nreasoning: tilde\n~~~",
+			want: decision{Approved: true, Reasoning: "tilde"},
+		},
+		{
+			name: "plain yaml",
+			text: "\napproved: true\nreasoning: looks fine\n",
+			want: decision{Approved: true, Reasoning: "looks fine"},
+		},
+		{
+			name:    "not yaml",
+			text:    "I cannot decide: [unbalanced",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := llmtest.NewServer(tt.text)
+			defer srv.Close()
+
+			req := llm.NewRequest("ct_faq_dedup").With("new_item", item{Question: "Q"})
+			got, resp, err := llm.ExecuteInto(context.Background(), newClient(t, srv), req, llm.DecodeYAML[decision])
+			if resp == nil || resp.Text != tt.text {
+				t.Fatalf("raw response not returned: %+v", resp)
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected a parse error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("decision = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigFromEnvRequiresBaseURL(t *testing.T) {
+	t.Setenv("SERVER_API_TOKEN", "token")
+	t.Setenv("LLM_BASE_URL", "")
+	if _, err := llm.ConfigFromEnv(); err == nil {
+		t.Fatal("expected an error without LLM_BASE_URL")
+	}
+
+	t.Setenv("LLM_BASE_URL", "https://llm.example.com")
+	cfg, err := llm.ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.BaseURL != "https://llm.example.com" || cfg.Environment != "prod" {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestNewClientRejectsInvalidBaseURL(t *testing.T) {
+	for _, baseURL := range []string{"", "llm.example.com", "ftp://llm.example.com", "http://"} {
+		if _, err := llm.NewClient(llm.Config{BaseURL: baseURL, Token: "token"}); err == nil {
+			t.Errorf("NewClient(%q): expected an error", baseURL)
+		}
+	}
+}
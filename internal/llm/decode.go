@@ -0,0 +1,86 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParseFunc converts unstructured LLM text into a typed decision.
+type ParseFunc[T any] func(text string) (T, error)
+
+// ExecuteInto sends a request and parses the reply with parse.
+// The raw Response is returned alongside the decision for logging and storage,
+// including when parsing fails.
+func ExecuteInto[T any](ctx context.Context, c *Client, req *Request, parse ParseFunc[T]) (T, *Response, error) {
+	var zero T
+
+	resp, err := c.Execute(ctx, req)
+	if err != nil {
+		return zero, nil, err
+	}
+
+	decision, err := parse(resp.Text)
+	if err != nil {
+		return zero, resp, fmt.Errorf("failed to parse LLM response from %s: %w", req.Endpoint, err)
+	}
+	return decision, resp, nil
+}
+
+// Block is a fenced code block found in a response.
+type Block struct {
+	Lang    string // Language tag after the opening fence, lower-cased ("" if none)
+	Content string
+}
+
+// fencePattern matches ```lang\n...\n``` blocks (also ~~~ fences).
+var fencePattern = regexp.MustCompile("(?s)(```|~~~)[ \t]*([A-Za-z0-9_+-]*)[^\n]*\n(.*?)\n?[ \t]*(```|~~~)")
+
+// FencedBlocks returns every fenced code block in text, in order.
+func FencedBlocks(text string) []Block {
+	var blocks []Block
+	for _, m := range fencePattern.FindAllStringSubmatch(text, -1) {
+		blocks = append(blocks, Block{
+			Lang:    strings.ToLower(m[2]),
+			Content: m[3],
+		})
+	}
+	return blocks
+}
+
+// FencedBlock returns the first fenced block whose language is one of langs.
+// An empty langs list matches any block.
+func FencedBlock(text string, langs ...string) (Block, bool) {
+	for _, b := range FencedBlocks(text) {
+		if len(langs) == 0 {
+			return b, true
+		}
+		for _, l := range langs {
+			if b.Lang == l {
+				return b, true
+			}
+		}
+	}
+	return Block{}, false
+}
+
+// DecodeYAML is a ParseFunc that reads a YAML decision from a ```yaml, ```yml
+// or untagged code block, falling back to parsing the whole response as YAML.
+// For responses in other formats, see the extract package.
+func DecodeYAML[T any](text string) (T, error) {
+	var decision T
+
+	if block, ok := FencedBlock(text, "yaml", "yml", ""); ok {
+		if err := yaml.Unmarshal([]byte(block.Content), &decision); err == nil {
+			return decision, nil
+		}
+	}
+
+	if err := yaml.Unmarshal([]byte(strings.TrimSpace(text)), &decision); err != nil {
+		return decision, fmt.Errorf("response is not valid YAML: %w", err)
+	}
+	return decision, nil
+}
@@ -0,0 +1,255 @@
+package extract
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/llm"
+)
+
+// Strategy names one way of locating structured data in a response.
+type Strategy string
+
+const (
+	StrategyFencedJSON Strategy = "fenced_json" // ```json block
+	StrategyFencedYAML Strategy = "fenced_yaml" // ```yaml, ```yml or untagged block
+	StrategyInlineJSON Strategy = "inline_json" // {...} embedded in prose
+	StrategyRawYAML    Strategy = "raw_yaml"    // whole response is a YAML mapping
+	StrategyKeyValue   Strategy = "key_value"   // "key: value" lines in prose
+)
+
+// strategies is the order Decode tries; the most explicit formats go first.
+var strategies = []Strategy{
+	StrategyFencedJSON,
+	StrategyFencedYAML,
+	StrategyInlineJSON,
+	StrategyRawYAML,
+	StrategyKeyValue,
+}
+
+// AttemptError records why one strategy failed.
+type AttemptError struct {
+	Round    int // 0 for the original response, 1+ for repair responses
+	Strategy Strategy
+	Err      error
+}
+
+// ParseError is returned when no strategy produced a valid value.
+// It carries every attempt, and every raw response, for debugging.
+type ParseError struct {
+	Attempts  []AttemptError
+	Responses []string // Original response followed by any repair responses
+}
+
+func (e *ParseError) Error() string {
+	parts := make([]string, 0, len(e.Attempts))
+	for _, a := range e.Attempts {
+		if a.Round > 0 {
+			parts = append(parts, fmt.Sprintf("repair %d %s: %v", a.Round, a.Strategy, a.Err))
+		} else {
+			parts = append(parts, fmt.Sprintf("%s: %v", a.Strategy, a.Err))
+		}
+	}
+	return fmt.Sprintf("failed to parse LLM response after %d response(s): %s",
+		len(e.Responses), strings.Join(parts, "; "))
+}
+
+// problems returns attempt errors as strings, for repair prompts.
+func (e *ParseError) problems(round int) []string {
+	var out []string
+	for _, a := range e.Attempts {
+		if a.Round == round {
+			out = append(out, fmt.Sprintf("%s: %v", a.Strategy, a.Err))
+		}
+	}
+	return out
+}
+
+// Decode extracts a T from an LLM response, trying each strategy in order and
+// validating the result against T's `llm` tags. T must be a struct.
+// On failure the error is a *ParseError.
+func Decode[T any](text string) (T, error) {
+	var zero T
+	perr := &ParseError{Responses: []string{text}}
+	value, ok := decodeRound[T](text, 0, perr)
+	if !ok {
+		return zero, perr
+	}
+	return value, nil
+}
+
+// decodeRound tries all strategies on one response, appending failures to perr.
+func decodeRound[T any](text string, round int, perr *ParseError) (T, bool) {
+	var zero T
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		perr.Attempts = append(perr.Attempts, AttemptError{Round: round, Err: fmt.Errorf("target type %s is not a struct", t)})
+		return zero, false
+	}
+	specs := structFields(t)
+
+	for _, strategy := range strategies {
+		values, err := locate(strategy, text)
+		if err == nil {
+			var out T
+			err = assign(reflect.ValueOf(&out).Elem(), specs, values)
+			if err == nil {
+				return out, true
+			}
+		}
+		perr.Attempts = append(perr.Attempts, AttemptError{Round: round, Strategy: strategy, Err: err})
+	}
+	return zero, false
+}
+
+// locate finds the key/value data for a strategy. Keys are normalized.
+func locate(strategy Strategy, text string) (map[string]interface{}, error) {
+	switch strategy {
+	case StrategyFencedJSON:
+		block, ok := llm.FencedBlock(text, "json", "json5")
+		if !ok {
+			return nil, fmt.Errorf("no fenced JSON block")
+		}
+		return parseMapping(block.Content)
+	case StrategyFencedYAML:
+		block, ok := llm.FencedBlock(text, "yaml", "yml", "")
+		if !ok {
+			return nil, fmt.Errorf("no fenced YAML block")
+		}
+		return parseMapping(block.Content)
+	case StrategyInlineJSON:
+		obj, ok := JSONObject(text)
+		if !ok {
+			return nil, fmt.Errorf("no JSON object")
+		}
+		return parseMapping(obj)
+	case StrategyRawYAML:
+		return parseMapping(text)
+	case StrategyKeyValue:
+		kv := KeyValues(text)
+		if len(kv) == 0 {
+			return nil, fmt.Errorf("no key: value lines")
+		}
+		values := make(map[string]interface{}, len(kv))
+		for k, v := range kv {
+			values[k] = v
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unknown strategy %q", strategy)
+	}
+}
+
+// parseMapping parses YAML (or JSON, which YAML accepts) into a normalized map.
+func parseMapping(content string) (map[string]interface{}, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal([]byte(strings.TrimSpace(content)), &raw); err != nil {
+		return nil, fmt.Errorf("invalid syntax: %w", err)
+	}
+	mapping, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected a mapping, got %T", raw)
+	}
+
+	out := make(map[string]interface{}, len(mapping))
+	for k, v := range mapping {
+		out[NormalizeKey(k)] = v
+	}
+	return out, nil
+}
+
+// assign sets struct fields from values and validates the result.
+func assign(target reflect.Value, specs []fieldSpec, values map[string]interface{}) error {
+	present := make(map[string]bool)
+	var problems []string
+
+	for _, spec := range specs {
+		raw, ok := values[spec.Name]
+		if !ok || raw == nil {
+			continue
+		}
+		present[spec.Name] = true
+
+		if err := setField(target.Field(spec.Index), raw); err != nil {
+			problems = append(problems, fmt.Sprintf("field %q: %v", spec.Name, err))
+		}
+	}
+
+	if len(present) == 0 {
+		return fmt.Errorf("no expected fields found")
+	}
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return validate(target, specs, present)
+}
+
+// setField converts a parsed value into the field's type.
+// Strings from prose are decoded as plain YAML scalars, so "0.15" fills a float
+// and "yes" fills a bool; comma-separated strings fill string slices.
+func setField(field reflect.Value, raw interface{}) error {
+	if s, ok := raw.(string); ok {
+		s = strings.TrimSpace(s)
+		switch field.Kind() {
+		case reflect.Bool:
+			b, err := parseBool(s)
+			if err != nil {
+				return err
+			}
+			field.SetBool(b)
+			return nil
+		case reflect.String:
+			field.SetString(s)
+			return nil
+		case reflect.Slice:
+			if field.Type().Elem().Kind() == reflect.String && !strings.HasPrefix(s, "[") {
+				parts := strings.Split(s, ",")
+				out := reflect.MakeSlice(field.Type(), 0, len(parts))
+				for _, p := range parts {
+					if p = strings.TrimSpace(p); p != "" {
+						out = reflect.Append(out, reflect.ValueOf(p).Convert(field.Type().Elem()))
+					}
+				}
+				field.Set(out)
+				return nil
+			}
+		}
+		node := &yaml.Node{Kind: yaml.ScalarNode, Value: s}
+		if strings.HasPrefix(s, "[") || strings.HasPrefix(s, "{") {
+			if err := yaml.Unmarshal([]byte(s), node); err != nil {
+				return err
+			}
+		}
+		return node.Decode(field.Addr().Interface())
+	}
+
+	if field.Kind() == reflect.String {
+		// Accept numbers/bools for string fields rather than failing
+		field.SetString(fmt.Sprint(raw))
+		return nil
+	}
+
+	var node yaml.Node
+	if err := node.Encode(raw); err != nil {
+		return err
+	}
+	return node.Decode(field.Addr().Interface())
+}
+
+// parseBool accepts the ways LLMs commonly say yes or no.
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(strings.Trim(s, ".!")) {
+	case "true", "yes", "y", "approved", "approve", "1":
+		return true, nil
+	case "false", "no", "n", "rejected", "reject", "0":
+		return false, nil
+	}
+	if b, err := strconv.ParseBool(s); err == nil {
+		return b, nil
+	}
+	return false, fmt.Errorf("cannot interpret %q as a boolean", s)
+}
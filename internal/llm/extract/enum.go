@@ -0,0 +1,53 @@
+package extract
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// EnumError is returned when a response does not name exactly one label.
+type EnumError struct {
+	Labels []string // Allowed labels
+	Found  []string // Labels found in the text (empty or ambiguous)
+}
+
+func (e *EnumError) Error() string {
+	if len(e.Found) == 0 {
+		return fmt.Sprintf("none of the labels %v found in response", e.Labels)
+	}
+	return fmt.Sprintf("ambiguous response: found labels %v", e.Found)
+}
+
+// EnumLabel finds which of labels the text picks, matching whole words case-insensitively.
+//
+// A fenced block or a line consisting only of a label wins outright; otherwise
+// the text must mention exactly one label. Labels are returned as passed in.
+func EnumLabel(text string, labels ...string) (string, error) {
+	if len(labels) == 0 {
+		return "", fmt.Errorf("no labels given")
+	}
+
+	// A label on its own (after stripping fences/punctuation) is unambiguous
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.Trim(strings.TrimSpace(line), "`*_\"'.:!")
+		for _, l := range labels {
+			if strings.EqualFold(line, l) {
+				return l, nil
+			}
+		}
+	}
+
+	var found []string
+	for _, l := range labels {
+		pattern := regexp.MustCompile(`(?i)(^|[^A-Za-z0-9_])` + regexp.QuoteMeta(l) + `($|[^A-Za-z0-9_])`)
+		if pattern.MatchString(text) {
+			found = append(found, l)
+		}
+	}
+
+	if len(found) != 1 {
+		return "", &EnumError{Labels: labels, Found: found}
+	}
+	return found[0], nil
+}
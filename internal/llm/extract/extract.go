@@ -0,0 +1,98 @@
+// Package extract pulls structured data out of unstructured LLM responses.
+//
+// LLM output varies: sometimes a fenced ```json or ```yaml block, sometimes bare
+// YAML, sometimes prose with "Approved: yes" lines. Decode tries each strategy in
+// turn, validates the result against the target struct's `llm` tags, and returns a
+// ParseError describing every failed attempt. Parser adds optional repair re-prompts.
+package extract
+
+import (
+	"regexp"
+	"strings"
+)
+
+// JSONObject returns the first balanced {...} or [...] span in text, ignoring
+// braces inside JSON strings. It finds JSON embedded in prose without fences.
+func JSONObject(text string) (string, bool) {
+	for start := 0; start < len(text); start++ {
+		if text[start] != '{' && text[start] != '[' {
+			continue
+		}
+		if end, ok := matchBracket(text, start); ok {
+			return text[start : end+1], true
+		}
+	}
+	return "", false
+}
+
+// matchBracket finds the index of the bracket closing text[start].
+func matchBracket(text string, start int) (int, bool) {
+	var stack []byte
+	inString, escaped := false, false
+
+	for i := start; i < len(text); i++ {
+		c := text[i]
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '{':
+			stack = append(stack, '}')
+		case '[':
+			stack = append(stack, ']')
+		case '}', ']':
+			if len(stack) == 0 || stack[len(stack)-1] != c {
+				return 0, false
+			}
+			stack = stack[:len(stack)-1]
+			if len(stack) == 0 {
+				return i, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// kvPattern matches "key: value" or "key = value" lines, tolerating list
+// markers and markdown emphasis such as "- **Approved**: yes".
+var kvPattern = regexp.MustCompile(`^\s*(?:[-*•]\s+)?[*_]*([A-Za-z][A-Za-z0-9 _-]{0,63}?)[*_]*\s*[:=]\s*[*_]*(.*?)[*_]*\s*$`)
+
+// KeyValues extracts "key: value" decisions from prose, one per line.
+// Keys are normalized to snake_case lower-case ("Is Duplicate" -> "is_duplicate").
+// When a key repeats, the first occurrence wins.
+func KeyValues(text string) map[string]string {
+	out := make(map[string]string)
+	for _, line := range strings.Split(text, "\n") {
+		m := kvPattern.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		key := NormalizeKey(m[1])
+		value := strings.Trim(strings.TrimSpace(m[2]), `"'`+"`")
+		if key == "" || value == "" {
+			continue
+		}
+		if _, exists := out[key]; !exists {
+			out[key] = value
+		}
+	}
+	return out
+}
+
+// NormalizeKey converts a key to lower snake_case.
+func NormalizeKey(key string) string {
+	key = strings.ToLower(strings.TrimSpace(key))
+	key = strings.NewReplacer(" ", "_", "-", "_").Replace(key)
+	return strings.Trim(key, "_")
+}
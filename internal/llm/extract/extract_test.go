@@ -0,0 +1,328 @@
+package extract_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/llm"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/llm/extract"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/llm/llmtest"
+)
+
+type decision struct {
+	Approved  bool     `yaml:"approved" llm:"required"`
+	Verdict   string   `yaml:"verdict" llm:"required,enum=keep|merge|delete"`
+	Reasoning string   `yaml:"reasoning"`
+	Matches   []string `yaml:"matches,omitempty"`
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    decision
+		wantErr string // Substring of the error, empty when decoding succeeds
+	}{
+		{
+			name: "fenced json",
+			text: "Decision:\n```json\n{\"approved\": true, \"verdict\": \"keep\", \"reasoning\": \"unique\"}\n```",
+			want: decision{Approved: true, Verdict: "keep", Reasoning: "unique"},
+		},
+		{
+			name: "fenced yaml",
+			text: "```yaml\napproved: false\nverdict: merge\nmatches: [faq-1, faq-2]\n```",
+			want: decision{Verdict: "merge", Matches: []string{"faq-1", "faq-2"}},
+		},
+		{
+			name: "inline json in prose",
+			text: `I looked at it. {"approved": true, "verdict": "delete", "reasoning": "has {braces} inside"} Done.`,
+			want: decision{Approved: true, Verdict: "delete", Reasoning: "has {braces} inside"},
+		},
+		{
+			name: "raw yaml",
+			text: "approved: true\nverdict: keep\n",
+			want: decision{Approved: true, Verdict: "keep"},
+		},
+		{
+			name: "key value prose with markdown",
+			text: "After review:\n- **Approved**: yes\n- **Verdict**: MERGE\n- Matches: faq-1, faq-2\nThe rest is commentary: nothing more.",
+			want: decision{Approved: true, Verdict: "merge", Matches: []string{"faq-1", "faq-2"}},
+		},
+		{
+			name: "enum is canonicalized",
+			text: "approved: no\nverdict: Keep",
+			want: decision{Verdict: "keep"},
+		},
+		{
+			name: "broken json block falls back to key value lines",
+			text: "```json\n{\"approved\": tru\n```\nApproved: yes\nVerdict: keep",
+			want: decision{Approved: true, Verdict: "keep"},
+		},
+		{
+			name:    "empty response",
+			text:    "",
+			wantErr: "no key: value lines",
+		},
+		{
+			name:    "truncated json",
+			text:    "```json\n{\"approved\": true, \"verd",
+			wantErr: "failed to parse",
+		},
+		{
+			name:    "missing required field",
+			text:    "approved: yes\nreasoning: looks unique",
+			wantErr: `missing required field "verdict"`,
+		},
+		{
+			name:    "value outside the enum",
+			text:    "approved: yes\nverdict: maybe",
+			wantErr: "expected one of keep, merge, delete",
+		},
+		{
+			name:    "uninterpretable boolean",
+			text:    "approved: perhaps\nverdict: keep",
+			wantErr: `cannot interpret "perhaps" as a boolean`,
+		},
+		{
+			name:    "prose without a decision",
+			text:    "I am not sure what to do with this item.",
+			wantErr: "no key: value lines",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extract.Decode[decision](tt.text)
+			if tt.wantErr != "" {
+				var perr *extract.ParseError
+				if !errors.As(err, &perr) {
+					t.Fatalf("error = %v, want a *ParseError", err)
+				}
+				if !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("error = %q, want it to contain %q", err, tt.wantErr)
+				}
+				if len(perr.Responses) != 1 || perr.Responses[0] != tt.text {
+					t.Errorf("Responses = %q, want the original text", perr.Responses)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decision = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeRecordsEveryStrategy(t *testing.T) {
+	_, err := extract.Decode[decision]("nothing useful")
+	var perr *extract.ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("error = %v, want a *ParseError", err)
+	}
+
+	var got []extract.Strategy
+	for _, a := range perr.Attempts {
+		got = append(got, a.Strategy)
+	}
+	want := []extract.Strategy{
+		extract.StrategyFencedJSON,
+		extract.StrategyFencedYAML,
+		extract.StrategyInlineJSON,
+		extract.StrategyRawYAML,
+		extract.StrategyKeyValue,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("attempted %v, want %v", got, want)
+	}
+}
+
+func TestDecodeRejectsNonStructTarget(t *testing.T) {
+	if _, err := extract.Decode[string]("approved: true"); err == nil || !strings.Contains(err.Error(), "not a struct") {
+		t.Errorf("error = %v, want a non-struct target error", err)
+	}
+}
+
+func TestKeyValues(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want map[string]string
+	}{
+		{
+			name: "plain lines",
+			text: "Approved: yes\nReasoning: unique question",
+			want: map[string]string{"approved": "yes", "reasoning": "unique question"},
+		},
+		{
+			name: "list markers, emphasis and quotes",
+			text: "* **Is Duplicate**: `no`\n- _Confidence_ = \"0.9\"\n• Best-Match: faq-7",
+			want: map[string]string{"is_duplicate": "no", "confidence": "0.9", "best_match": "faq-7"},
+		},
+		{
+			name: "first occurrence wins",
+			text: "verdict: keep\nverdict: delete",
+			want: map[string]string{"verdict": "keep"},
+		},
+		{
+			name: "empty values and non key lines are skipped",
+			text: "verdict:\n{\"approved\": true}\n42: answer\njust prose",
+			want: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := extract.KeyValues(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KeyValues = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnumLabel(t *testing.T) {
+	labels := []string{"duplicate", "unique"}
+	tests := []struct {
+		name      string
+		text      string
+		want      string
+		wantFound []string // Labels reported by the *EnumError when want is empty
+	}{
+		{name: "bare label", text: "Unique", want: "unique"},
+		{name: "fenced label", text: "```\n**duplicate**\n```", want: "duplicate"},
+		{name: "label line wins over prose", text: "It is not unique, because...\nduplicate.", want: "duplicate"},
+		{name: "single mention in prose", text: "This question is a duplicate of faq-12.", want: "duplicate"},
+		{name: "whole words only", text: "This is uniquely phrased but a DUPLICATE.", want: "duplicate"},
+		{name: "ambiguous", text: "Could be duplicate or unique.", wantFound: []string{"duplicate", "unique"}},
+		{name: "no label", text: "I cannot tell.", wantFound: nil},
+		{name: "truncated reply", text: "The answer is dupl", wantFound: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := extract.EnumLabel(tt.text, labels...)
+			if tt.want != "" {
+				if err != nil || got != tt.want {
+					t.Errorf("EnumLabel = %q, %v; want %q", got, err, tt.want)
+				}
+				return
+			}
+			var eerr *extract.EnumError
+			if !errors.As(err, &eerr) {
+				t.Fatalf("error = %v, want an *EnumError", err)
+			}
+			if !reflect.DeepEqual(eerr.Found, tt.wantFound) {
+				t.Errorf("Found = %v, want %v", eerr.Found, tt.wantFound)
+			}
+		})
+	}
+
+	if _, err := extract.EnumLabel("anything"); err == nil {
+		t.Error("expected an error without labels")
+	}
+}
+
+func TestSchema(t *testing.T) {
+	want := "approved: bool (required)\n" +
+		"verdict: one of keep|merge|delete (required)\n" +
+		"reasoning: string\n" +
+		"matches: []string\n"
+	if got := extract.Schema[decision](); got != want {
+		t.Errorf("Schema:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func newClient(t *testing.T, srv *llmtest.Server) *llm.Client {
+	t.Helper()
+	client, err := llm.NewClient(srv.Config())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestExecuteWithRepairReprompts(t *testing.T) {
+	srv := llmtest.NewServer(
+		"I'd approve it, the verdict is basically keep.",
+		"```yaml\napproved: true\nverdict: keep\n```",
+	)
+	defer srv.Close()
+
+	req := llm.NewRequest("ct_faq_dedup").With("new_item", "How do I reset?")
+	got, resp, err := extract.ExecuteWithRepair[decision](context.Background(), newClient(t, srv), req, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (decision{Approved: true, Verdict: "keep"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("decision = %+v, want %+v", got, want)
+	}
+	if resp.Text != "I'd approve it, the verdict is basically keep." {
+		t.Errorf("Response = %q, want the original reply", resp.Text)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("server got %d requests, want 2", len(requests))
+	}
+	repair := requests[1]
+	if repair.Endpoint != "ct_faq_dedup" {
+		t.Errorf("repair sent to %s, want the original endpoint", repair.Endpoint)
+	}
+	for _, section := range []string{"task:", "expected_format:", "parse_errors:", "previous_response:", "verdict: one of keep|merge|delete"} {
+		if !strings.Contains(repair.Input, section) {
+			t.Errorf("repair input lacks %q:\n%s", section, repair.Input)
+		}
+	}
+}
+
+func TestParserGivesUpAfterMaxRepairs(t *testing.T) {
+	srv := llmtest.NewServer("approved: yes", "approved: yes\nverdict: maybe")
+	defer srv.Close()
+
+	parser := &extract.Parser[decision]{Client: newClient(t, srv), RepairEndpoint: "ct_repair", MaxRepairs: 2}
+	_, err := parser.Parse(context.Background(), "ct_faq_dedup", "no decision here")
+
+	var perr *extract.ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("error = %v, want a *ParseError", err)
+	}
+	wantResponses := []string{"no decision here", "approved: yes", "approved: yes\nverdict: maybe"}
+	if !reflect.DeepEqual(perr.Responses, wantResponses) {
+		t.Errorf("Responses = %q, want %q", perr.Responses, wantResponses)
+	}
+	if last := perr.Attempts[len(perr.Attempts)-1]; last.Round != 2 {
+		t.Errorf("last attempt round = %d, want 2", last.Round)
+	}
+	if !strings.Contains(err.Error(), "repair 2") {
+		t.Errorf("error = %q, want it to mention the repair rounds", err)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("server got %d requests, want 2", len(requests))
+	}
+	for _, r := range requests {
+		if r.Endpoint != "ct_repair" {
+			t.Errorf("repair sent to %s, want ct_repair", r.Endpoint)
+		}
+	}
+	// The second repair prompt carries the first repair's response and problems
+	if !strings.Contains(requests[1].Input, "approved: yes") || !strings.Contains(requests[1].Input, "verdict") {
+		t.Errorf("second repair input lacks the previous problems:\n%s", requests[1].Input)
+	}
+}
+
+func TestParserWithoutRepair(t *testing.T) {
+	parser := &extract.Parser[decision]{}
+	if _, err := parser.Parse(context.Background(), "ct_faq_dedup", "no decision here"); err == nil {
+		t.Fatal("expected a parse error")
+	}
+
+	parser.MaxRepairs = 1
+	_, err := parser.Parse(context.Background(), "ct_faq_dedup", "no decision here")
+	if err == nil || !strings.Contains(err.Error(), "without an LLM client") {
+		t.Errorf("error = %v, want a missing client error", err)
+	}
+}
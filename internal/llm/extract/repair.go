@@ -0,0 +1,89 @@
+package extract
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/llm"
+)
+
+// repairInstructions is the task given to the LLM when asking for a corrected response.
+const repairInstructions = "Your previous response could not be parsed. " +
+	"Reply with only a ```yaml code block containing the fields in expected_format. " +
+	"Fix every problem listed in parse_errors and keep the original decision."
+
+// Parser decodes responses into T, asking the LLM to repair unparseable output.
+type Parser[T any] struct {
+	Client         *llm.Client // Required when MaxRepairs > 0
+	RepairEndpoint string      // Endpoint for repair prompts (default: the original endpoint)
+	MaxRepairs     int         // Repair re-prompts before giving up (0 disables repair)
+}
+
+// Parse decodes text, re-prompting up to MaxRepairs times on failure.
+// endpoint is the endpoint that produced text. On failure the error is a *ParseError
+// containing every attempt across the original and repair responses.
+func (p *Parser[T]) Parse(ctx context.Context, endpoint, text string) (T, error) {
+	var zero T
+	perr := &ParseError{Responses: []string{text}}
+
+	if value, ok := decodeRound[T](text, 0, perr); ok {
+		return value, nil
+	}
+
+	repairEndpoint := p.RepairEndpoint
+	if repairEndpoint == "" {
+		repairEndpoint = endpoint
+	}
+
+	for round := 1; round <= p.MaxRepairs; round++ {
+		if p.Client == nil {
+			return zero, fmt.Errorf("repair requested without an LLM client: %w", perr)
+		}
+
+		log.Printf("⚠️  LLM response from %s could not be parsed, requesting repair (%d/%d)",
+			endpoint, round, p.MaxRepairs)
+
+		req := llm.NewRequest(repairEndpoint).
+			With("task", repairInstructions).
+			With("expected_format", Schema[T]()).
+			With("parse_errors", perr.problems(round-1)).
+			With("previous_response", perr.Responses[round-1])
+
+		resp, err := p.Client.Execute(ctx, req)
+		if err != nil {
+			return zero, fmt.Errorf("repair request failed: %w (original: %v)", err, perr)
+		}
+		perr.Responses = append(perr.Responses, resp.Text)
+
+		if value, ok := decodeRound[T](resp.Text, round, perr); ok {
+			return value, nil
+		}
+	}
+
+	return zero, perr
+}
+
+// ParseFunc adapts Decode for llm.ExecuteInto (no repair).
+func ParseFunc[T any]() llm.ParseFunc[T] {
+	return Decode[T]
+}
+
+// ExecuteWithRepair sends req and decodes the reply into T, re-prompting the
+// same endpoint up to maxRepairs times when the reply cannot be parsed.
+// The returned Response is the original reply.
+func ExecuteWithRepair[T any](ctx context.Context, client *llm.Client, req *llm.Request, maxRepairs int) (T, *llm.Response, error) {
+	var zero T
+
+	resp, err := client.Execute(ctx, req)
+	if err != nil {
+		return zero, nil, err
+	}
+
+	parser := &Parser[T]{Client: client, MaxRepairs: maxRepairs}
+	value, err := parser.Parse(ctx, req.Endpoint, resp.Text)
+	if err != nil {
+		return zero, resp, err
+	}
+	return value, resp, nil
+}
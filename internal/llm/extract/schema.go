@@ -0,0 +1,139 @@
+package extract
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Struct tags understood by the validator, alongside yaml/json names:
+//
+//	type Decision struct {
+//	    Approved  bool     `yaml:"approved" llm:"required"`
+//	    Verdict   string   `yaml:"verdict" llm:"required,enum=keep|merge|delete"`
+//	    Reasoning string   `yaml:"reasoning"`
+//	    Matches   []string `yaml:"matches,omitempty"`
+//	}
+//
+// "required" means the key must appear in the response (false/0 are valid values).
+// "enum" restricts a string field; matching is case-insensitive and the value is
+// rewritten to the canonical label.
+
+// fieldSpec describes one top-level field of the target struct.
+type fieldSpec struct {
+	Name     string // Normalized key the LLM is expected to use
+	Index    int
+	Type     reflect.Type
+	Required bool
+	Enum     []string
+}
+
+// ValidationError lists every schema violation in a decoded response.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid response: " + strings.Join(e.Problems, "; ")
+}
+
+// structFields returns the field specs of a struct type.
+func structFields(t reflect.Type) []fieldSpec {
+	var specs []fieldSpec
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name := tagName(f.Tag.Get("yaml"))
+		if name == "" {
+			name = tagName(f.Tag.Get("json"))
+		}
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		spec := fieldSpec{Name: NormalizeKey(name), Index: i, Type: f.Type}
+		for _, opt := range strings.Split(f.Tag.Get("llm"), ",") {
+			opt = strings.TrimSpace(opt)
+			switch {
+			case opt == "required":
+				spec.Required = true
+			case strings.HasPrefix(opt, "enum="):
+				spec.Enum = strings.Split(strings.TrimPrefix(opt, "enum="), "|")
+			}
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// tagName returns the name part of a yaml/json struct tag.
+func tagName(tag string) string {
+	name, _, _ := strings.Cut(tag, ",")
+	return name
+}
+
+// validate checks required keys and enum values, canonicalizing enum fields in place.
+func validate(v reflect.Value, specs []fieldSpec, present map[string]bool) error {
+	var problems []string
+	for _, spec := range specs {
+		if spec.Required && !present[spec.Name] {
+			problems = append(problems, fmt.Sprintf("missing required field %q", spec.Name))
+			continue
+		}
+		if len(spec.Enum) == 0 || spec.Type.Kind() != reflect.String || !present[spec.Name] {
+			continue
+		}
+
+		field := v.Field(spec.Index)
+		value := strings.TrimSpace(field.String())
+		matched := false
+		for _, label := range spec.Enum {
+			if strings.EqualFold(value, label) {
+				field.SetString(label)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			problems = append(problems, fmt.Sprintf("field %q is %q, expected one of %s",
+				spec.Name, value, strings.Join(spec.Enum, ", ")))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// Schema describes T's expected fields for prompts, one "name: type" line per field:
+//
+//	approved: bool (required)
+//	verdict: one of keep|merge|delete (required)
+//	reasoning: string
+func Schema[T any]() string {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() != reflect.Struct {
+		return t.String()
+	}
+
+	var b strings.Builder
+	for _, spec := range structFields(t) {
+		typ := spec.Type.String()
+		if len(spec.Enum) > 0 {
+			typ = "one of " + strings.Join(spec.Enum, "|")
+		}
+		fmt.Fprintf(&b, "%s: %s", spec.Name, typ)
+		if spec.Required {
+			b.WriteString(" (required)")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,150 @@
+// Package llmtest provides a fake LLM execute endpoint for tests.
+//
+//	srv := llmtest.NewServer("approved: true\nreasoning: unique question")
+//	defer srv.Close()
+//
+//	client, _ := llm.NewClient(srv.Config())
+//	resp, _ := client.Execute(ctx, llm.NewRequest("ct_faq_dedup").With("new_item", item))
+//	srv.Requests()[0].Input // the YAML the client sent
+package llmtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/llm"
+)
+
+// Token is the bearer token the fake server expects.
+const Token = "llmtest-token"
+
+// Reply is a scripted response from the fake server.
+type Reply struct {
+	Text       string // LLM output placed in {"response": ...}
+	StatusCode int    // HTTP status (default 200)
+}
+
+// HandlerFunc computes a reply for a request.
+type HandlerFunc func(req RecordedRequest) Reply
+
+// RecordedRequest is a request received by the fake server.
+type RecordedRequest struct {
+	Endpoint    string
+	Environment string
+	Input       string // YAML input from the JSON body
+	Header      http.Header
+}
+
+// Server is an httptest server speaking the execute endpoint protocol.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	handler  HandlerFunc
+	requests []RecordedRequest
+}
+
+// NewServer replies with texts in order, repeating the last one once exhausted.
+func NewServer(texts ...string) *Server {
+	replies := make([]Reply, len(texts))
+	for i, t := range texts {
+		replies[i] = Reply{Text: t}
+	}
+	return NewServerReplies(replies...)
+}
+
+// NewServerReplies replies with scripted replies in order, repeating the last one.
+// Use non-200 status codes to exercise retries.
+func NewServerReplies(replies ...Reply) *Server {
+	var mu sync.Mutex
+	next := 0
+	return NewServerFunc(func(RecordedRequest) Reply {
+		mu.Lock()
+		defer mu.Unlock()
+		if len(replies) == 0 {
+			return Reply{}
+		}
+		reply := replies[min(next, len(replies)-1)]
+		next++
+		return reply
+	})
+}
+
+// NewServerFunc computes every reply with fn.
+func NewServerFunc(fn HandlerFunc) *Server {
+	s := &Server{handler: fn}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Config returns a client configuration pointing at the fake server
+// with fast retries.
+func (s *Server) Config() llm.Config {
+	return llm.Config{
+		BaseURL:     s.URL,
+		Environment: "prod",
+		Token:       Token,
+		Timeout:     5 * time.Second,
+		MaxRetries:  3,
+		RetryDelay:  time.Millisecond,
+		HTTPClient:  s.Client(),
+	}
+}
+
+// Requests returns all requests received so far.
+func (s *Server) Requests() []RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]RecordedRequest(nil), s.requests...)
+}
+
+// serveHTTP implements POST /api/execute/{endpoint}/{environment}.
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if r.Method != http.MethodPost || len(parts) != 4 || parts[0] != "api" || parts[1] != "execute" {
+		http.NotFound(w, r)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+Token {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid token"})
+		return
+	}
+
+	var body struct {
+		Input string `json:"input"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid JSON: " + err.Error()})
+		return
+	}
+
+	req := RecordedRequest{
+		Endpoint:    parts[2],
+		Environment: parts[3],
+		Input:       body.Input,
+		Header:      r.Header.Clone(),
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+
+	reply := s.handler(req)
+	if reply.StatusCode == 0 {
+		reply.StatusCode = http.StatusOK
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(reply.StatusCode)
+	if reply.StatusCode != http.StatusOK {
+		json.NewEncoder(w).Encode(map[string]string{"error": reply.Text})
+		return
+	}
+	json.NewEncoder(w).Encode(map[string]string{"response": reply.Text})
+}
@@ -0,0 +1,90 @@
+package llm
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Request is an LLM call whose input is built from named YAML sections.
+// Sections keep their insertion order so prompts read top to bottom:
+//
+//	req := llm.NewRequest("ct_faq_dedup").
+//	    With("new_item", newItem).
+//	    With("similar_in_production", similar)
+//
+// Section values are any Go values with yaml tags (use omitempty to keep input small).
+type Request struct {
+	Endpoint string
+	sections []section
+}
+
+// section is a top-level YAML key and its value.
+type section struct {
+	key   string
+	value interface{}
+}
+
+// NewRequest creates a request for the named endpoint (e.g. "ct_faq_dedup").
+func NewRequest(endpoint string) *Request {
+	return &Request{Endpoint: endpoint}
+}
+
+// NewRequestFrom creates a request whose input is a single struct or map.
+// The value's own YAML representation becomes the full input.
+func NewRequestFrom(endpoint string, input interface{}) *Request {
+	return &Request{Endpoint: endpoint, sections: []section{{value: input}}}
+}
+
+// With adds a top-level section. Adding an existing key replaces its value.
+func (r *Request) With(key string, value interface{}) *Request {
+	for i := range r.sections {
+		if r.sections[i].key == key {
+			r.sections[i].value = value
+			return r
+		}
+	}
+	r.sections = append(r.sections, section{key: key, value: value})
+	return r
+}
+
+// YAML renders the request input.
+func (r *Request) YAML() (string, error) {
+	if len(r.sections) == 0 {
+		return "", fmt.Errorf("request for %s has no input", r.Endpoint)
+	}
+
+	// A single unnamed section is the whole document
+	if len(r.sections) == 1 && r.sections[0].key == "" {
+		return marshalYAML(r.sections[0].value)
+	}
+
+	doc := &yaml.Node{Kind: yaml.MappingNode}
+	for _, s := range r.sections {
+		var value yaml.Node
+		if err := value.Encode(s.value); err != nil {
+			return "", fmt.Errorf("failed to marshal YAML section %s: %w", s.key, err)
+		}
+		doc.Content = append(doc.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Value: s.key},
+			&value,
+		)
+	}
+
+	return marshalYAML(doc)
+}
+
+// marshalYAML encodes v with two-space indentation, which keeps prompts compact.
+func marshalYAML(v interface{}) (string, error) {
+	var buf strings.Builder
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return "", fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+	return buf.String(), nil
+}
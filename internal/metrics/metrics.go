@@ -0,0 +1,442 @@
+// Package metrics collects counters, gauges and histograms and writes them in
+// the Prometheus text exposition format, served at /metrics. It has no
+// dependencies so any package can record metrics without import cycles.
+//
+//	var requests = metrics.DefaultRegistry.NewCounter(
+//	    "myapp_requests_total", "Requests handled.", "outcome")
+//
+//	requests.With("success").Inc()
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ContentType is the media type of the text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultBuckets suit request latencies in seconds (5ms to 10s).
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// collector writes one or more metric families.
+type collector interface {
+	name() string
+	write(b *bytes.Buffer)
+}
+
+// Registry holds the metrics exposed together.
+type Registry struct {
+	mu         sync.Mutex
+	collectors map[string]collector
+}
+
+// DefaultRegistry holds the worker's metrics and is served at /metrics.
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]collector)}
+}
+
+// register adds c, panicking on duplicate names like other programming errors
+// at startup.
+func (r *Registry) register(c collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.collectors[c.name()]; ok {
+		panic("metrics: duplicate metric " + c.name())
+	}
+	r.collectors[c.name()] = c
+}
+
+// WriteText writes every metric in the text exposition format, sorted by name.
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.Lock()
+	collectors := make([]collector, 0, len(r.collectors))
+	for _, c := range r.collectors {
+		collectors = append(collectors, c)
+	}
+	r.mu.Unlock()
+	sort.Slice(collectors, func(i, j int) bool { return collectors[i].name() < collectors[j].name() })
+
+	var b bytes.Buffer
+	for _, c := range collectors {
+		c.write(&b)
+	}
+	_, err := w.Write(b.Bytes())
+	return err
+}
+
+// Handler serves the registry for Prometheus scrapes.
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		w.Header().Set("Cache-Control", "no-store")
+		_ = r.WriteText(w)
+	})
+}
+
+// vec holds the labelled series of one metric.
+type vec[T any] struct {
+	metric string
+	help   string
+	labels []string
+
+	mu     sync.Mutex
+	series map[string]*T
+	values map[string][]string // Label values by series key
+	create func() *T
+}
+
+func newVec[T any](name, help string, labels []string, create func() *T) *vec[T] {
+	return &vec[T]{
+		metric: name,
+		help:   help,
+		labels: labels,
+		series: make(map[string]*T),
+		values: make(map[string][]string),
+		create: create,
+	}
+}
+
+func (v *vec[T]) name() string { return v.metric }
+
+// get returns the series for the label values, creating it on first use.
+func (v *vec[T]) get(values []string) *T {
+	if len(values) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: %s wants %d label values, got %d", v.metric, len(v.labels), len(values)))
+	}
+	key := strings.Join(values, "\xff")
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	s, ok := v.series[key]
+	if !ok {
+		s = v.create()
+		v.series[key] = s
+		v.values[key] = append([]string(nil), values...)
+	}
+	return s
+}
+
+// each calls fn for every series in label order.
+func (v *vec[T]) each(fn func(labels string, s *T)) {
+	v.mu.Lock()
+	keys := make([]string, 0, len(v.series))
+	for key := range v.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	series := make([]*T, len(keys))
+	labels := make([]string, len(keys))
+	for i, key := range keys {
+		series[i] = v.series[key]
+		labels[i] = formatLabels(v.labels, v.values[key])
+	}
+	v.mu.Unlock()
+
+	for i := range keys {
+		fn(labels[i], series[i])
+	}
+}
+
+// value is a float that is safe for concurrent use.
+type value struct {
+	mu sync.Mutex
+	v  float64
+}
+
+func (v *value) add(delta float64) {
+	v.mu.Lock()
+	v.v += delta
+	v.mu.Unlock()
+}
+
+func (v *value) set(x float64) {
+	v.mu.Lock()
+	v.v = x
+	v.mu.Unlock()
+}
+
+func (v *value) get() float64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.v
+}
+
+// Counter is a value that only goes up, e.g. requests served.
+type Counter struct{ vec *vec[value] }
+
+// CounterSeries is one labelled series of a counter.
+type CounterSeries struct{ v *value }
+
+// NewCounter creates a counter with the given label names.
+func (r *Registry) NewCounter(name, help string, labels ...string) *Counter {
+	c := &Counter{vec: newVec(name, help, labels, func() *value { return &value{} })}
+	r.register(c)
+	return c
+}
+
+// With returns the series for the label values, in the order the labels were declared.
+func (c *Counter) With(values ...string) CounterSeries {
+	return CounterSeries{c.vec.get(values)}
+}
+
+// Inc adds one.
+func (s CounterSeries) Inc() { s.v.add(1) }
+
+// Add adds delta, which must not be negative.
+func (s CounterSeries) Add(delta float64) {
+	if delta < 0 {
+		panic("metrics: counter cannot decrease")
+	}
+	s.v.add(delta)
+}
+
+// Value returns the current count.
+func (s CounterSeries) Value() float64 { return s.v.get() }
+
+func (c *Counter) name() string { return c.vec.name() }
+
+func (c *Counter) write(b *bytes.Buffer) {
+	writeHeader(b, c.vec.metric, c.vec.help, "counter")
+	c.vec.each(func(labels string, v *value) {
+		writeSample(b, c.vec.metric, labels, v.get())
+	})
+}
+
+// Gauge is a value that goes up and down, e.g. items in a queue.
+type Gauge struct{ vec *vec[value] }
+
+// GaugeSeries is one labelled series of a gauge.
+type GaugeSeries struct{ v *value }
+
+// NewGauge creates a gauge with the given label names.
+func (r *Registry) NewGauge(name, help string, labels ...string) *Gauge {
+	g := &Gauge{vec: newVec(name, help, labels, func() *value { return &value{} })}
+	r.register(g)
+	return g
+}
+
+// With returns the series for the label values, in the order the labels were declared.
+func (g *Gauge) With(values ...string) GaugeSeries {
+	return GaugeSeries{g.vec.get(values)}
+}
+
+// Set replaces the value.
+func (s GaugeSeries) Set(v float64) { s.v.set(v) }
+
+// Add adds delta, which may be negative.
+func (s GaugeSeries) Add(delta float64) { s.v.add(delta) }
+
+// Value returns the current value.
+func (s GaugeSeries) Value() float64 { return s.v.get() }
+
+func (g *Gauge) name() string { return g.vec.name() }
+
+func (g *Gauge) write(b *bytes.Buffer) {
+	writeHeader(b, g.vec.metric, g.vec.help, "gauge")
+	g.vec.each(func(labels string, v *value) {
+		writeSample(b, g.vec.metric, labels, v.get())
+	})
+}
+
+// Histogram counts observations in buckets, e.g. request durations.
+type Histogram struct {
+	vec     *vec[histogramSeries]
+	buckets []float64
+}
+
+// histogramSeries holds the bucket counts of one labelled series.
+type histogramSeries struct {
+	mu     sync.Mutex
+	counts []uint64 // Per bucket, not cumulative; the last is +Inf
+	sum    float64
+	count  uint64
+}
+
+// HistogramSeries is one labelled series of a histogram.
+type HistogramSeries struct {
+	s       *histogramSeries
+	buckets []float64
+}
+
+// NewHistogram creates a histogram with the given upper bucket bounds
+// (DefaultBuckets when nil) and label names.
+func (r *Registry) NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	if buckets == nil {
+		buckets = DefaultBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	h := &Histogram{buckets: buckets}
+	h.vec = newVec(name, help, labels, func() *histogramSeries {
+		return &histogramSeries{counts: make([]uint64, len(buckets)+1)}
+	})
+	r.register(h)
+	return h
+}
+
+// With returns the series for the label values, in the order the labels were declared.
+func (h *Histogram) With(values ...string) HistogramSeries {
+	return HistogramSeries{s: h.vec.get(values), buckets: h.buckets}
+}
+
+// Observe records one value.
+func (s HistogramSeries) Observe(v float64) {
+	i := sort.SearchFloat64s(s.buckets, v) // First bucket with bound >= v
+	s.s.mu.Lock()
+	s.s.counts[i]++
+	s.s.sum += v
+	s.s.count++
+	s.s.mu.Unlock()
+}
+
+// ObserveDuration records d in seconds.
+func (s HistogramSeries) ObserveDuration(d time.Duration) {
+	s.Observe(d.Seconds())
+}
+
+// Totals returns the number of observations and their sum.
+func (s HistogramSeries) Totals() (count uint64, sum float64) {
+	s.s.mu.Lock()
+	defer s.s.mu.Unlock()
+	return s.s.count, s.s.sum
+}
+
+func (h *Histogram) name() string { return h.vec.name() }
+
+func (h *Histogram) write(b *bytes.Buffer) {
+	writeHeader(b, h.vec.metric, h.vec.help, "histogram")
+	h.vec.each(func(labels string, s *histogramSeries) {
+		s.mu.Lock()
+		counts := append([]uint64(nil), s.counts...)
+		sum, count := s.sum, s.count
+		s.mu.Unlock()
+
+		var cumulative uint64
+		for i, bound := range h.buckets {
+			cumulative += counts[i]
+			writeSample(b, h.vec.metric+"_bucket", withLabel(labels, "le", formatFloat(bound)), float64(cumulative))
+		}
+		writeSample(b, h.vec.metric+"_bucket", withLabel(labels, "le", "+Inf"), float64(count))
+		writeSample(b, h.vec.metric+"_sum", labels, sum)
+		writeSample(b, h.vec.metric+"_count", labels, float64(count))
+	})
+}
+
+// funcCollector writes an unlabelled value read at scrape time.
+type funcCollector struct {
+	metric, help, typ string
+	fn                func() float64
+}
+
+// NewGaugeFunc exposes the value returned by fn at scrape time.
+func (r *Registry) NewGaugeFunc(name, help string, fn func() float64) {
+	r.register(&funcCollector{metric: name, help: help, typ: "gauge", fn: fn})
+}
+
+// NewCounterFunc exposes a monotonic value kept elsewhere, read at scrape time.
+func (r *Registry) NewCounterFunc(name, help string, fn func() float64) {
+	r.register(&funcCollector{metric: name, help: help, typ: "counter", fn: fn})
+}
+
+func (f *funcCollector) name() string { return f.metric }
+
+func (f *funcCollector) write(b *bytes.Buffer) {
+	writeHeader(b, f.metric, f.help, f.typ)
+	writeSample(b, f.metric, "", f.fn())
+}
+
+// Sample is one labelled series returned by a vector func, with the label
+// values in the order the labels were declared.
+type Sample struct {
+	Labels []string
+	Value  float64
+}
+
+// vecFuncCollector writes labelled values read at scrape time.
+type vecFuncCollector struct {
+	metric, help, typ string
+	labels            []string
+	fn                func() []Sample
+}
+
+// NewGaugeVecFunc exposes the series returned by fn at scrape time, e.g. one
+// per rate limiter.
+func (r *Registry) NewGaugeVecFunc(name, help string, labels []string, fn func() []Sample) {
+	r.register(&vecFuncCollector{metric: name, help: help, typ: "gauge", labels: labels, fn: fn})
+}
+
+// NewCounterVecFunc exposes monotonic series kept elsewhere, read at scrape time.
+func (r *Registry) NewCounterVecFunc(name, help string, labels []string, fn func() []Sample) {
+	r.register(&vecFuncCollector{metric: name, help: help, typ: "counter", labels: labels, fn: fn})
+}
+
+func (f *vecFuncCollector) name() string { return f.metric }
+
+func (f *vecFuncCollector) write(b *bytes.Buffer) {
+	writeHeader(b, f.metric, f.help, f.typ)
+	for _, s := range f.fn() {
+		if len(s.Labels) != len(f.labels) {
+			panic(fmt.Sprintf("metrics: %s wants %d label values, got %d", f.metric, len(f.labels), len(s.Labels)))
+		}
+		writeSample(b, f.metric, formatLabels(f.labels, s.Labels), s.Value)
+	}
+}
+
+func writeHeader(b *bytes.Buffer, name, help, typ string) {
+	help = strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help)
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+func writeSample(b *bytes.Buffer, name, labels string, v float64) {
+	b.WriteString(name)
+	if labels != "" {
+		b.WriteString("{" + labels + "}")
+	}
+	b.WriteByte(' ')
+	b.WriteString(formatFloat(v))
+	b.WriteByte('\n')
+}
+
+// formatLabels renders name="value" pairs without the braces.
+func formatLabels(names, values []string) string {
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + `="` + escapeLabel(values[i]) + `"`
+	}
+	return strings.Join(pairs, ",")
+}
+
+func withLabel(labels, name, value string) string {
+	pair := name + `="` + escapeLabel(value) + `"`
+	if labels == "" {
+		return pair
+	}
+	return labels + "," + pair
+}
+
+func escapeLabel(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
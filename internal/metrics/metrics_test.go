@@ -0,0 +1,106 @@
+package metrics_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+)
+
+func TestWriteText(t *testing.T) {
+	reg := metrics.NewRegistry()
+
+	requests := reg.NewCounter("app_requests_total", "Requests handled.", "method", "outcome")
+	requests.With("POST", "success").Add(2)
+	requests.With("GET", "success").Inc()
+	requests.With("GET", `say "hi"\`+"\n").Inc()
+
+	queue := reg.NewGauge("app_queue_depth", "Items waiting.\nPer queue.")
+	queue.With().Set(3)
+	queue.With().Add(-1.5)
+
+	latency := reg.NewHistogram("app_latency_seconds", "Request latency.", []float64{1, 0.1}, "route")
+	latency.With("/a").Observe(0.05)
+	latency.With("/a").Observe(0.1)
+	latency.With("/a").ObserveDuration(2 * time.Second)
+
+	reg.NewGaugeFunc("app_uptime_seconds", "Seconds since start.", func() float64 { return 42 })
+	reg.NewCounterVecFunc("app_retries_total", "Retries by upstream.", []string{"upstream"}, func() []metrics.Sample {
+		return []metrics.Sample{{Labels: []string{"openai"}, Value: 7}, {Labels: []string{"llm"}, Value: 0}}
+	})
+
+	// Sorted by metric name; series sorted by label values; histogram buckets
+	// are cumulative and sorted
+	want := `# HELP app_latency_seconds Request latency.
+# TYPE app_latency_seconds histogram
+app_latency_seconds_bucket{route="/a",le="0.1"} 2
+app_latency_seconds_bucket{route="/a",le="1"} 2
+app_latency_seconds_bucket{route="/a",le="+Inf"} 3
+app_latency_seconds_sum{route="/a"} 2.15
+app_latency_seconds_count{route="/a"} 3
+# HELP app_queue_depth Items waiting.\nPer queue.
+# TYPE app_queue_depth gauge
+app_queue_depth 1.5
+# HELP app_requests_total Requests handled.
+# TYPE app_requests_total counter
+app_requests_total{method="GET",outcome="say \"hi\"\\\n"} 1
+app_requests_total{method="GET",outcome="success"} 1
+app_requests_total{method="POST",outcome="success"} 2
+# HELP app_retries_total Retries by upstream.
+# TYPE app_retries_total counter
+app_retries_total{upstream="openai"} 7
+app_retries_total{upstream="llm"} 0
+# HELP app_uptime_seconds Seconds since start.
+# TYPE app_uptime_seconds gauge
+app_uptime_seconds 42
+`
+	var b strings.Builder
+	if err := reg.WriteText(&b); err != nil {
+		t.Fatal(err)
+	}
+	if got := b.String(); got != want {
+		t.Errorf("exposition:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	reg := metrics.NewRegistry()
+	reg.NewGaugeFunc("app_up", "Whether the app is up.", func() float64 { return 1 })
+
+	rec := httptest.NewRecorder()
+	reg.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if ct := rec.Header().Get("Content-Type"); ct != metrics.ContentType {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if !strings.Contains(rec.Body.String(), "\napp_up 1\n") {
+		t.Errorf("body:\n%s", rec.Body.String())
+	}
+}
+
+func TestMisuse(t *testing.T) {
+	tests := map[string]func(reg *metrics.Registry){
+		"duplicate name": func(reg *metrics.Registry) {
+			reg.NewCounter("app_total", "")
+			reg.NewGauge("app_total", "")
+		},
+		"wrong label count": func(reg *metrics.Registry) {
+			reg.NewCounter("app_total", "", "a", "b").With("a")
+		},
+		"negative counter": func(reg *metrics.Registry) {
+			reg.NewCounter("app_total", "").With().Add(-1)
+		},
+	}
+	for name, misuse := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected a panic")
+				}
+			}()
+			misuse(metrics.NewRegistry())
+		})
+	}
+}
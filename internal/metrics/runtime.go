@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// runtimeCollector writes Go runtime and process metrics. Memory statistics
+// are read once per scrape since ReadMemStats briefly stops the world.
+type runtimeCollector struct {
+	start time.Time
+
+	mu    sync.Mutex
+	stats runtime.MemStats
+}
+
+func (c *runtimeCollector) name() string { return "go_" }
+
+func (c *runtimeCollector) write(b *bytes.Buffer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	runtime.ReadMemStats(&c.stats)
+	m := &c.stats
+
+	gauge := func(name, help string, v float64) {
+		writeHeader(b, name, help, "gauge")
+		writeSample(b, name, "", v)
+	}
+	counter := func(name, help string, v float64) {
+		writeHeader(b, name, help, "counter")
+		writeSample(b, name, "", v)
+	}
+
+	writeHeader(b, "go_info", "Go version the worker was built with.", "gauge")
+	writeSample(b, "go_info", withLabel("", "version", runtime.Version()), 1)
+	gauge("go_goroutines", "Number of goroutines.", float64(runtime.NumGoroutine()))
+	gauge("go_gomaxprocs", "Value of GOMAXPROCS.", float64(runtime.GOMAXPROCS(0)))
+	gauge("go_memstats_heap_alloc_bytes", "Bytes of allocated heap objects.", float64(m.HeapAlloc))
+	gauge("go_memstats_heap_inuse_bytes", "Bytes in in-use heap spans.", float64(m.HeapInuse))
+	gauge("go_memstats_heap_objects", "Number of allocated heap objects.", float64(m.HeapObjects))
+	gauge("go_memstats_sys_bytes", "Bytes of memory obtained from the OS.", float64(m.Sys))
+	counter("go_memstats_alloc_bytes_total", "Total bytes allocated for heap objects.", float64(m.TotalAlloc))
+	counter("go_gc_cycles_total", "Completed GC cycles.", float64(m.NumGC))
+	counter("go_gc_pause_seconds_total", "Total stop-the-world GC pause time.", time.Duration(m.PauseTotalNs).Seconds())
+	gauge("process_start_time_seconds", "Start time of the process since the Unix epoch.", float64(c.start.UnixNano())/1e9)
+}
+
+func init() {
+	DefaultRegistry.register(&runtimeCollector{start: time.Now()})
+}
@@ -0,0 +1,159 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+)
+
+// Outcome label values.
+const (
+	OutcomeSuccess = "success"
+	OutcomeError   = "error"
+	OutcomePanic   = "panic"
+)
+
+// longBuckets suit jobs and tasks, which run from milliseconds to minutes.
+var longBuckets = []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300, 900}
+
+// Worker metrics in DefaultRegistry.
+var (
+	functionInvocations = DefaultRegistry.NewCounter("worker_function_invocations_total",
+		"Worker function invocations.", "function", "version", "outcome")
+	functionDuration = DefaultRegistry.NewHistogram("worker_function_duration_seconds",
+		"Worker function run time.", DefaultBuckets, "function", "version")
+	functionsInFlight = DefaultRegistry.NewGauge("worker_function_in_flight",
+		"Worker function invocations currently running.", "function", "version")
+
+	jobRuns = DefaultRegistry.NewCounter("worker_job_runs_total",
+		"Job runs.", "job", "outcome")
+	jobDuration = DefaultRegistry.NewHistogram("worker_job_duration_seconds",
+		"Job run time.", longBuckets, "job")
+
+	taskRuns = DefaultRegistry.NewCounter("worker_task_runs_total",
+		"Task runs.", "task", "outcome")
+	taskDuration = DefaultRegistry.NewHistogram("worker_task_duration_seconds",
+		"Task run time.", longBuckets, "task")
+
+	httpRequests = DefaultRegistry.NewCounter("http_requests_total",
+		"HTTP requests by route pattern and status code.", "method", "route", "status")
+	httpDuration = DefaultRegistry.NewHistogram("http_request_duration_seconds",
+		"HTTP request latency by route pattern.", DefaultBuckets, "method", "route")
+
+	embeddingRequests = DefaultRegistry.NewCounter("worker_embedding_requests_total",
+		"Embedding API calls.", "model", "outcome")
+	embeddingTokens = DefaultRegistry.NewCounter("worker_embedding_tokens_total",
+		"Tokens billed for embeddings.", "model")
+	embeddingCost = DefaultRegistry.NewCounter("worker_embedding_cost_usd_total",
+		"Estimated embedding cost in USD.", "model")
+)
+
+// outcome maps an error to the outcome label.
+func outcome(err error) string {
+	if err != nil {
+		return OutcomeError
+	}
+	return OutcomeSuccess
+}
+
+// InitFunction creates the series of a registered function at zero, so it is
+// listed before its first invocation.
+func InitFunction(name, version string) {
+	for _, o := range []string{OutcomeSuccess, OutcomeError, OutcomePanic} {
+		functionInvocations.With(name, version, o)
+	}
+	functionDuration.With(name, version)
+	functionsInFlight.With(name, version)
+}
+
+// FunctionStats are the invocation counts of one function since startup.
+type FunctionStats struct {
+	Success       uint64  `json:"success"`
+	Errors        uint64  `json:"errors"`
+	Panics        uint64  `json:"panics"`
+	InFlight      int     `json:"in_flight"`
+	AvgDurationMs float64 `json:"avg_duration_ms"`
+}
+
+// Function returns the invocation counts recorded for a function.
+func Function(name, version string) FunctionStats {
+	count, sum := functionDuration.With(name, version).Totals()
+	stats := FunctionStats{
+		Success:  uint64(functionInvocations.With(name, version, OutcomeSuccess).Value()),
+		Errors:   uint64(functionInvocations.With(name, version, OutcomeError).Value()),
+		Panics:   uint64(functionInvocations.With(name, version, OutcomePanic).Value()),
+		InFlight: int(functionsInFlight.With(name, version).Value()),
+	}
+	if count > 0 {
+		stats.AvgDurationMs = sum / float64(count) * 1000
+	}
+	return stats
+}
+
+// ObserveFunction records one worker function invocation.
+func ObserveFunction(name, version, outcome string, d time.Duration) {
+	functionInvocations.With(name, version, outcome).Inc()
+	functionDuration.With(name, version).ObserveDuration(d)
+}
+
+// Instrument wraps a worker function handler so every call is counted and timed:
+//
+//	sdk.NewSimpleFunction[Input, Output]("greeting", "1.0.0", "...").
+//	    WithHandler(metrics.Instrument("greeting", "1.0.0", handle))
+//
+// The SDK's function types can't be wrapped from outside the SDK, so the
+// handler is instrumented instead.
+func Instrument[In, Out any](name, version string, handler func(In) (Out, error)) func(In) (Out, error) {
+	return func(input In) (out Out, err error) {
+		start := time.Now()
+		inFlight := functionsInFlight.With(name, version)
+		inFlight.Add(1)
+		defer func() {
+			inFlight.Add(-1)
+			if p := recover(); p != nil {
+				ObserveFunction(name, version, OutcomePanic, time.Since(start))
+				panic(p)
+			}
+			ObserveFunction(name, version, outcome(err), time.Since(start))
+		}()
+		return handler(input)
+	}
+}
+
+// InstrumentFull is Instrument for sdk.NewFunction handlers, which also receive
+// the event message and global state.
+func InstrumentFull[In, Out, Event, State any](name, version string, handler func(In, Event, State) (Out, error)) func(In, Event, State) (Out, error) {
+	return func(input In, event Event, state State) (Out, error) {
+		return Instrument(name, version, func(input In) (Out, error) {
+			return handler(input, event, state)
+		})(input)
+	}
+}
+
+// ObserveJob records one job run.
+func ObserveJob(job string, d time.Duration, err error) {
+	jobRuns.With(job, outcome(err)).Inc()
+	jobDuration.With(job).ObserveDuration(d)
+}
+
+// ObserveTask records one task run.
+func ObserveTask(task string, d time.Duration, err error) {
+	taskRuns.With(task, outcome(err)).Inc()
+	taskDuration.With(task).ObserveDuration(d)
+}
+
+// ObserveHTTP records one HTTP request. route is the matched ServeMux pattern
+// (not the raw path) so the number of series stays bounded.
+func ObserveHTTP(method, route string, status int, d time.Duration) {
+	httpRequests.With(method, route, strconv.Itoa(status)).Inc()
+	httpDuration.With(method, route).ObserveDuration(d)
+}
+
+// ObserveEmbedding records one embedding API call with the tokens it was
+// billed for and their estimated cost.
+func ObserveEmbedding(model string, tokens int, costUSD float64, err error) {
+	embeddingRequests.With(model, outcome(err)).Inc()
+	if err == nil {
+		embeddingTokens.With(model).Add(float64(tokens))
+		embeddingCost.With(model).Add(costUSD)
+	}
+}
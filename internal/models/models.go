@@ -12,11 +12,13 @@
 // If you're not using a database, you can delete this entire package.
 package models
 
-import (
-	"time"
-	// Uncomment if using GORM
-	// "gorm.io/gorm"
-)
+// Uncomment when you enable the example models below
+// import (
+// 	"time"
+//
+// 	"github.com/pgvector/pgvector-go"
+// 	"gorm.io/gorm"
+// )
 
 // AllModels returns all models to be registered with GORM AutoMigrate.
 // Add any new models to this list to automatically create/update their tables.
@@ -101,6 +103,28 @@ func AllModels() []interface{} {
 // 	CreatedAt time.Time `json:"created_at"`
 // }
 
+// Example Model 4: Document with an embedding column
+// Demonstrates storing pgvector embeddings alongside regular fields
+//
+// type Document struct {
+// 	ID      uint   `gorm:"primaryKey;autoIncrement" json:"id"`
+// 	Title   string `gorm:"size:255" json:"title"`
+// 	Content string `gorm:"type:text" json:"content"`
+//
+// 	// Embedding column (pgvector). The dimension must match your embedding model.
+// 	// AutoMigrate creates the column; pgvector.MigrateColumn adds the ANN index:
+// 	//   pgvector.MigrateColumn(ctx, db, pgvector.ColumnSpec{
+// 	//       Table: "documents", Column: "embedding", Dimension: 1536, Metric: vectorstore.Cosine,
+// 	//   })
+// 	Embedding *pgvector.Vector `gorm:"type:vector(1536)" json:"-"`
+//
+// 	CreatedAt time.Time `json:"created_at"`
+// 	UpdatedAt time.Time `json:"updated_at"`
+// }
+//
+// For a ready-made table keyed by string IDs with jsonb metadata, use the
+// vector store instead (internal/vectorstore/pgvector, VECTOR_STORE_BACKEND=pgvector).
+
 // Common GORM Tags Reference:
 //
 // Field Constraints:
@@ -0,0 +1,229 @@
+// Package openapi generates an OpenAPI 3.1 document from the routes registered
+// on a frontend.Router. Routes described with frontend.Describe contribute their
+// summary, request/response types and status codes; other routes are listed
+// with a generic response.
+//
+//	doc := openapi.Generate(router.Routes(), openapi.Info{Title: "Worker API", Version: "1.0.0"})
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+)
+
+// Version is the OpenAPI version of generated documents.
+const Version = "3.1.0"
+
+// Document is an OpenAPI document.
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+
+	schemas *schemas
+}
+
+// Info describes the API.
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem maps lower-case HTTP methods to operations.
+type PathItem map[string]*Operation
+
+// Operation is one method on one path.
+type Operation struct {
+	OperationID string               `json:"operationId"`
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+// Parameter is a path parameter, e.g. {id}.
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody is a JSON request body.
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response is one documented response.
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a body.
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the schemas referenced by operations.
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+// pathParam matches ServeMux wildcards: {id} and {path...}.
+var pathParam = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)(\.\.\.)?\}`)
+
+// Generate builds a document from registered routes. Routes registered without
+// a method (matching every method) are omitted.
+func Generate(routes []frontend.Route, info Info) *Document {
+	s := newSchemas()
+	// Shared error schemas get short names
+	s.names[reflect.TypeOf(httpapi.FieldError{})] = "FieldError"
+	s.components["FieldError"] = s.structSchema(reflect.TypeOf(httpapi.FieldError{}))
+	s.names[reflect.TypeOf(httpapi.Problem{})] = "Problem"
+	s.components["Problem"] = s.structSchema(reflect.TypeOf(httpapi.Problem{}))
+
+	doc := &Document{
+		OpenAPI:    Version,
+		Info:       info,
+		Paths:      make(map[string]*PathItem),
+		Components: Components{Schemas: s.components},
+		schemas:    s,
+	}
+
+	for _, route := range routes {
+		if route.Method == "" {
+			continue
+		}
+		path := pathParam.ReplaceAllString(strings.TrimSuffix(route.Path, "{$}"), "{$1}")
+		item := doc.Paths[path]
+		if item == nil {
+			item = &PathItem{}
+			doc.Paths[path] = item
+		}
+		(*item)[strings.ToLower(route.Method)] = operation(s, route, path)
+	}
+	return doc
+}
+
+// operation documents one route.
+func operation(s *schemas, route frontend.Route, path string) *Operation {
+	doc := frontend.RouteDoc{}
+	if route.Doc != nil {
+		doc = *route.Doc
+	}
+
+	op := &Operation{
+		OperationID: doc.OperationID,
+		Summary:     doc.Summary,
+		Description: doc.Description,
+		Tags:        doc.Tags,
+		Responses:   make(map[string]*Response),
+	}
+	if op.OperationID == "" {
+		op.OperationID = OperationID(route.Method, path)
+	}
+
+	for _, match := range pathParam.FindAllStringSubmatch(path, -1) {
+		op.Parameters = append(op.Parameters, Parameter{Name: match[1], In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+
+	errorCodes := append([]int(nil), doc.Errors...)
+	if doc.Input != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]MediaType{"application/json": {Schema: s.For(doc.Input)}},
+		}
+		// Returned by httpapi.Decode
+		errorCodes = append(errorCodes, http.StatusBadRequest, http.StatusRequestEntityTooLarge,
+			http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity)
+	}
+
+	status := doc.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	success := &Response{Description: http.StatusText(status)}
+	if doc.Output != nil {
+		success.Content = map[string]MediaType{"application/json": {Schema: s.For(doc.Output)}}
+	}
+	op.Responses[strconv.Itoa(status)] = success
+
+	for _, code := range errorCodes {
+		op.Responses[strconv.Itoa(code)] = &Response{
+			Description: http.StatusText(code),
+			Content: map[string]MediaType{
+				httpapi.ContentTypeProblem: {Schema: &Schema{Ref: "#/components/schemas/Problem"}},
+			},
+		}
+	}
+	return op
+}
+
+// OperationID derives a client method name from a route, e.g.
+// "GET /api/jobs/{id}" becomes getJobsById.
+func OperationID(method, path string) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(method))
+	for _, segment := range strings.Split(path, "/") {
+		switch {
+		case segment == "" || segment == "api":
+			continue
+		case strings.HasPrefix(segment, "{"):
+			b.WriteString("By" + exportName(strings.Trim(segment, "{}.")))
+		default:
+			b.WriteString(exportName(strings.NewReplacer("-", " ", "_", " ", ".", " ").Replace(segment)))
+		}
+	}
+	return b.String()
+}
+
+// SchemaFor returns the schema of v's type, adding named structs to the
+// document's components. Generators use it for types outside the HTTP API.
+func (d *Document) SchemaFor(v any) *Schema {
+	if d.schemas == nil {
+		d.schemas = newSchemas()
+		if d.Components.Schemas == nil {
+			d.Components.Schemas = d.schemas.components
+		}
+		d.schemas.components = d.Components.Schemas
+	}
+	return d.schemas.For(v)
+}
+
+// Operations returns the document's operations sorted by path and method,
+// for generators that need a stable order.
+func (d *Document) Operations() []PathOperation {
+	var ops []PathOperation
+	for path, item := range d.Paths {
+		for method, op := range *item {
+			ops = append(ops, PathOperation{Method: strings.ToUpper(method), Path: path, Operation: op})
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].Path != ops[j].Path {
+			return ops[i].Path < ops[j].Path
+		}
+		return ops[i].Method < ops[j].Method
+	})
+	return ops
+}
+
+// PathOperation is an operation with its method and path.
+type PathOperation struct {
+	Method    string
+	Path      string
+	Operation *Operation
+}
@@ -0,0 +1,229 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/openapi"
+)
+
+type Base struct {
+	ID string `json:"id"`
+}
+
+type Item struct {
+	Base
+	Name     string            `json:"name"`
+	Note     *string           `json:"note"`
+	Tags     []string          `json:"tags,omitempty"`
+	Labels   map[string]int    `json:"labels,omitempty"`
+	Created  time.Time         `json:"created_at"`
+	Timeout  time.Duration     `json:"timeout"`
+	Raw      json.RawMessage   `json:"raw,omitempty"`
+	Count    int64             `json:"count,string"`
+	Children []*Item           `json:"children,omitempty"`
+	Meta     struct{ A bool }  `json:"meta"`
+	Extra    map[string]string `json:"-"`
+	internal string
+}
+
+type CreateInput struct {
+	Name string `json:"name"`
+}
+
+func noop(w http.ResponseWriter, r *http.Request) {}
+
+// newDocument registers a small API and generates its document.
+func newDocument(t *testing.T) *openapi.Document {
+	t.Helper()
+	router := frontend.NewRouter()
+	api := router.Group("/api")
+	api.Handle("POST /items", frontend.DescribeFunc(noop, frontend.RouteDoc{
+		Summary: "Create an item",
+		Tags:    []string{"items"},
+		Input:   CreateInput{},
+		Output:  Item{},
+		Status:  http.StatusCreated,
+		Errors:  []int{http.StatusConflict},
+	}))
+	api.Handle("GET /items/{id}", frontend.DescribeFunc(noop, frontend.RouteDoc{
+		OperationID: "findItem",
+		Output:      Item{},
+		Errors:      []int{http.StatusNotFound},
+	}))
+	api.HandleFunc("GET /files/{path...}", noop)
+	api.HandleFunc("/echo", noop) // No method: not documented
+
+	doc := openapi.Generate(router.Routes(), openapi.Info{Title: "Test API", Version: "1.0.0"})
+	// Everything must survive a JSON round trip
+	if _, err := json.Marshal(doc); err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestGeneratePaths(t *testing.T) {
+	doc := newDocument(t)
+	if doc.OpenAPI != openapi.Version || doc.Info.Title != "Test API" {
+		t.Errorf("header = %s %+v", doc.OpenAPI, doc.Info)
+	}
+
+	var got []string
+	for _, op := range doc.Operations() {
+		got = append(got, op.Method+" "+op.Path+" "+op.Operation.OperationID)
+	}
+	want := []string{
+		"GET /api/files/{path} getFilesByPath",
+		"POST /api/items postItems",
+		"GET /api/items/{id} findItem",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("operations:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	get := (*doc.Paths["/api/items/{id}"])["get"]
+	if len(get.Parameters) != 1 || get.Parameters[0].Name != "id" || get.Parameters[0].In != "path" || !get.Parameters[0].Required {
+		t.Errorf("parameters = %+v", get.Parameters)
+	}
+	if get.RequestBody != nil {
+		t.Error("GET without Input has a request body")
+	}
+	if codes := responseCodes(get); codes != "200,404" {
+		t.Errorf("GET responses = %s, want 200,404", codes)
+	}
+}
+
+func TestGenerateOperation(t *testing.T) {
+	doc := newDocument(t)
+	op := (*doc.Paths["/api/items"])["post"]
+
+	if op.Summary != "Create an item" || !reflect.DeepEqual(op.Tags, []string{"items"}) {
+		t.Errorf("operation = %+v", op)
+	}
+	if op.RequestBody == nil || !op.RequestBody.Required ||
+		op.RequestBody.Content["application/json"].Schema.Ref != "#/components/schemas/OpenapiTestCreateInput" {
+		t.Errorf("request body = %+v", op.RequestBody)
+	}
+
+	// Declared errors plus the ones httpapi.Decode can return
+	if codes := responseCodes(op); codes != "201,400,409,413,415,422" {
+		t.Errorf("responses = %s", codes)
+	}
+	if ref := op.Responses["201"].Content["application/json"].Schema.Ref; ref != "#/components/schemas/OpenapiTestItem" {
+		t.Errorf("201 schema = %s", ref)
+	}
+	if ref := op.Responses["409"].Content["application/problem+json"].Schema.Ref; ref != "#/components/schemas/Problem" {
+		t.Errorf("409 schema = %s", ref)
+	}
+}
+
+func TestGenerateSchemas(t *testing.T) {
+	doc := newDocument(t)
+	item := doc.Components.Schemas["OpenapiTestItem"]
+	if item == nil {
+		t.Fatalf("components = %v", keys(doc.Components.Schemas))
+	}
+
+	props := item.Properties
+	tests := []struct {
+		field string
+		want  openapi.Schema
+	}{
+		{"id", openapi.Schema{Type: "string"}}, // Flattened from Base
+		{"note", openapi.Schema{Type: []string{"string", "null"}}},
+		{"created_at", openapi.Schema{Type: "string", Format: "date-time"}},
+		{"timeout", openapi.Schema{Type: "integer", Format: "int64", Description: "Duration in nanoseconds"}},
+		{"raw", openapi.Schema{}},
+		{"count", openapi.Schema{Type: "string"}},
+	}
+	for _, tt := range tests {
+		if got := props[tt.field]; got == nil || !reflect.DeepEqual(*got, tt.want) {
+			t.Errorf("%s = %+v, want %+v", tt.field, got, tt.want)
+		}
+	}
+
+	if tags := props["tags"]; tags.Type != "array" || tags.Items.Type != "string" {
+		t.Errorf("tags = %+v", tags)
+	}
+	if labels := props["labels"]; labels.Type != "object" || labels.AdditionalProperties.Format != "int32" {
+		t.Errorf("labels = %+v", labels)
+	}
+	// Recursive types reference their own component
+	if children := props["children"]; children.Items == nil || children.Items.Ref != "#/components/schemas/OpenapiTestItem" {
+		t.Errorf("children = %+v", children)
+	}
+	// Anonymous structs are inlined
+	if meta := props["meta"]; meta.Type != "object" || meta.Properties["A"] == nil {
+		t.Errorf("meta = %+v", meta)
+	}
+	for _, skipped := range []string{"Extra", "-", "internal", "Base"} {
+		if props[skipped] != nil {
+			t.Errorf("%s should not be a property", skipped)
+		}
+	}
+
+	// Pointers and omitempty fields are optional
+	want := []string{"count", "created_at", "id", "meta", "name", "timeout"}
+	if !reflect.DeepEqual(item.Required, want) {
+		t.Errorf("required = %v, want %v", item.Required, want)
+	}
+
+	for _, shared := range []string{"Problem", "FieldError"} {
+		if doc.Components.Schemas[shared] == nil {
+			t.Errorf("missing shared %s schema", shared)
+		}
+	}
+}
+
+func TestOperationID(t *testing.T) {
+	tests := []struct{ method, path, want string }{
+		{"GET", "/api/jobs/{id}", "getJobsById"},
+		{"POST", "/api/greeting", "postGreeting"},
+		{"GET", "/api/health/breakers", "getHealthBreakers"},
+		{"DELETE", "/api/admin/rate-limits/{key}", "deleteAdminRateLimitsByKey"},
+		{"GET", "/metrics", "getMetrics"},
+	}
+	for _, tt := range tests {
+		if got := openapi.OperationID(tt.method, tt.path); got != tt.want {
+			t.Errorf("OperationID(%s %s) = %s, want %s", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestSpecHandler(t *testing.T) {
+	router := frontend.NewRouter()
+	router.Handle("POST /api/items", frontend.DescribeFunc(noop, frontend.RouteDoc{Input: CreateInput{}}))
+
+	rec := httptest.NewRecorder()
+	openapi.SpecHandler(router, openapi.Info{Title: "Test API", Version: "1.0.0"}).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/openapi.json", nil))
+
+	var doc map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	paths, _ := doc["paths"].(map[string]any)
+	if doc["openapi"] != "3.1.0" || paths["/api/items"] == nil {
+		t.Errorf("document = %s", rec.Body)
+	}
+}
+
+func responseCodes(op *openapi.Operation) string {
+	return strings.Join(keys(op.Responses), ",")
+}
+
+func keys[V any](m map[string]V) []string {
+	var out []string
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,29 @@
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+)
+
+//go:embed explorer.html
+var explorerHTML []byte
+
+// SpecHandler serves the document for the router's current routes as JSON.
+func SpecHandler(router *frontend.Router, info Info) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		httpapi.Encode(w, r, http.StatusOK, Generate(router.Routes(), info))
+	})
+}
+
+// ExplorerHandler serves a self-contained page that lists the operations from
+// openapi.json (resolved relative to the page) and can send requests to them.
+func ExplorerHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Write(explorerHTML)
+	})
+}
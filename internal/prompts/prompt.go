@@ -0,0 +1,104 @@
+package prompts
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Prompt is one parsed version of a prompt template.
+type Prompt struct {
+	Name     string
+	Version  string
+	template *template.Template
+}
+
+// Rendered is a prompt filled in with task inputs.
+type Rendered struct {
+	System string // Empty when the template has no "system" block
+	User   string
+}
+
+// Parse compiles a prompt template. Missing input fields are errors rather than
+// silently rendering "<no value>".
+func Parse(name, version, content string) (*Prompt, error) {
+	tmpl, err := template.New(name).
+		Option("missingkey=error").
+		Funcs(funcs).
+		Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse prompt %s/%s: %w", name, version, err)
+	}
+	return &Prompt{Name: name, Version: version, template: tmpl}, nil
+}
+
+// Render executes the template with data.
+func (p *Prompt) Render(data interface{}) (*Rendered, error) {
+	out := &Rendered{}
+
+	if p.template.Lookup("system") != nil {
+		system, err := p.execute("system", data)
+		if err != nil {
+			return nil, err
+		}
+		out.System = system
+	}
+
+	userBlock := p.Name
+	if p.template.Lookup("user") != nil {
+		userBlock = "user"
+	}
+	user, err := p.execute(userBlock, data)
+	if err != nil {
+		return nil, err
+	}
+	out.User = user
+
+	if out.User == "" {
+		return nil, fmt.Errorf("prompt %s/%s rendered an empty user message", p.Name, p.Version)
+	}
+	return out, nil
+}
+
+// execute renders one named template and trims surrounding whitespace.
+func (p *Prompt) execute(block string, data interface{}) (string, error) {
+	var b strings.Builder
+	if err := p.template.ExecuteTemplate(&b, block, data); err != nil {
+		return "", fmt.Errorf("failed to render prompt %s/%s: %w", p.Name, p.Version, err)
+	}
+	return strings.TrimSpace(b.String()), nil
+}
+
+// funcs are available in every prompt template.
+var funcs = template.FuncMap{
+	// yaml renders a value as YAML (token-efficient structured input)
+	"yaml": func(v interface{}) (string, error) {
+		var b strings.Builder
+		enc := yaml.NewEncoder(&b)
+		enc.SetIndent(2)
+		if err := enc.Encode(v); err != nil {
+			return "", err
+		}
+		if err := enc.Close(); err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(b.String()), nil
+	},
+	// json renders a value as compact JSON
+	"json": func(v interface{}) (string, error) {
+		out, err := json.Marshal(v)
+		return string(out), err
+	},
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	// indent prefixes every line with n spaces
+	"indent": func(n int, s string) string {
+		pad := strings.Repeat(" ", n)
+		return pad + strings.ReplaceAll(s, "\n", "\n"+pad)
+	},
+}
@@ -0,0 +1,157 @@
+// Package prompts loads versioned text/template prompt files.
+//
+// Templates are organized as <name>/<version>.tmpl, for example:
+//
+//	templates/
+//	└── summarize/
+//	    ├── v1.tmpl
+//	    └── v2.tmpl
+//
+// A template may define "system" and "user" blocks; without them the whole
+// file is the user message:
+//
+//	{{define "system"}}You are a concise assistant.{{end}}
+//	{{define "user"}}Summarize:{{"\n"}}{{yaml .Document}}{{end}}
+//
+// Built-in templates are embedded in the binary. Set PROMPTS_DIR to load
+// additional templates (or override built-in versions) from disk.
+package prompts
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed templates
+var embeddedFS embed.FS
+
+// templateExt is the file extension of prompt templates.
+const templateExt = ".tmpl"
+
+// Library holds every version of every loaded prompt.
+type Library struct {
+	prompts map[string]map[string]*Prompt // name -> version -> prompt
+}
+
+// Default loads the embedded templates plus any in PROMPTS_DIR.
+func Default() (*Library, error) {
+	lib := NewLibrary()
+
+	embedded, err := fs.Sub(embeddedFS, "templates")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open embedded prompts: %w", err)
+	}
+	if err := lib.Load(embedded); err != nil {
+		return nil, err
+	}
+
+	if dir := os.Getenv("PROMPTS_DIR"); dir != "" {
+		if err := lib.Load(os.DirFS(dir)); err != nil {
+			return nil, fmt.Errorf("failed to load prompts from %s: %w", dir, err)
+		}
+	}
+
+	return lib, nil
+}
+
+// NewLibrary creates an empty library.
+func NewLibrary() *Library {
+	return &Library{prompts: make(map[string]map[string]*Prompt)}
+}
+
+// Load parses every <name>/<version>.tmpl file in fsys.
+// Templates already in the library with the same name and version are replaced.
+func (l *Library) Load(fsys fs.FS) error {
+	return fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || path.Ext(p) != templateExt {
+			return nil
+		}
+
+		name, file := path.Split(p)
+		name = strings.Trim(name, "/")
+		version := strings.TrimSuffix(file, templateExt)
+		if name == "" || strings.Contains(name, "/") {
+			return fmt.Errorf("prompt %s must be stored as <name>/<version>%s", p, templateExt)
+		}
+
+		content, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return fmt.Errorf("failed to read prompt %s: %w", p, err)
+		}
+
+		prompt, err := Parse(name, version, string(content))
+		if err != nil {
+			return err
+		}
+		l.Add(prompt)
+		return nil
+	})
+}
+
+// Add registers a parsed prompt.
+func (l *Library) Add(p *Prompt) {
+	if l.prompts[p.Name] == nil {
+		l.prompts[p.Name] = make(map[string]*Prompt)
+	}
+	l.prompts[p.Name][p.Version] = p
+}
+
+// Get returns a prompt version. An empty version or "latest" selects the
+// highest version (v2 > v1, v10 > v9).
+func (l *Library) Get(name, version string) (*Prompt, error) {
+	versions, ok := l.prompts[name]
+	if !ok {
+		return nil, fmt.Errorf("prompt %q not found", name)
+	}
+
+	if version == "" || version == "latest" {
+		all := l.Versions(name)
+		return versions[all[len(all)-1]], nil
+	}
+
+	p, ok := versions[version]
+	if !ok {
+		return nil, fmt.Errorf("prompt %q has no version %q (available: %s)",
+			name, version, strings.Join(l.Versions(name), ", "))
+	}
+	return p, nil
+}
+
+// Names returns all prompt names, sorted.
+func (l *Library) Names() []string {
+	names := make([]string, 0, len(l.prompts))
+	for name := range l.prompts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Versions returns a prompt's versions from oldest to newest.
+func (l *Library) Versions(name string) []string {
+	versions := make([]string, 0, len(l.prompts[name]))
+	for v := range l.prompts[name] {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool { return versionLess(versions[i], versions[j]) })
+	return versions
+}
+
+// versionLess orders versions like v1 < v2 < v10, falling back to string order.
+func versionLess(a, b string) bool {
+	na, errA := strconv.Atoi(strings.TrimPrefix(a, "v"))
+	nb, errB := strconv.Atoi(strings.TrimPrefix(b, "v"))
+	if errA == nil && errB == nil {
+		return na < nb
+	}
+	return a < b
+}
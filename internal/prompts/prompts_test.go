@@ -0,0 +1,189 @@
+package prompts_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/prompts"
+)
+
+func newLibrary(t *testing.T, files map[string]string) *prompts.Library {
+	t.Helper()
+	fsys := fstest.MapFS{}
+	for name, content := range files {
+		fsys[name] = &fstest.MapFile{Data: []byte(content)}
+	}
+	lib := prompts.NewLibrary()
+	if err := lib.Load(fsys); err != nil {
+		t.Fatal(err)
+	}
+	return lib
+}
+
+func TestGetSelectsVersion(t *testing.T) {
+	lib := newLibrary(t, map[string]string{
+		"summarize/v1.tmpl":  "one",
+		"summarize/v2.tmpl":  "two",
+		"summarize/v10.tmpl": "ten",
+		"classify/v1.tmpl":   "classify",
+		"classify/README.md": "not a template",
+	})
+
+	tests := []struct {
+		name, prompt, version string
+		want                  string // Version returned, empty when Get fails
+		wantErr               string
+	}{
+		{name: "empty is latest", prompt: "summarize", version: "", want: "v10"},
+		{name: "latest sorts numerically", prompt: "summarize", version: "latest", want: "v10"},
+		{name: "pinned", prompt: "summarize", version: "v2", want: "v2"},
+		{name: "unknown version lists available", prompt: "summarize", version: "v3", wantErr: "available: v1, v2, v10"},
+		{name: "unknown prompt", prompt: "translate", wantErr: `prompt "translate" not found`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := lib.Get(tt.prompt, tt.version)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.Name != tt.prompt || p.Version != tt.want {
+				t.Errorf("got %s/%s, want %s/%s", p.Name, p.Version, tt.prompt, tt.want)
+			}
+		})
+	}
+
+	if got := strings.Join(lib.Names(), ","); got != "classify,summarize" {
+		t.Errorf("Names = %s", got)
+	}
+}
+
+func TestLoadRejectsBadTemplates(t *testing.T) {
+	tests := map[string]fstest.MapFS{
+		"nested directory": {"a/b/v1.tmpl": {Data: []byte("hi")}},
+		"top-level file":   {"v1.tmpl": {Data: []byte("hi")}},
+		"syntax error":     {"summarize/v1.tmpl": {Data: []byte("{{.Text")}},
+	}
+	for name, fsys := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := prompts.NewLibrary().Load(fsys); err == nil {
+				t.Error("expected a load error")
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		data       interface{}
+		wantSystem string
+		wantUser   string
+		wantErr    string
+	}{
+		{
+			name:       "system and user blocks",
+			content:    `{{define "system"}} Be brief. {{end}}{{define "user"}}Summarize: {{.Text}}{{end}}`,
+			data:       map[string]interface{}{"Text": "hello"},
+			wantSystem: "Be brief.",
+			wantUser:   "Summarize: hello",
+		},
+		{
+			name:     "whole file is the user message",
+			content:  "\n  Classify {{upper .Label}}\n",
+			data:     map[string]interface{}{"Label": "faq"},
+			wantUser: "Classify FAQ",
+		},
+		{
+			name:     "structured input helpers",
+			content:  "{{yaml .Items}}\n{{json .Items}}\n{{indent 2 (join .Tags \"\\n\")}}",
+			data:     map[string]interface{}{"Items": []map[string]int{{"a": 1}}, "Tags": []string{"x", "y"}},
+			wantUser: "- a: 1\n[{\"a\":1}]\n  x\n  y",
+		},
+		{
+			name:    "missing map key",
+			content: "Summarize {{.Txt}}",
+			data:    map[string]interface{}{"Text": "hello"},
+			wantErr: `failed to render prompt summarize/v1`,
+		},
+		{
+			name:    "missing struct field",
+			content: "Summarize {{.Txt}}",
+			data:    struct{ Text string }{Text: "hello"},
+			wantErr: "can't evaluate field Txt",
+		},
+		{
+			name:    "error in system block",
+			content: `{{define "system"}}{{.Missing}}{{end}}{{define "user"}}hi{{end}}`,
+			data:    map[string]interface{}{},
+			wantErr: "failed to render prompt",
+		},
+		{
+			name:    "empty user message",
+			content: `{{define "user"}}{{if .Text}}{{.Text}}{{end}}{{end}}`,
+			data:    map[string]interface{}{"Text": ""},
+			wantErr: "rendered an empty user message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := prompts.Parse("summarize", "v1", tt.content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := p.Render(tt.data)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.System != tt.wantSystem || got.User != tt.wantUser {
+				t.Errorf("rendered %+v, want system %q, user %q", got, tt.wantSystem, tt.wantUser)
+			}
+		})
+	}
+}
+
+func TestDefaultLoadsEmbeddedAndPromptsDir(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "summarize"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "summarize", "v2.tmpl"), []byte("Shorter: {{.Text}}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PROMPTS_DIR", dir)
+
+	lib, err := prompts.Default()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(lib.Versions("summarize"), ","); got != "v1,v2" {
+		t.Errorf("versions = %s, want v1,v2", got)
+	}
+
+	v1, err := lib.Get("summarize", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rendered, err := v1.Render(map[string]interface{}{"Text": "Long text.", "MaxSentences": 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rendered.System == "" || !strings.Contains(rendered.User, "at most 2 sentences") || !strings.HasSuffix(rendered.User, "Long text.") {
+		t.Errorf("embedded summarize/v1 rendered %+v", rendered)
+	}
+}
@@ -0,0 +1,99 @@
+package ratelimit
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Observe updates the limiter from a provider response. It understands
+// Retry-After / retry-after-ms and OpenAI-style x-ratelimit-* headers:
+//
+//	x-ratelimit-remaining-requests / x-ratelimit-remaining-tokens
+//	x-ratelimit-reset-requests / x-ratelimit-reset-tokens  (e.g. "1s", "6m0s", "20ms")
+//
+// The local budget is lowered to the provider's remaining count (other clients may share
+// the same API key), and callers pause until the reset when a budget is exhausted.
+// A 429 response pauses for Retry-After, or one second if no hint is given.
+func (l *Limiter) Observe(resp *http.Response) {
+	if l == nil || resp == nil {
+		return
+	}
+	h := resp.Header
+
+	var pause time.Duration
+	if resp.StatusCode == http.StatusTooManyRequests {
+		pause = retryAfter(h)
+		if pause == 0 {
+			pause = max(resetAfter(h, "requests"), resetAfter(h, "tokens"), time.Second)
+		}
+	}
+
+	l.mu.Lock()
+	if resp.StatusCode == http.StatusTooManyRequests {
+		l.rateLimited++
+	}
+	l.refill(l.now())
+	if n, ok := headerFloat(h, "X-Ratelimit-Remaining-Requests"); ok && l.limits.RequestsPerMinute > 0 && n < l.requests {
+		l.requests = n
+	}
+	if n, ok := headerFloat(h, "X-Ratelimit-Remaining-Tokens"); ok && l.limits.TokensPerMinute > 0 && n < l.tokens {
+		l.tokens = n
+	}
+	if n, ok := headerFloat(h, "X-Ratelimit-Remaining-Requests"); ok && n == 0 {
+		pause = max(pause, resetAfter(h, "requests"))
+	}
+	if n, ok := headerFloat(h, "X-Ratelimit-Remaining-Tokens"); ok && n == 0 {
+		pause = max(pause, resetAfter(h, "tokens"))
+	}
+	l.mu.Unlock()
+
+	l.Pause(pause)
+}
+
+// retryAfter parses retry-after-ms or Retry-After (seconds or HTTP date).
+func retryAfter(h http.Header) time.Duration {
+	if v := h.Get("Retry-After-Ms"); v != "" {
+		if ms, err := strconv.ParseFloat(v, 64); err == nil && ms > 0 {
+			return time.Duration(ms * float64(time.Millisecond))
+		}
+	}
+
+	v := strings.TrimSpace(h.Get("Retry-After"))
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.ParseFloat(v, 64); err == nil && secs > 0 {
+		return time.Duration(secs * float64(time.Second))
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return time.Until(at)
+	}
+	return 0
+}
+
+// resetAfter parses x-ratelimit-reset-<kind>, which OpenAI sends as a Go-style duration.
+func resetAfter(h http.Header, kind string) time.Duration {
+	v := h.Get("X-Ratelimit-Reset-" + kind)
+	if v == "" {
+		return 0
+	}
+	if d, err := time.ParseDuration(v); err == nil {
+		return d
+	}
+	if secs, err := strconv.ParseFloat(v, 64); err == nil {
+		return time.Duration(secs * float64(time.Second))
+	}
+	return 0
+}
+
+// headerFloat parses a numeric header.
+func headerFloat(h http.Header, name string) (float64, bool) {
+	v := h.Get(name)
+	if v == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(v, 64)
+	return n, err == nil
+}
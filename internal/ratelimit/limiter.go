@@ -0,0 +1,288 @@
+// Package ratelimit enforces client-side request and token budgets for outbound AI API calls.
+//
+// A Limiter holds per-minute budgets for one provider/model. Callers block in Wait until
+// their request fits the budget; waiters are served strictly in arrival order so a large
+// request cannot be starved by a stream of small ones. Budgets refill continuously and are
+// corrected from the provider's rate-limit headers (see Observe) and Retry-After on 429s.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limits are per-minute budgets. Zero means unlimited.
+type Limits struct {
+	RequestsPerMinute int
+	TokensPerMinute   int
+}
+
+// Stats is a snapshot of a limiter's budget usage.
+type Stats struct {
+	Key               string     `json:"key"`
+	RequestsPerMinute int        `json:"requests_per_minute"`
+	TokensPerMinute   int        `json:"tokens_per_minute"`
+	RequestsAvailable float64    `json:"requests_available"`
+	TokensAvailable   float64    `json:"tokens_available"`
+	Waiting           int        `json:"waiting"`
+	Requests          int64      `json:"requests_total"`
+	Tokens            int64      `json:"tokens_total"`
+	Throttled         int64      `json:"throttled_total"`    // Waits that had to block
+	RateLimited       int64      `json:"rate_limited_total"` // 429 responses observed
+	WaitTime          float64    `json:"wait_seconds_total"`
+	PausedUntil       *time.Time `json:"paused_until,omitempty"`
+}
+
+// waiter is a queued Wait call.
+type waiter struct {
+	tokens int
+	ready  chan struct{} // Signalled when the waiter becomes head of the queue or budgets change
+}
+
+// Limiter enforces Limits with continuously refilling buckets and a FIFO wait queue.
+type Limiter struct {
+	key string
+
+	mu          sync.Mutex
+	limits      Limits
+	requests    float64 // Available requests
+	tokens      float64 // Available tokens (may go negative after Report)
+	last        time.Time
+	pausedUntil time.Time
+	queue       []*waiter
+
+	totalRequests int64
+	totalTokens   int64
+	throttled     int64
+	rateLimited   int64
+	waitTime      time.Duration
+
+	now func() time.Time
+}
+
+// NewLimiter creates a limiter with full buckets.
+func NewLimiter(key string, limits Limits) *Limiter {
+	return &Limiter{
+		key:      key,
+		limits:   limits,
+		requests: float64(limits.RequestsPerMinute),
+		tokens:   float64(limits.TokensPerMinute),
+		last:     time.Now(),
+		now:      time.Now,
+	}
+}
+
+// Key returns the provider/model key the limiter was created for.
+func (l *Limiter) Key() string {
+	return l.key
+}
+
+// Limits returns the configured budgets.
+func (l *Limiter) Limits() Limits {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limits
+}
+
+// Wait blocks until one request using the given number of tokens fits the budget,
+// then consumes it. Requests larger than the whole token budget are clamped to it
+// so they eventually run. Returns ctx.Err() if the context ends first.
+func (l *Limiter) Wait(ctx context.Context, tokens int) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	if tpm := l.limits.TokensPerMinute; tpm > 0 && tokens > tpm {
+		tokens = tpm
+	}
+	w := &waiter{tokens: tokens, ready: make(chan struct{}, 1)}
+	l.queue = append(l.queue, w)
+
+	start := l.now()
+	blocked := false
+	for {
+		var delay time.Duration = -1
+		if l.queue[0] == w {
+			now := l.now()
+			l.refill(now)
+			delay = l.delay(now, tokens)
+			if delay == 0 {
+				l.consume(tokens)
+				l.queue = l.queue[1:]
+				l.notifyHead()
+				if blocked {
+					l.throttled++
+					l.waitTime += l.now().Sub(start)
+				}
+				l.mu.Unlock()
+				return nil
+			}
+		}
+		blocked = true
+		l.mu.Unlock()
+
+		var timer *time.Timer
+		var expired <-chan time.Time
+		if delay > 0 {
+			timer = time.NewTimer(delay)
+			expired = timer.C
+		}
+
+		select {
+		case <-ctx.Done():
+			stopTimer(timer)
+			l.mu.Lock()
+			l.remove(w)
+			l.mu.Unlock()
+			return ctx.Err()
+		case <-w.ready:
+		case <-expired:
+		}
+		stopTimer(timer)
+
+		l.mu.Lock()
+	}
+}
+
+// Report corrects the token budget once the actual usage of a request is known,
+// refunding over-estimates and charging under-estimates.
+func (l *Limiter) Report(estimated, actual int) {
+	if l == nil || estimated == actual {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(l.now())
+	l.tokens -= float64(actual - estimated)
+	if tpm := float64(l.limits.TokensPerMinute); tpm > 0 && l.tokens > tpm {
+		l.tokens = tpm
+	}
+	l.totalTokens += int64(actual - estimated)
+	l.notifyHead()
+}
+
+// Pause blocks all callers for d, e.g. after a 429 with Retry-After.
+// Overlapping pauses keep the later deadline.
+func (l *Limiter) Pause(d time.Duration) {
+	if l == nil || d <= 0 {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if until := l.now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// Stats returns a snapshot of the limiter's budgets and counters.
+func (l *Limiter) Stats() Stats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.refill(now)
+
+	stats := Stats{
+		Key:               l.key,
+		RequestsPerMinute: l.limits.RequestsPerMinute,
+		TokensPerMinute:   l.limits.TokensPerMinute,
+		RequestsAvailable: l.requests,
+		TokensAvailable:   l.tokens,
+		Waiting:           len(l.queue),
+		Requests:          l.totalRequests,
+		Tokens:            l.totalTokens,
+		Throttled:         l.throttled,
+		RateLimited:       l.rateLimited,
+		WaitTime:          l.waitTime.Seconds(),
+	}
+	if l.pausedUntil.After(now) {
+		pausedUntil := l.pausedUntil
+		stats.PausedUntil = &pausedUntil
+	}
+	return stats
+}
+
+// refill adds budget for the time elapsed since the last refill.
+func (l *Limiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last)
+	if elapsed <= 0 {
+		return
+	}
+	l.last = now
+
+	if rpm := float64(l.limits.RequestsPerMinute); rpm > 0 {
+		l.requests = min(rpm, l.requests+rpm*elapsed.Minutes())
+	}
+	if tpm := float64(l.limits.TokensPerMinute); tpm > 0 {
+		l.tokens = min(tpm, l.tokens+tpm*elapsed.Minutes())
+	}
+}
+
+// delay returns how long the head waiter must wait, or 0 if it can run now.
+func (l *Limiter) delay(now time.Time, tokens int) time.Duration {
+	var delay time.Duration
+	if l.pausedUntil.After(now) {
+		delay = l.pausedUntil.Sub(now)
+	}
+	if rpm := float64(l.limits.RequestsPerMinute); rpm > 0 && l.requests < 1 {
+		delay = max(delay, minutes((1-l.requests)/rpm))
+	}
+	if tpm := float64(l.limits.TokensPerMinute); tpm > 0 && l.tokens < float64(tokens) {
+		delay = max(delay, minutes((float64(tokens)-l.tokens)/tpm))
+	}
+	return delay
+}
+
+// consume takes one request and the given tokens from the buckets.
+func (l *Limiter) consume(tokens int) {
+	if l.limits.RequestsPerMinute > 0 {
+		l.requests--
+	}
+	if l.limits.TokensPerMinute > 0 {
+		l.tokens -= float64(tokens)
+	}
+	l.totalRequests++
+	l.totalTokens += int64(tokens)
+}
+
+// remove drops a cancelled waiter from the queue.
+func (l *Limiter) remove(w *waiter) {
+	for i, q := range l.queue {
+		if q == w {
+			l.queue = append(l.queue[:i], l.queue[i+1:]...)
+			if i == 0 {
+				l.notifyHead()
+			}
+			return
+		}
+	}
+}
+
+// notifyHead wakes the head of the queue so it re-checks the budget.
+func (l *Limiter) notifyHead() {
+	if len(l.queue) == 0 {
+		return
+	}
+	select {
+	case l.queue[0].ready <- struct{}{}:
+	default:
+	}
+}
+
+// stopTimer stops a timer if one was started.
+func stopTimer(t *time.Timer) {
+	if t != nil {
+		t.Stop()
+	}
+}
+
+// minutes converts a fractional number of minutes to a duration (at least 1ms).
+func minutes(m float64) time.Duration {
+	return max(time.Duration(m*float64(time.Minute)), time.Millisecond)
+}
@@ -0,0 +1,357 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+)
+
+// newTestLimiter returns a limiter on a frozen clock, so budgets only change
+// when the test refunds tokens or moves the clock.
+func newTestLimiter(limits Limits) (*Limiter, *time.Time) {
+	clock := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	l := NewLimiter("openai/test", limits)
+	l.now = func() time.Time { return clock }
+	l.last = clock
+	return l, &clock
+}
+
+// waitAsync runs Wait in a goroutine and returns a channel with its result.
+func waitAsync(ctx context.Context, l *Limiter, tokens int) <-chan error {
+	done := make(chan error, 1)
+	go func() { done <- l.Wait(ctx, tokens) }()
+	return done
+}
+
+// waitQueued blocks until n callers are queued.
+func waitQueued(t *testing.T, l *Limiter, n int) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for l.Stats().Waiting != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("waiting = %d, want %d", l.Stats().Waiting, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func expectBlocked(t *testing.T, done <-chan error, name string) {
+	t.Helper()
+	select {
+	case err := <-done:
+		t.Fatalf("%s returned early (err %v)", name, err)
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func expectDone(t *testing.T, done <-chan error, name string) error {
+	t.Helper()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(2 * time.Second):
+		t.Fatalf("%s still blocked", name)
+		return nil
+	}
+}
+
+func TestWaitIsFIFO(t *testing.T) {
+	l, _ := newTestLimiter(Limits{TokensPerMinute: 100})
+	if err := l.Wait(context.Background(), 50); err != nil {
+		t.Fatal(err)
+	}
+
+	// 50 tokens left: the large request must wait, and the small one must not overtake it
+	large := waitAsync(context.Background(), l, 100)
+	waitQueued(t, l, 1)
+	small := waitAsync(context.Background(), l, 10)
+	waitQueued(t, l, 2)
+	expectBlocked(t, small, "small request")
+
+	l.Report(50, 0) // refund: 100 tokens available
+	if err := expectDone(t, large, "large request"); err != nil {
+		t.Fatal(err)
+	}
+	expectBlocked(t, small, "small request")
+
+	l.Report(10, 0)
+	if err := expectDone(t, small, "small request"); err != nil {
+		t.Fatal(err)
+	}
+
+	stats := l.Stats()
+	if stats.Requests != 3 || stats.Throttled != 2 || stats.Waiting != 0 {
+		t.Errorf("stats = %+v", stats)
+	}
+}
+
+func TestWaitClampsOversizedRequests(t *testing.T) {
+	l, _ := newTestLimiter(Limits{TokensPerMinute: 100})
+	if err := l.Wait(context.Background(), 500); err != nil {
+		t.Fatal(err)
+	}
+	if stats := l.Stats(); stats.TokensAvailable != 0 || stats.Tokens != 100 {
+		t.Errorf("stats = %+v, want the request clamped to the 100 token budget", stats)
+	}
+}
+
+func TestWaitCancelledWhileQueued(t *testing.T) {
+	l, _ := newTestLimiter(Limits{TokensPerMinute: 100})
+	if err := l.Wait(context.Background(), 50); err != nil {
+		t.Fatal(err)
+	}
+
+	headCtx, cancelHead := context.WithCancel(context.Background())
+	defer cancelHead()
+	tailCtx, cancelTail := context.WithCancel(context.Background())
+	defer cancelTail()
+
+	head := waitAsync(headCtx, l, 100)
+	waitQueued(t, l, 1)
+	tail := waitAsync(tailCtx, l, 10)
+	waitQueued(t, l, 2)
+	third := waitAsync(context.Background(), l, 20)
+	waitQueued(t, l, 3)
+
+	// Cancelling a caller in the middle of the queue only removes it
+	cancelTail()
+	if err := expectDone(t, tail, "cancelled request"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	waitQueued(t, l, 2)
+	expectBlocked(t, third, "third request")
+
+	// Cancelling the head lets the next caller run if it fits
+	cancelHead()
+	if err := expectDone(t, head, "cancelled head"); !errors.Is(err, context.Canceled) {
+		t.Fatalf("err = %v, want context.Canceled", err)
+	}
+	if err := expectDone(t, third, "third request"); err != nil {
+		t.Fatal(err)
+	}
+
+	stats := l.Stats()
+	if stats.Waiting != 0 || stats.Requests != 2 || stats.TokensAvailable != 30 {
+		t.Errorf("stats = %+v, want only the first and third requests charged", stats)
+	}
+}
+
+func TestWaitRefillsOverTime(t *testing.T) {
+	l, clock := newTestLimiter(Limits{RequestsPerMinute: 60})
+	for i := 0; i < 60; i++ {
+		if err := l.Wait(context.Background(), 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := l.Stats().RequestsAvailable; got != 0 {
+		t.Fatalf("requests available = %v, want 0", got)
+	}
+
+	*clock = clock.Add(30 * time.Second)
+	if got := l.Stats().RequestsAvailable; got != 30 {
+		t.Errorf("requests available after 30s = %v, want 30", got)
+	}
+	*clock = clock.Add(time.Hour)
+	if got := l.Stats().RequestsAvailable; got != 60 {
+		t.Errorf("requests available after an hour = %v, want the 60 cap", got)
+	}
+}
+
+func TestObserveHeaders(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		headers    map[string]string
+		wantReqs   float64
+		wantTokens float64
+		wantPause  time.Duration
+	}{
+		{
+			name:       "remaining lowers the budget",
+			status:     http.StatusOK,
+			headers:    map[string]string{"x-ratelimit-remaining-requests": "7", "x-ratelimit-remaining-tokens": "900"},
+			wantReqs:   7,
+			wantTokens: 900,
+		},
+		{
+			name:       "remaining never raises the budget",
+			status:     http.StatusOK,
+			headers:    map[string]string{"x-ratelimit-remaining-requests": "5000", "x-ratelimit-remaining-tokens": "junk"},
+			wantReqs:   10,
+			wantTokens: 1000,
+		},
+		{
+			name:       "exhausted budget pauses until reset",
+			status:     http.StatusOK,
+			headers:    map[string]string{"x-ratelimit-remaining-tokens": "0", "x-ratelimit-reset-tokens": "6m0s"},
+			wantReqs:   10,
+			wantTokens: 0,
+			wantPause:  6 * time.Minute,
+		},
+		{
+			name:       "429 with Retry-After seconds",
+			status:     http.StatusTooManyRequests,
+			headers:    map[string]string{"Retry-After": "2"},
+			wantReqs:   10,
+			wantTokens: 1000,
+			wantPause:  2 * time.Second,
+		},
+		{
+			name:       "429 with retry-after-ms",
+			status:     http.StatusTooManyRequests,
+			headers:    map[string]string{"retry-after-ms": "1500", "Retry-After": "30"},
+			wantReqs:   10,
+			wantTokens: 1000,
+			wantPause:  1500 * time.Millisecond,
+		},
+		{
+			name:       "429 falls back to the reset headers",
+			status:     http.StatusTooManyRequests,
+			headers:    map[string]string{"x-ratelimit-reset-requests": "20s", "x-ratelimit-reset-tokens": "1.5"},
+			wantReqs:   10,
+			wantTokens: 1000,
+			wantPause:  20 * time.Second,
+		},
+		{
+			name:       "429 without hints pauses one second",
+			status:     http.StatusTooManyRequests,
+			headers:    map[string]string{"Retry-After": "soon"},
+			wantReqs:   10,
+			wantTokens: 1000,
+			wantPause:  time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, clock := newTestLimiter(Limits{RequestsPerMinute: 10, TokensPerMinute: 1000})
+			resp := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+			for k, v := range tt.headers {
+				resp.Header.Set(k, v)
+			}
+			l.Observe(resp)
+
+			stats := l.Stats()
+			if stats.RequestsAvailable != tt.wantReqs || stats.TokensAvailable != tt.wantTokens {
+				t.Errorf("available = %v requests, %v tokens; want %v, %v",
+					stats.RequestsAvailable, stats.TokensAvailable, tt.wantReqs, tt.wantTokens)
+			}
+			var pause time.Duration
+			if stats.PausedUntil != nil {
+				pause = stats.PausedUntil.Sub(*clock)
+			}
+			if pause != tt.wantPause {
+				t.Errorf("paused for %v, want %v", pause, tt.wantPause)
+			}
+			wantLimited := int64(0)
+			if tt.status == http.StatusTooManyRequests {
+				wantLimited = 1
+			}
+			if stats.RateLimited != wantLimited {
+				t.Errorf("rate limited = %d, want %d", stats.RateLimited, wantLimited)
+			}
+		})
+	}
+}
+
+func TestRetryAfterHTTPDate(t *testing.T) {
+	h := http.Header{}
+	h.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	if d := retryAfter(h); d < 58*time.Second || d > time.Minute {
+		t.Errorf("retryAfter = %v, want about a minute", d)
+	}
+}
+
+func TestTransportRefundsRejectedRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	l, clock := newTestLimiter(Limits{RequestsPerMinute: 10, TokensPerMinute: 1000})
+	client := Client(srv.Client(), l)
+
+	req, _ := http.NewRequestWithContext(WithTokens(context.Background(), 400), http.MethodPost, srv.URL, nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	stats := l.Stats()
+	if stats.TokensAvailable != 1000 || stats.Tokens != 0 {
+		t.Errorf("tokens = %v available, %d used; want the 400 refunded", stats.TokensAvailable, stats.Tokens)
+	}
+	if stats.RequestsAvailable != 9 || stats.RateLimited != 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+	if stats.PausedUntil == nil || stats.PausedUntil.Sub(*clock) != 3*time.Second {
+		t.Errorf("paused until %v, want 3s from now", stats.PausedUntil)
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("RATE_LIMIT_DEFAULT_RPM", "100")
+	t.Setenv("RATE_LIMIT_DEFAULT_TPM", "")
+	t.Setenv("RATE_LIMITS", "openai/text-embedding-3-small=3000:1000000, llm/*=60:")
+
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		provider, model string
+		want            Limits
+	}{
+		{"openai", "text-embedding-3-small", Limits{RequestsPerMinute: 3000, TokensPerMinute: 1000000}},
+		{"llm", "ct_faq_dedup", Limits{RequestsPerMinute: 60}},
+		{"openai", "gpt-4o-mini", Limits{RequestsPerMinute: 100}},
+	}
+	for _, tt := range tests {
+		if got := cfg.Lookup(tt.provider, tt.model); got != tt.want {
+			t.Errorf("Lookup(%s, %s) = %+v, want %+v", tt.provider, tt.model, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"openai", "openai/x=ten:1", "openai/x=1:-5"} {
+		t.Setenv("RATE_LIMITS", bad)
+		if _, err := ConfigFromEnv(); err == nil {
+			t.Errorf("RATE_LIMITS=%q: expected an error", bad)
+		}
+	}
+}
+
+func TestRegisterMetrics(t *testing.T) {
+	r := NewRegistry(Config{Default: Limits{RequestsPerMinute: 60, TokensPerMinute: 1000}})
+	r.Limiter("openai", "gpt-4o-mini").Observe(&http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}})
+	if err := r.Limiter("llm", "default").Wait(context.Background(), 100); err != nil {
+		t.Fatal(err)
+	}
+
+	reg := metrics.NewRegistry()
+	r.RegisterMetrics(reg)
+	var b strings.Builder
+	if err := reg.WriteText(&b); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		`worker_ratelimit_waiting{limiter="openai/gpt-4o-mini"} 0`,
+		`worker_ratelimit_rate_limited_total{limiter="llm/default"} 0`,
+		`worker_ratelimit_rate_limited_total{limiter="openai/gpt-4o-mini"} 1`,
+		`worker_ratelimit_throttled_total{limiter="llm/default"} 0`,
+	} {
+		if !strings.Contains(b.String(), line+"\n") {
+			t.Errorf("missing %s in:\n%s", line, b.String())
+		}
+	}
+	// The budget refills on the real clock, so only check it was charged
+	if s := r.Limiter("llm", "default").Stats(); s.RequestsAvailable >= 60 || !strings.Contains(b.String(), `worker_ratelimit_requests_available{limiter="llm/default"} 59`) {
+		t.Errorf("requests available = %v", s.RequestsAvailable)
+	}
+}
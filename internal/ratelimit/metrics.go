@@ -0,0 +1,33 @@
+package ratelimit
+
+import "github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+
+// RegisterMetrics exposes every limiter's budgets, queue depth, waits and 429
+// responses in reg, labelled by limiter key and read from Stats at scrape time.
+// Call it once per registry; metric names must be unique.
+func (r *Registry) RegisterMetrics(reg *metrics.Registry) {
+	labels := []string{"limiter"}
+	series := func(value func(Stats) float64) func() []metrics.Sample {
+		return func() []metrics.Sample {
+			stats := r.Stats()
+			samples := make([]metrics.Sample, len(stats))
+			for i, s := range stats {
+				samples[i] = metrics.Sample{Labels: []string{s.Key}, Value: value(s)}
+			}
+			return samples
+		}
+	}
+
+	reg.NewGaugeVecFunc("worker_ratelimit_requests_available", "Requests left in the per-minute budget.", labels,
+		series(func(s Stats) float64 { return s.RequestsAvailable }))
+	reg.NewGaugeVecFunc("worker_ratelimit_tokens_available", "Tokens left in the per-minute budget.", labels,
+		series(func(s Stats) float64 { return s.TokensAvailable }))
+	reg.NewGaugeVecFunc("worker_ratelimit_waiting", "Calls queued for budget.", labels,
+		series(func(s Stats) float64 { return float64(s.Waiting) }))
+	reg.NewCounterVecFunc("worker_ratelimit_throttled_total", "Calls that had to wait for budget.", labels,
+		series(func(s Stats) float64 { return float64(s.Throttled) }))
+	reg.NewCounterVecFunc("worker_ratelimit_rate_limited_total", "429 responses from the provider.", labels,
+		series(func(s Stats) float64 { return float64(s.RateLimited) }))
+	reg.NewCounterVecFunc("worker_ratelimit_wait_seconds_total", "Time spent waiting for budget.", labels,
+		series(func(s Stats) float64 { return s.WaitTime }))
+}
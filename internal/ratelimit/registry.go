@@ -0,0 +1,149 @@
+package ratelimit
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Config maps "provider/model" keys to budgets. A "provider/*" key applies to every
+// model of that provider; Default applies when nothing else matches.
+type Config struct {
+	Default Limits
+	Limits  map[string]Limits
+}
+
+// ConfigFromEnv reads rate limits from environment variables:
+//
+//	RATE_LIMIT_DEFAULT_RPM  default requests per minute (0 = unlimited)
+//	RATE_LIMIT_DEFAULT_TPM  default tokens per minute (0 = unlimited)
+//	RATE_LIMITS             per-key budgets as key=rpm:tpm, comma separated, e.g.
+//	                        openai/text-embedding-3-small=3000:1000000,llm/*=60:0
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{Limits: map[string]Limits{}}
+
+	var err error
+	if cfg.Default.RequestsPerMinute, err = envInt("RATE_LIMIT_DEFAULT_RPM"); err != nil {
+		return Config{}, err
+	}
+	if cfg.Default.TokensPerMinute, err = envInt("RATE_LIMIT_DEFAULT_TPM"); err != nil {
+		return Config{}, err
+	}
+
+	for _, entry := range strings.Split(os.Getenv("RATE_LIMITS"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, spec, ok := strings.Cut(entry, "=")
+		if !ok {
+			return Config{}, fmt.Errorf("invalid RATE_LIMITS entry %q: expected key=rpm:tpm", entry)
+		}
+		limits, err := parseLimits(spec)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RATE_LIMITS entry %q: %w", entry, err)
+		}
+		cfg.Limits[strings.TrimSpace(key)] = limits
+	}
+
+	return cfg, nil
+}
+
+// Lookup returns the budgets for a provider/model.
+func (c Config) Lookup(provider, model string) Limits {
+	if limits, ok := c.Limits[provider+"/"+model]; ok {
+		return limits
+	}
+	if limits, ok := c.Limits[provider+"/*"]; ok {
+		return limits
+	}
+	return c.Default
+}
+
+// Registry hands out one shared Limiter per provider/model.
+type Registry struct {
+	cfg Config
+
+	mu       sync.Mutex
+	limiters map[string]*Limiter
+}
+
+// NewRegistry creates a registry for the given configuration.
+func NewRegistry(cfg Config) *Registry {
+	return &Registry{cfg: cfg, limiters: make(map[string]*Limiter)}
+}
+
+// Limiter returns the limiter for a provider/model, creating it on first use.
+func (r *Registry) Limiter(provider, model string) *Limiter {
+	key := provider + "/" + model
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if l, ok := r.limiters[key]; ok {
+		return l
+	}
+	l := NewLimiter(key, r.cfg.Lookup(provider, model))
+	r.limiters[key] = l
+	return l
+}
+
+// Stats returns usage for every limiter, sorted by key.
+func (r *Registry) Stats() []Stats {
+	r.mu.Lock()
+	limiters := make([]*Limiter, 0, len(r.limiters))
+	for _, l := range r.limiters {
+		limiters = append(limiters, l)
+	}
+	r.mu.Unlock()
+
+	stats := make([]Stats, len(limiters))
+	for i, l := range limiters {
+		stats[i] = l.Stats()
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Key < stats[j].Key })
+	return stats
+}
+
+// parseLimits parses "rpm:tpm" (either part may be empty or 0 for unlimited).
+func parseLimits(spec string) (Limits, error) {
+	rpm, tpm, _ := strings.Cut(spec, ":")
+
+	var limits Limits
+	var err error
+	if limits.RequestsPerMinute, err = parseCount(rpm); err != nil {
+		return Limits{}, fmt.Errorf("requests per minute: %w", err)
+	}
+	if limits.TokensPerMinute, err = parseCount(tpm); err != nil {
+		return Limits{}, fmt.Errorf("tokens per minute: %w", err)
+	}
+	return limits, nil
+}
+
+// parseCount parses a non-negative integer, treating "" as 0.
+func parseCount(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("%d is negative", n)
+	}
+	return n, nil
+}
+
+// envInt reads a non-negative integer environment variable (0 if unset).
+func envInt(key string) (int, error) {
+	n, err := parseCount(os.Getenv(key))
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return n, nil
+}
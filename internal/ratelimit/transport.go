@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"net/http"
+)
+
+type (
+	tokensKey  struct{}
+	limiterKey struct{}
+)
+
+// WithTokens attaches an estimated token count to a request context so Transport
+// charges it against the token budget. Requests without it only count against RPM.
+func WithTokens(ctx context.Context, tokens int) context.Context {
+	return context.WithValue(ctx, tokensKey{}, tokens)
+}
+
+// TokensFromContext returns the estimate set by WithTokens, or 0.
+func TokensFromContext(ctx context.Context) int {
+	tokens, _ := ctx.Value(tokensKey{}).(int)
+	return tokens
+}
+
+// WithLimiter makes Transport use l for requests made with ctx instead of its own
+// limiter, e.g. when one OpenAI client serves several models with separate budgets.
+func WithLimiter(ctx context.Context, l *Limiter) context.Context {
+	return context.WithValue(ctx, limiterKey{}, l)
+}
+
+// Transport is an http.RoundTripper that waits for budget before each request
+// and feeds response headers back into the limiter.
+type Transport struct {
+	Base    http.RoundTripper // Defaults to http.DefaultTransport
+	Limiter *Limiter
+}
+
+// NewTransport wraps base with a limiter.
+func NewTransport(base http.RoundTripper, limiter *Limiter) *Transport {
+	return &Transport{Base: base, Limiter: limiter}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	limiter := t.Limiter
+	if l, ok := req.Context().Value(limiterKey{}).(*Limiter); ok && l != nil {
+		limiter = l
+	}
+
+	tokens := TokensFromContext(req.Context())
+	if err := limiter.Wait(req.Context(), tokens); err != nil {
+		return nil, err
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rejected requests did not use their tokens
+	if resp.StatusCode == http.StatusTooManyRequests {
+		limiter.Report(tokens, 0)
+	}
+	limiter.Observe(resp)
+	return resp, nil
+}
+
+// Client returns a copy of client (or a new client) whose transport is rate limited.
+func Client(client *http.Client, limiter *Limiter) *http.Client {
+	var c http.Client
+	if client != nil {
+		c = *client
+	}
+	c.Transport = NewTransport(c.Transport, limiter)
+	return &c
+}
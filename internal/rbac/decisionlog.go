@@ -0,0 +1,116 @@
+package rbac
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// Actions recorded in decisions.
+const (
+	ActionInvoke = "invoke" // Run a worker function
+	ActionHTTP   = "http"   // Call an HTTP route
+)
+
+// Decision is the outcome of one access check.
+type Decision struct {
+	Time     time.Time `json:"time"`
+	Subject  string    `json:"subject"`
+	Method   string    `json:"method,omitempty"` // Credential type (token, session, oidc, grpc)
+	Roles    []string  `json:"roles,omitempty"`
+	Action   string    `json:"action"`   // invoke or http
+	Resource string    `json:"resource"` // Function name or "METHOD /path"
+	Allowed  bool      `json:"allowed"`
+	Role     string    `json:"role,omitempty"` // Role that granted access
+	Rule     string    `json:"rule,omitempty"` // Pattern that matched
+	Reason   string    `json:"reason"`
+}
+
+// DecisionLog keeps recent decisions in memory and optionally appends every
+// decision to a JSON Lines file. Denials are also written to the process log.
+type DecisionLog struct {
+	mu      sync.Mutex
+	entries []Decision
+	next    int
+	full    bool
+	out     io.Writer
+	closer  io.Closer
+}
+
+// NewDecisionLog keeps the last size decisions (default 1000) and writes each
+// decision as a JSON line to out when out is not nil.
+func NewDecisionLog(size int, out io.Writer) *DecisionLog {
+	if size <= 0 {
+		size = 1000
+	}
+	return &DecisionLog{entries: make([]Decision, size), out: out}
+}
+
+// OpenDecisionLog is NewDecisionLog with decisions appended to the file at path.
+func OpenDecisionLog(path string, size int) (*DecisionLog, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open decision log: %w", err)
+	}
+	l := NewDecisionLog(size, f)
+	l.closer = f
+	return l, nil
+}
+
+// Record stores a decision.
+func (l *DecisionLog) Record(d Decision) {
+	if !d.Allowed {
+		log.Printf("🚫 Denied %s %s for %s (roles %v): %s", d.Action, d.Resource, d.Subject, d.Roles, d.Reason)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries[l.next] = d
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+
+	if l.out != nil {
+		line, err := json.Marshal(d)
+		if err == nil {
+			_, err = l.out.Write(append(line, '\n'))
+		}
+		if err != nil {
+			log.Printf("⚠️  Failed to write RBAC decision log: %v", err)
+		}
+	}
+}
+
+// Recent returns up to n decisions, newest first (n <= 0 returns all kept).
+func (l *DecisionLog) Recent(n int) []Decision {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	count := l.next
+	if l.full {
+		count = len(l.entries)
+	}
+	if n <= 0 || n > count {
+		n = count
+	}
+
+	out := make([]Decision, 0, n)
+	for i := 1; i <= n; i++ {
+		out = append(out, l.entries[(l.next-i+len(l.entries))%len(l.entries)])
+	}
+	return out
+}
+
+// Close closes the log file opened by OpenDecisionLog.
+func (l *DecisionLog) Close() error {
+	if l == nil || l.closer == nil {
+		return nil
+	}
+	return l.closer.Close()
+}
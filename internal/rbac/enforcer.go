@@ -0,0 +1,217 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/auth"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/config"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/httpapi"
+)
+
+// ErrDenied is wrapped by errors returned when the policy denies access.
+var ErrDenied = errors.New("access denied by policy")
+
+// DeniedError describes a denied access check.
+type DeniedError struct {
+	Decision Decision
+}
+
+func (e *DeniedError) Error() string {
+	return fmt.Sprintf("%s may not %s %s: %s", e.Decision.Subject, e.Decision.Action, e.Decision.Resource, e.Decision.Reason)
+}
+
+func (e *DeniedError) Unwrap() error {
+	return ErrDenied
+}
+
+// HTTPStatus maps denials to 403 for httpapi.WriteError.
+func (e *DeniedError) HTTPStatus() int {
+	return http.StatusForbidden
+}
+
+// Config configures an Enforcer.
+type Config struct {
+	PolicyFile      string // YAML policy (empty disables enforcement)
+	DecisionLogFile string // JSON Lines file for every decision (optional)
+	DecisionLogSize int    // Decisions kept in memory (default 1000)
+	GRPCSubject     string // Subject of calls from the Dibbla platform (default grpc)
+}
+
+// ConfigFromEnv reads the RBAC configuration from environment variables:
+//
+//	RBAC_POLICY_FILE        YAML policy file (unset = allow everything)
+//	RBAC_DECISION_LOG       append every decision to this JSON Lines file
+//	RBAC_DECISION_LOG_SIZE  decisions kept in memory (default 1000)
+//	RBAC_GRPC_SUBJECT       subject used for gRPC invocations (default grpc)
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		PolicyFile:      os.Getenv("RBAC_POLICY_FILE"),
+		DecisionLogFile: os.Getenv("RBAC_DECISION_LOG"),
+		GRPCSubject:     config.GetEnvOrDefault("RBAC_GRPC_SUBJECT", SubjectGRPC),
+	}
+	if v := os.Getenv("RBAC_DECISION_LOG_SIZE"); v != "" {
+		size, err := strconv.Atoi(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RBAC_DECISION_LOG_SIZE %q: %w", v, err)
+		}
+		cfg.DecisionLogSize = size
+	}
+	return cfg, nil
+}
+
+// Enforcer checks identities against a policy and records each decision.
+// A nil Enforcer, or one without a policy, allows everything.
+type Enforcer struct {
+	policy      *Policy
+	log         *DecisionLog
+	grpcSubject string
+}
+
+// New creates an Enforcer from configuration.
+func New(cfg Config) (*Enforcer, error) {
+	e := &Enforcer{grpcSubject: cfg.GRPCSubject}
+	if e.grpcSubject == "" {
+		e.grpcSubject = SubjectGRPC
+	}
+	if cfg.PolicyFile == "" {
+		return e, nil
+	}
+
+	policy, err := LoadPolicy(cfg.PolicyFile)
+	if err != nil {
+		return nil, err
+	}
+	e.policy = policy
+
+	if cfg.DecisionLogFile != "" {
+		e.log, err = OpenDecisionLog(cfg.DecisionLogFile, cfg.DecisionLogSize)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		e.log = NewDecisionLog(cfg.DecisionLogSize, nil)
+	}
+	return e, nil
+}
+
+// NewWithPolicy creates an Enforcer for an in-memory policy.
+func NewWithPolicy(policy *Policy, log *DecisionLog) (*Enforcer, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	if log == nil {
+		log = NewDecisionLog(0, nil)
+	}
+	return &Enforcer{policy: policy, log: log, grpcSubject: SubjectGRPC}, nil
+}
+
+// Enabled reports whether a policy is loaded.
+func (e *Enforcer) Enabled() bool {
+	return e != nil && e.policy != nil
+}
+
+// Log returns the decision log (nil when disabled).
+func (e *Enforcer) Log() *DecisionLog {
+	if e == nil {
+		return nil
+	}
+	return e.log
+}
+
+// GRPCIdentity is the identity used for invocations from the Dibbla platform,
+// which carry no per-user credentials.
+func (e *Enforcer) GRPCIdentity() *auth.Identity {
+	subject := SubjectGRPC
+	if e != nil && e.grpcSubject != "" {
+		subject = e.grpcSubject
+	}
+	return &auth.Identity{Subject: subject, Method: "grpc"}
+}
+
+// CanInvoke decides whether id may run the named worker function.
+func (e *Enforcer) CanInvoke(id *auth.Identity, function string) Decision {
+	return e.decide(id, ActionInvoke, function, func(role Role) (string, bool) {
+		return role.matchFunction(function)
+	})
+}
+
+// CanAccess decides whether id may call an HTTP route.
+func (e *Enforcer) CanAccess(id *auth.Identity, method, path string) Decision {
+	return e.decide(id, ActionHTTP, method+" "+path, func(role Role) (string, bool) {
+		return role.matchRoute(method, path)
+	})
+}
+
+// Authorize checks the identity in ctx (anonymous when absent) against the
+// named function and returns a *DeniedError when access is denied.
+func (e *Enforcer) Authorize(ctx context.Context, function string) error {
+	if d := e.CanInvoke(auth.FromContext(ctx), function); !d.Allowed {
+		return &DeniedError{Decision: d}
+	}
+	return nil
+}
+
+// Middleware rejects requests the policy denies: 401 for anonymous callers,
+// 403 for authenticated ones. Place it after auth's Middleware.
+func (e *Enforcer) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !e.Enabled() {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			id := auth.FromContext(r.Context())
+			if d := e.CanAccess(id, r.Method, r.URL.Path); !d.Allowed {
+				if id == nil {
+					httpapi.WriteError(w, r, httpapi.Unauthorized("authentication required"))
+				} else {
+					httpapi.WriteError(w, r, httpapi.Forbidden("forbidden by policy"))
+				}
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// decide resolves the identity's roles and asks each role in turn.
+func (e *Enforcer) decide(id *auth.Identity, action, resource string, allows func(Role) (string, bool)) Decision {
+	d := Decision{Time: time.Now().UTC(), Subject: SubjectAnonymous, Action: action, Resource: resource}
+	var extraRoles []string
+	if id != nil {
+		d.Subject = id.Subject
+		d.Method = id.Method
+		extraRoles = id.Roles
+	}
+
+	if !e.Enabled() {
+		d.Allowed = true
+		d.Reason = "no policy loaded"
+		return d
+	}
+
+	d.Roles = e.policy.RolesFor(d.Subject, extraRoles)
+	for _, name := range d.Roles {
+		if rule, ok := allows(e.policy.Roles[name]); ok {
+			d.Allowed = true
+			d.Role = name
+			d.Rule = rule
+			d.Reason = "allowed by role " + name
+			break
+		}
+	}
+	if !d.Allowed {
+		d.Allowed = e.policy.Default == "allow"
+		d.Reason = "no role allows it (default " + e.policy.Default + ")"
+	}
+
+	e.log.Record(d)
+	return d
+}
@@ -0,0 +1,160 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/auth"
+)
+
+const testPolicy = `
+roles:
+  viewer:
+    functions: [greeting]
+    routes: ["POST /api/greeting", "GET /api/health/*"]
+  batch:
+    functions: ["process_*"]
+  operator:
+    functions: ["*"]
+    routes: ["* /api/*"]
+bindings:
+  - subjects: [dashboard]
+    roles: [viewer]
+  - subjects: [grpc]
+    roles: [viewer, batch]
+  - subjects: ["ops-*"]
+    roles: [operator]
+`
+
+func newTestEnforcer(t *testing.T, yaml string) *Enforcer {
+	t.Helper()
+	policy, err := ParsePolicy([]byte(yaml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := NewWithPolicy(policy, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func TestCanInvoke(t *testing.T) {
+	e := newTestEnforcer(t, testPolicy)
+
+	tests := []struct {
+		name     string
+		id       *auth.Identity
+		function string
+		want     bool
+		wantRole string
+	}{
+		{"bound role allows", &auth.Identity{Subject: "dashboard"}, "greeting", true, "viewer"},
+		{"bound role does not list it", &auth.Identity{Subject: "dashboard"}, "process_batch", false, ""},
+		{"prefix wildcard", e.GRPCIdentity(), "process_batch", true, "batch"},
+		{"prefix wildcard needs the prefix", e.GRPCIdentity(), "reprocess", false, ""},
+		{"subject wildcard and function *", &auth.Identity{Subject: "ops-alice"}, "anything", true, "operator"},
+		{"unbound subject is denied", &auth.Identity{Subject: "stranger"}, "greeting", false, ""},
+		{"anonymous is denied", nil, "greeting", false, ""},
+		{"role from the credential", &auth.Identity{Subject: "stranger", Roles: []string{"operator"}}, "greeting", true, "operator"},
+		{"unknown credential role is ignored", &auth.Identity{Subject: "stranger", Roles: []string{"superuser"}}, "greeting", false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := e.CanInvoke(tt.id, tt.function)
+			if d.Allowed != tt.want || d.Role != tt.wantRole {
+				t.Errorf("decision = allowed %v by %q (%s), want allowed %v by %q", d.Allowed, d.Role, d.Reason, tt.want, tt.wantRole)
+			}
+			if d.Action != ActionInvoke || d.Resource != tt.function {
+				t.Errorf("decision records %s %s", d.Action, d.Resource)
+			}
+		})
+	}
+}
+
+func TestDefaultAllow(t *testing.T) {
+	e := newTestEnforcer(t, "default: allow\nroles:\n  viewer:\n    functions: [greeting]")
+	if d := e.CanInvoke(&auth.Identity{Subject: "stranger"}, "anything"); !d.Allowed || d.Role != "" {
+		t.Errorf("default allow: %+v", d)
+	}
+}
+
+func TestNoPolicyAllowsEverything(t *testing.T) {
+	for name, e := range map[string]*Enforcer{"nil": nil, "empty": {}} {
+		if e.Enabled() {
+			t.Errorf("%s enforcer reports enabled", name)
+		}
+		if d := e.CanInvoke(nil, "greeting"); !d.Allowed {
+			t.Errorf("%s enforcer denied: %+v", name, d)
+		}
+		if d := e.CanAccess(nil, "DELETE", "/api/admin/jobs"); !d.Allowed {
+			t.Errorf("%s enforcer denied: %+v", name, d)
+		}
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	e := newTestEnforcer(t, testPolicy)
+
+	ctx := auth.WithIdentity(context.Background(), &auth.Identity{Subject: "dashboard"})
+	if err := e.Authorize(ctx, "greeting"); err != nil {
+		t.Fatal(err)
+	}
+
+	err := e.Authorize(ctx, "process_batch")
+	var denied *DeniedError
+	if !errors.Is(err, ErrDenied) || !errors.As(err, &denied) || denied.HTTPStatus() != http.StatusForbidden {
+		t.Fatalf("err = %v, want a 403 DeniedError", err)
+	}
+	if denied.Decision.Subject != "dashboard" {
+		t.Errorf("denied subject = %q", denied.Decision.Subject)
+	}
+}
+
+func TestDecisionsAreLogged(t *testing.T) {
+	e := newTestEnforcer(t, testPolicy)
+	e.CanInvoke(&auth.Identity{Subject: "dashboard"}, "greeting")
+	e.CanInvoke(nil, "greeting")
+
+	decisions := e.Log().Recent(10)
+	if len(decisions) != 2 {
+		t.Fatalf("logged %d decisions, want 2", len(decisions))
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	e := newTestEnforcer(t, testPolicy)
+	handler := e.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	tests := []struct {
+		name   string
+		id     *auth.Identity
+		method string
+		path   string
+		want   int
+	}{
+		{"allowed route", &auth.Identity{Subject: "dashboard"}, "POST", "/api/greeting", http.StatusNoContent},
+		{"wildcard route", &auth.Identity{Subject: "dashboard"}, "GET", "/api/health/ready", http.StatusNoContent},
+		{"wrong method", &auth.Identity{Subject: "dashboard"}, "GET", "/api/greeting", http.StatusForbidden},
+		{"method wildcard", &auth.Identity{Subject: "ops-bob"}, "DELETE", "/api/admin/jobs/1", http.StatusNoContent},
+		{"anonymous", nil, "POST", "/api/greeting", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.id != nil {
+				r = r.WithContext(auth.WithIdentity(r.Context(), tt.id))
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, r)
+			if rec.Code != tt.want {
+				t.Errorf("status = %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+// Package rbac decides which callers may run worker functions and HTTP routes.
+//
+// A policy file defines roles (sets of allowed function names and routes, with
+// "*" wildcards) and bindings that grant roles to subjects:
+//
+//	default: deny
+//	roles:
+//	  viewer:
+//	    functions: [greeting]
+//	    routes: ["POST /api/greeting", "GET /api/health/*"]
+//	  operator:
+//	    functions: ["*"]
+//	    routes: ["* /api/*"]
+//	bindings:
+//	  - subjects: [dashboard]
+//	    roles: [viewer]
+//
+// Subjects come from internal/auth identities (token name, session subject or
+// JWT sub). Roles carried by the identity itself (OIDC roles claim) apply too.
+// Without a policy file every request is allowed.
+package rbac
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Subjects with a fixed meaning in bindings.
+const (
+	// SubjectAnonymous is the subject of requests without credentials.
+	SubjectAnonymous = "anonymous"
+	// SubjectGRPC is the default subject for calls from the Dibbla platform.
+	SubjectGRPC = "grpc"
+)
+
+// Policy maps subjects and roles to allowed resources.
+type Policy struct {
+	// Default decides requests no role allows: "deny" (default) or "allow".
+	Default  string          `yaml:"default"`
+	Roles    map[string]Role `yaml:"roles"`
+	Bindings []Binding       `yaml:"bindings"`
+}
+
+// Role lists the resources a role may use. Patterns use "*" to match any run
+// of characters, including "/".
+type Role struct {
+	Functions []string `yaml:"functions"` // Function names, e.g. "greeting" or "process_*"
+	Routes    []string `yaml:"routes"`    // "METHOD /path", "* /path" or "/path" (any method)
+}
+
+// Binding grants roles to subjects ("*" matches every subject, including anonymous).
+type Binding struct {
+	Subjects []string `yaml:"subjects"`
+	Roles    []string `yaml:"roles"`
+}
+
+// LoadPolicy reads and validates a YAML policy file.
+func LoadPolicy(path string) (*Policy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy file: %w", err)
+	}
+	policy, err := ParsePolicy(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %w", path, err)
+	}
+	return policy, nil
+}
+
+// ParsePolicy parses and validates a YAML policy.
+func ParsePolicy(data []byte) (*Policy, error) {
+	var policy Policy
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		return nil, err
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Validate checks the default and that bindings only reference defined roles.
+func (p *Policy) Validate() error {
+	switch p.Default {
+	case "":
+		p.Default = "deny"
+	case "deny", "allow":
+	default:
+		return fmt.Errorf("default must be \"deny\" or \"allow\", got %q", p.Default)
+	}
+
+	for name, role := range p.Roles {
+		for _, route := range role.Routes {
+			if _, path := splitRoute(route); !strings.HasPrefix(path, "/") && path != "*" {
+				return fmt.Errorf("role %s: route %q must start with / (optionally preceded by a method)", name, route)
+			}
+		}
+	}
+
+	for i, binding := range p.Bindings {
+		if len(binding.Subjects) == 0 {
+			return fmt.Errorf("binding %d has no subjects", i+1)
+		}
+		for _, role := range binding.Roles {
+			if _, ok := p.Roles[role]; !ok {
+				return fmt.Errorf("binding %d references unknown role %q", i+1, role)
+			}
+		}
+	}
+	return nil
+}
+
+// RolesFor returns the sorted roles of a subject: roles bound to it in the
+// policy plus extra roles from its credential that the policy defines.
+func (p *Policy) RolesFor(subject string, extra []string) []string {
+	seen := make(map[string]bool)
+	for _, binding := range p.Bindings {
+		for _, pattern := range binding.Subjects {
+			if match(pattern, subject) {
+				for _, role := range binding.Roles {
+					seen[role] = true
+				}
+				break
+			}
+		}
+	}
+	for _, role := range extra {
+		if _, ok := p.Roles[role]; ok {
+			seen[role] = true
+		}
+	}
+
+	roles := make([]string, 0, len(seen))
+	for role := range seen {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// matchFunction returns the first function pattern of role that matches name.
+func (r Role) matchFunction(name string) (string, bool) {
+	for _, pattern := range r.Functions {
+		if match(pattern, name) {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+// matchRoute returns the first route pattern of role that matches method and path.
+func (r Role) matchRoute(method, path string) (string, bool) {
+	for _, pattern := range r.Routes {
+		patternMethod, patternPath := splitRoute(pattern)
+		if (patternMethod == "" || match(patternMethod, method)) && match(patternPath, path) {
+			return pattern, true
+		}
+	}
+	return "", false
+}
+
+// splitRoute splits "GET /api/x" into its method and path. The method is empty
+// when the pattern is a bare path.
+func splitRoute(pattern string) (method, path string) {
+	pattern = strings.TrimSpace(pattern)
+	if method, path, ok := strings.Cut(pattern, " "); ok {
+		return strings.ToUpper(method), strings.TrimSpace(path)
+	}
+	return "", pattern
+}
+
+// match reports whether s matches pattern, where "*" matches any run of characters.
+func match(pattern, s string) bool {
+	if pattern == "*" {
+		return true
+	}
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+
+	// Anchor the first and last literal parts, then find the middle ones in order
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return strings.HasSuffix(s, last)
+}
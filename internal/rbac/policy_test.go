@@ -0,0 +1,132 @@
+package rbac
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"*", "", true},
+		{"*", "greeting", true},
+		{"greeting", "greeting", true},
+		{"greeting", "greeting_v2", false},
+		{"greeting", "Greeting", false},
+		{"", "", true},
+		{"", "greeting", false},
+
+		// Prefix and suffix wildcards
+		{"process_*", "process_batch", true},
+		{"process_*", "process_", true},
+		{"process_*", "process", false},
+		{"process_*", "reprocess_batch", false},
+		{"*_batch", "process_batch", true},
+		{"*_batch", "process_batch_v2", false},
+
+		// "*" spans "/" in routes
+		{"/api/*", "/api/greeting", true},
+		{"/api/*", "/api/admin/functions", true},
+		{"/api/*", "/api", false},
+		{"/api/health/*", "/api/healthz", false},
+
+		// Middle wildcards match in order
+		{"/api/*/items/*", "/api/v1/items/42", true},
+		{"/api/*/items/*", "/api/v1/things/42", false},
+		{"a*b*c", "abc", true},
+		{"a*b*c", "aXbYc", true},
+		{"a*b*c", "acb", false},
+		{"a*a", "a", false}, // The prefix and suffix may not overlap
+		{"a*a", "aa", true},
+		{"**", "anything", true},
+	}
+	for _, tt := range tests {
+		if got := match(tt.pattern, tt.s); got != tt.want {
+			t.Errorf("match(%q, %q) = %v, want %v", tt.pattern, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestMatchRoute(t *testing.T) {
+	role := Role{Routes: []string{"POST /api/greeting", "get /api/health/*", "/api/open", "* /api/admin/*"}}
+
+	tests := []struct {
+		method, path string
+		want         string
+	}{
+		{"POST", "/api/greeting", "POST /api/greeting"},
+		{"GET", "/api/greeting", ""},
+		{"GET", "/api/health/ready", "get /api/health/*"}, // Methods are case-insensitive
+		{"DELETE", "/api/open", "/api/open"},              // A bare path allows any method
+		{"PUT", "/api/admin/jobs", "* /api/admin/*"},
+		{"GET", "/api/other", ""},
+	}
+	for _, tt := range tests {
+		got, _ := role.matchRoute(tt.method, tt.path)
+		if got != tt.want {
+			t.Errorf("matchRoute(%s %s) = %q, want %q", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{"default is deny", "roles: {}", ""},
+		{"invalid default", "default: maybe", "default must be"},
+		{"unknown role in binding", "bindings:\n  - subjects: [ci]\n    roles: [missing]", `unknown role "missing"`},
+		{"binding without subjects", "roles: {viewer: {}}\nbindings:\n  - roles: [viewer]", "no subjects"},
+		{"route without slash", "roles:\n  viewer:\n    routes: [\"GET api/greeting\"]", "must start with /"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := ParsePolicy([]byte(tt.yaml))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if p.Default != "deny" {
+					t.Errorf("default = %q, want deny", p.Default)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRolesFor(t *testing.T) {
+	p := &Policy{
+		Roles: map[string]Role{"viewer": {}, "operator": {}, "ci": {}},
+		Bindings: []Binding{
+			{Subjects: []string{"dashboard", "ops-*"}, Roles: []string{"viewer"}},
+			{Subjects: []string{"ops-*"}, Roles: []string{"operator"}},
+		},
+	}
+
+	tests := []struct {
+		subject string
+		extra   []string
+		want    []string
+	}{
+		{"dashboard", nil, []string{"viewer"}},
+		{"ops-alice", nil, []string{"operator", "viewer"}},
+		{"stranger", nil, []string{}},
+		{"stranger", []string{"ci", "undefined"}, []string{"ci"}}, // Unknown claimed roles are ignored
+		{"dashboard", []string{"viewer"}, []string{"viewer"}},
+	}
+	for _, tt := range tests {
+		if got := p.RolesFor(tt.subject, tt.extra); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("RolesFor(%q, %v) = %v, want %v", tt.subject, tt.extra, got, tt.want)
+		}
+	}
+}
@@ -15,6 +15,25 @@
 package state
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/sashabaranov/go-openai"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/circuitbreaker"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/config"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/embeddings"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/health"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/prompts"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/ratelimit"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tracing"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/vectorstore"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/vectorstore/pgvector"
+
 	// Uncomment if using GORM with PostgreSQL
 	// "gorm.io/driver/postgres"
 	// "gorm.io/gorm"
@@ -49,6 +68,30 @@ type AsyncGlobalState struct {
 	
 	// Metrics and monitoring
 	// MetricsCollector *metrics.Collector
+
+	// OpenAI embeddings client (nil when OPENAI_API_KEY is not set)
+	// Configured via EMBEDDING_* environment variables (see internal/embeddings)
+	EmbeddingsClient *embeddings.Client
+
+	// OpenAI chat client for chat-completion tasks (nil when OPENAI_API_KEY is not set)
+	// ChatModel is the default model, from CHAT_MODEL (default gpt-4o-mini)
+	ChatClient *openai.Client
+	ChatModel  string
+
+	// Client-side request/token budgets per provider/model (RATE_LIMIT_* environment variables)
+	// Shared by the OpenAI clients above; use RateLimits.Stats() to inspect usage
+	RateLimits *ratelimit.Registry
+
+	// Circuit breakers for external dependencies (CIRCUIT_BREAKER_* environment variables)
+	// The OpenAI clients share the "openai" breaker; state is served at GET /api/health/breakers
+	Breakers *circuitbreaker.Registry
+
+	// Versioned prompt templates (embedded, plus PROMPTS_DIR if set)
+	Prompts *prompts.Library
+
+	// Vector store for similarity search over embeddings
+	// Configured via VECTOR_STORE_* environment variables (see internal/vectorstore)
+	Vectors vectorstore.Store
 }
 
 // NewAsyncGlobalState initializes the global state with all shared resources.
@@ -95,18 +138,166 @@ func NewAsyncGlobalState() (*AsyncGlobalState, error) {
 	// }
 	// apiClient := api.NewClient(cfg.APIKey, cfg.APIBaseURL)
 	
+	// Vector store (backend selected by VECTOR_STORE_BACKEND)
+	vectors, err := newVectorStore()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize vector store: %w", err)
+	}
+
+	// Prompt templates for chat-completion tasks
+	promptLibrary, err := prompts.Default()
+	if err != nil {
+		vectors.Close()
+		return nil, fmt.Errorf("failed to load prompt templates: %w", err)
+	}
+
+	// Rate limits for outbound AI API calls
+	rateLimitConfig, err := ratelimit.ConfigFromEnv()
+	if err != nil {
+		vectors.Close()
+		return nil, fmt.Errorf("failed to load rate limits: %w", err)
+	}
+	rateLimits := ratelimit.NewRegistry(rateLimitConfig)
+
+	// Circuit breakers (shared with the health endpoint through the default registry)
+	breakerConfig, err := circuitbreaker.ConfigFromEnv()
+	if err != nil {
+		vectors.Close()
+		return nil, fmt.Errorf("failed to load circuit breaker config: %w", err)
+	}
+	breakers := circuitbreaker.DefaultRegistry
+	breakers.Configure(breakerConfig)
+
+	// OpenAI clients (optional - only when OPENAI_API_KEY is set)
+	var embeddingsClient *embeddings.Client
+	var chatClient *openai.Client
+	chatModel := config.GetEnvOrDefault("CHAT_MODEL", openai.GPT4oMini)
+	if apiKey := os.Getenv("OPENAI_API_KEY"); apiKey != "" {
+		// Tasks using another model pass its limiter with ratelimit.WithLimiter
+		chatConfig := openai.DefaultConfig(apiKey)
+		chatConfig.HTTPClient = tracing.Client(circuitbreaker.Client(
+			ratelimit.Client(nil, rateLimits.Limiter("openai", chatModel)),
+			breakers.Get("openai"),
+		))
+		chatClient = openai.NewClientWithConfig(chatConfig)
+
+		embeddingsConfig, err := embeddings.ConfigFromEnv()
+		if err != nil {
+			vectors.Close()
+			return nil, fmt.Errorf("failed to load embeddings config: %w", err)
+		}
+		embeddingsConfig.Limiter = rateLimits.Limiter("openai", string(embeddingsConfig.Model))
+		embeddingsConfig.Breaker = breakers.Get("openai")
+
+		embeddingsClient, err = embeddings.NewClientWithConfig(embeddingsConfig)
+		if err != nil {
+			vectors.Close()
+			return nil, fmt.Errorf("failed to initialize embeddings client: %w", err)
+		}
+
+		// Embeddings must fit the vector store, otherwise every write would fail later
+		if dims := embeddingsClient.Dimensions(); dims > 0 {
+			if err := vectorstore.CheckDimension(vectors, dims); err != nil {
+				vectors.Close()
+				return nil, fmt.Errorf("embedding model %s does not match vector store (set EMBEDDING_DIMENSIONS or VECTOR_STORE_DIMENSION): %w",
+					embeddingsClient.Model(), err)
+			}
+		}
+	} else {
+		log.Println("⚠️  OPENAI_API_KEY not set, embeddings and chat clients disabled")
+	}
+
 	// Example: Initialize Redis cache
 	// redisClient := redis.NewClient(&redis.Options{
 	// 	Addr: os.Getenv("REDIS_URL"),
 	// })
 	
-	return &AsyncGlobalState{
+	ags := &AsyncGlobalState{
 		// TODO: Uncomment and assign your initialized resources
 		// DB: db,
 		// ExternalAPIClient: apiClient,
 		// RedisClient: redisClient,
 		// Config: cfg,
-	}, nil
+		EmbeddingsClient: embeddingsClient,
+		ChatClient:       chatClient,
+		ChatModel:        chatModel,
+		RateLimits:       rateLimits,
+		Breakers:         breakers,
+		Prompts:          promptLibrary,
+		Vectors:          vectors,
+	}
+
+	// Readiness checks for the shared resources (served at /readyz)
+	ags.RegisterHealthChecks(health.DefaultRegistry)
+
+	// Rate limiter budgets and queues (served at /metrics)
+	rateLimits.RegisterMetrics(metrics.DefaultRegistry)
+
+	return ags, nil
+}
+
+// RegisterHealthChecks registers a check per shared resource.
+// TODO: Add checks for the resources you enable above, e.g. the database:
+//
+//	registry.Register(health.Check{Name: "database", Critical: true, Run: func(ctx context.Context) error {
+//		sqlDB, err := ags.DB.DB()
+//		if err != nil {
+//			return err
+//		}
+//		return sqlDB.PingContext(ctx)
+//	}})
+func (ags *AsyncGlobalState) RegisterHealthChecks(registry *health.Registry) {
+	// Vector store: a count reaches the database for pgvector
+	if ags.Vectors != nil {
+		registry.Register(health.Check{
+			Name:     "vector_store",
+			Critical: true,
+			Run: func(ctx context.Context) error {
+				_, err := ags.Vectors.Count(ctx)
+				return err
+			},
+		})
+	}
+
+	// OpenAI: reported from the circuit breaker, so probes never spend API quota.
+	// Not critical - functions that don't call OpenAI keep working.
+	if ags.EmbeddingsClient != nil || ags.ChatClient != nil {
+		registry.Register(health.Check{
+			Name: "openai",
+			Run: func(ctx context.Context) error {
+				if status := ags.Breakers.Get("openai").Status(); status.State == circuitbreaker.Open {
+					return fmt.Errorf("circuit breaker open: %s", status.LastError)
+				}
+				return nil
+			},
+		})
+	}
+}
+
+// newVectorStore creates the vector store selected by VECTOR_STORE_BACKEND:
+// "flat" or "hnsw" for an in-process index, "pgvector" for PostgreSQL (DATABASE_URL).
+//
+// The pgvector backend opens its own connection. If you enable the shared DB above,
+// use pgvector.New(db, cfg) followed by Migrate instead to reuse the pool.
+func newVectorStore() (vectorstore.Store, error) {
+	cfg, err := vectorstore.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	switch backend := strings.ToLower(strings.TrimSpace(cfg.Backend)); backend {
+	case "pgvector":
+		pgCfg, err := pgvector.ConfigFromEnv(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return pgvector.Open(context.Background(), os.Getenv("DATABASE_URL"), pgCfg)
+	case "flat", "hnsw":
+		cfg.Backend = backend
+		return vectorstore.New(cfg)
+	default:
+		return nil, fmt.Errorf("unknown VECTOR_STORE_BACKEND %q (want flat, hnsw or pgvector)", cfg.Backend)
+	}
 }
 
 // Close gracefully closes all connections and releases resources.
@@ -125,6 +316,13 @@ func (ags *AsyncGlobalState) Close() error {
 	// 	}
 	// }
 	
+	// Close vector store (saves a snapshot when VECTOR_STORE_PATH is set)
+	if ags.Vectors != nil {
+		if err := ags.Vectors.Close(); err != nil {
+			return fmt.Errorf("failed to close vector store: %w", err)
+		}
+	}
+
 	// Example: Close Redis connection
 	// if ags.RedisClient != nil {
 	// 	if err := ags.RedisClient.Close(); err != nil {
@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Instrument wraps a worker function handler in a span and passes the span's
+// context to the handler, so jobs, tasks and outbound calls it makes become
+// child spans:
+//
+//	sdk.NewSimpleFunction[Input, Output](name, version, "...").
+//	    WithHandler(metrics.Instrument(name, version, tracing.Instrument(name, version, handle)))
+//
+//	func handle(ctx context.Context, input Input) (Output, error)
+func Instrument[In, Out any](name, version string, handler func(context.Context, In) (Out, error)) func(In) (Out, error) {
+	return func(input In) (out Out, err error) {
+		ctx, span := Start(context.Background(), "function "+name,
+			attribute.String("worker.function.name", name),
+			attribute.String("worker.function.version", version),
+		)
+		defer func() {
+			if p := recover(); p != nil {
+				span.SetAttributes(attribute.Bool("worker.function.panic", true))
+				span.End()
+				panic(p)
+			}
+			End(span, err)
+		}()
+		return handler(ctx, input)
+	}
+}
+
+// InstrumentFull is Instrument for sdk.NewFunction handlers, which also receive
+// the event message and global state.
+func InstrumentFull[In, Out, Event, State any](name, version string, handler func(context.Context, In, Event, State) (Out, error)) func(In, Event, State) (Out, error) {
+	return func(input In, event Event, state State) (Out, error) {
+		return Instrument(name, version, func(ctx context.Context, input In) (Out, error) {
+			return handler(ctx, input, event, state)
+		})(input)
+	}
+}
@@ -0,0 +1,198 @@
+// Package tracing sets up OpenTelemetry tracing and provides helpers for the
+// spans the worker records: worker function invocations, job phases, tasks,
+// HTTP requests and outbound HTTP calls. Tracing is off unless an exporter is
+// configured; spans are then no-ops.
+//
+//	ctx, span := tracing.Start(ctx, "task my_task")
+//	defer func() { tracing.End(span, err) }()
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/config"
+)
+
+// instrumentationName identifies the worker's spans.
+const instrumentationName = "github.com/dibbla-agents/go-worker-starter-template"
+
+// Exporters.
+const (
+	ExporterNone   = "none"
+	ExporterOTLP   = "otlp"   // OTLP/HTTP; endpoint and headers from OTEL_EXPORTER_OTLP_* variables
+	ExporterStdout = "stdout" // Pretty-printed JSON on stdout
+	ExporterFile   = "file"   // One JSON span per line, appended to File
+)
+
+// Config controls the tracer provider.
+type Config struct {
+	Exporter    string  // ExporterNone (default), ExporterOTLP, ExporterStdout or ExporterFile
+	File        string  // Output path for ExporterFile
+	SampleRatio float64 // Fraction of new traces recorded; spans with a parent follow its decision
+	ServiceName string  // service.name resource attribute (OTEL_SERVICE_NAME overrides it)
+	Version     string  // service.version resource attribute (optional)
+}
+
+// ConfigFromEnv reads tracing settings from environment variables:
+//
+//	TRACING_EXPORTER      none (default), otlp, stdout or file
+//	TRACING_FILE          output path for the file exporter (default traces.jsonl)
+//	TRACING_SAMPLE_RATIO  fraction of traces to record, 0 to 1 (default 1)
+//	APP_VERSION           service.version of the spans
+//
+// The OTLP exporter reads the standard OTEL_EXPORTER_OTLP_ENDPOINT,
+// OTEL_EXPORTER_OTLP_HEADERS and related variables.
+func ConfigFromEnv(serviceName string) (Config, error) {
+	cfg := Config{
+		Exporter:    strings.ToLower(config.GetEnvOrDefault("TRACING_EXPORTER", ExporterNone)),
+		File:        config.GetEnvOrDefault("TRACING_FILE", "traces.jsonl"),
+		SampleRatio: 1,
+		ServiceName: serviceName,
+		Version:     os.Getenv("APP_VERSION"),
+	}
+	switch cfg.Exporter {
+	case ExporterNone, ExporterOTLP, ExporterStdout, ExporterFile:
+	default:
+		return cfg, fmt.Errorf("invalid TRACING_EXPORTER %q: want none, otlp, stdout or file", cfg.Exporter)
+	}
+	if v := os.Getenv("TRACING_SAMPLE_RATIO"); v != "" {
+		ratio, err := strconv.ParseFloat(v, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			return cfg, fmt.Errorf("invalid TRACING_SAMPLE_RATIO %q: want a number from 0 to 1", v)
+		}
+		cfg.SampleRatio = ratio
+	}
+	return cfg, nil
+}
+
+// Setup installs the global tracer provider and the W3C trace context
+// propagator. Call shutdown before exiting to flush buffered spans.
+func Setup(ctx context.Context, cfg Config) (shutdown func(context.Context) error, err error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if cfg.Exporter == "" || cfg.Exporter == ExporterNone {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	var processor sdktrace.SpanProcessor
+	var closeFile func() error
+	switch cfg.Exporter {
+	case ExporterOTLP:
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+		}
+		processor = sdktrace.NewBatchSpanProcessor(exporter)
+	case ExporterStdout:
+		exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %w", err)
+		}
+		// Export synchronously so offline runs never lose spans on exit
+		processor = sdktrace.NewSimpleSpanProcessor(exporter)
+	case ExporterFile:
+		f, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to create file exporter: %w", err)
+		}
+		processor = sdktrace.NewSimpleSpanProcessor(exporter)
+		closeFile = f.Close
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", cfg.Exporter)
+	}
+
+	attrs := []attribute.KeyValue{semconv.ServiceName(cfg.ServiceName)}
+	if cfg.Version != "" {
+		attrs = append(attrs, semconv.ServiceVersion(cfg.Version))
+	}
+	res, err := resource.New(ctx,
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(attrs...),
+		resource.WithFromEnv(), // OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES win
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build trace resource: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	target := cfg.Exporter
+	if cfg.Exporter == ExporterFile {
+		target = cfg.File
+	}
+	log.Printf("🔭 Tracing enabled: exporting to %s (sample ratio %g)", target, cfg.SampleRatio)
+
+	return func(ctx context.Context) error {
+		err := provider.Shutdown(ctx)
+		if closeFile != nil {
+			err = errors.Join(err, closeFile())
+		}
+		return err
+	}, nil
+}
+
+// Tracer returns the worker's tracer from the global provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Start starts a span as a child of the span in ctx, if any.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records err on the span, if any, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// NewTransport wraps base (default http.DefaultTransport) so every outbound
+// request gets a client span and carries the trace context to the server.
+func NewTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return otelhttp.NewTransport(base)
+}
+
+// Client returns a copy of client (or a new client) whose requests are traced.
+func Client(client *http.Client) *http.Client {
+	var c http.Client
+	if client != nil {
+		c = *client
+	}
+	c.Transport = NewTransport(c.Transport)
+	return &c
+}
@@ -0,0 +1,336 @@
+// Package tsgen generates a typed TypeScript client for the frontend from the
+// OpenAPI document of the HTTP routes and the worker function definitions.
+// Changing a Go request or response struct changes the generated interfaces,
+// so mismatches surface as TypeScript compile errors.
+//
+//	doc := openapi.Generate(router.Routes(), info)
+//	src := tsgen.Generate(doc, functions)
+package tsgen
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/openapi"
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
+)
+
+// Header marks the file as generated so editors and linters leave it alone.
+const Header = "// Code generated by \"worker tsclient\"; DO NOT EDIT.\n"
+
+// identifier matches names usable as unquoted TypeScript property names.
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// pathParam matches {id} in document paths.
+var pathParam = regexp.MustCompile(`\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// Generate returns the TypeScript source for the document's operations and the
+// given worker functions. Function input and output types are added to the
+// document's components.
+func Generate(doc *openapi.Document, functions []workerfunctions.Definition) []byte {
+	// Resolve function types first so their components are emitted below
+	type functionTypes struct {
+		def           workerfunctions.Definition
+		input, output string
+	}
+	var fns []functionTypes
+	for _, fn := range functions {
+		fns = append(fns, functionTypes{
+			def:    fn,
+			input:  typeOf(doc.SchemaFor(fn.Input)),
+			output: typeOf(doc.SchemaFor(fn.Output)),
+		})
+	}
+
+	var b bytes.Buffer
+	b.WriteString(Header)
+	fmt.Fprintf(&b, "// Client for %s %s. Regenerate with: go generate ./cmd/worker\n\n", doc.Info.Title, doc.Info.Version)
+
+	// Interfaces for every component, sorted by name
+	names := make([]string, 0, len(doc.Components.Schemas))
+	for name := range doc.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeInterface(&b, name, doc.Components.Schemas[name])
+	}
+
+	b.WriteString(runtime)
+
+	// One function per operation
+	for _, op := range doc.Operations() {
+		writeOperation(&b, op)
+	}
+
+	// Worker functions: types and metadata
+	b.WriteString("\n/** Input and output types of the worker functions. */\nexport interface WorkerFunctionTypes {\n")
+	for _, fn := range fns {
+		fmt.Fprintf(&b, "  /** %s (v%s) */\n", comment(fn.def.Description), fn.def.Version)
+		fmt.Fprintf(&b, "  %s: { input: %s; output: %s }\n", property(fn.def.Name), fn.input, fn.output)
+	}
+	b.WriteString("}\n\n")
+	b.WriteString("export type WorkerFunctionName = keyof WorkerFunctionTypes\n")
+	b.WriteString("export type WorkerFunctionInput<N extends WorkerFunctionName> = WorkerFunctionTypes[N]['input']\n")
+	b.WriteString("export type WorkerFunctionOutput<N extends WorkerFunctionName> = WorkerFunctionTypes[N]['output']\n\n")
+	b.WriteString("/** Metadata of the worker functions registered by the worker. */\n")
+	b.WriteString("export const workerFunctions: Record<WorkerFunctionName, { version: string; description: string; tags: string[] }> = {\n")
+	for _, fn := range fns {
+		tags := make([]string, len(fn.def.Tags))
+		for i, tag := range fn.def.Tags {
+			tags[i] = quote(tag)
+		}
+		fmt.Fprintf(&b, "  %s: { version: %s, description: %s, tags: [%s] },\n",
+			property(fn.def.Name), quote(fn.def.Version), quote(fn.def.Description), strings.Join(tags, ", "))
+	}
+	b.WriteString("}\n")
+	return b.Bytes()
+}
+
+// runtime is the request helper and error type shared by all operations.
+const runtime = `/** Error thrown for non-2xx responses; problem holds the RFC 9457 body. */
+export class ApiError extends Error {
+  readonly status: number
+  readonly problem: Problem
+
+  constructor(status: number, problem: Problem) {
+    super(problem.detail || problem.title || ` + "`Request failed (${status})`" + `)
+    this.name = 'ApiError'
+    this.status = status
+    this.problem = problem
+  }
+}
+
+/** Options applied to every request. */
+export interface ClientOptions {
+  baseUrl?: string
+  headers?: Record<string, string>
+}
+
+let clientOptions: ClientOptions = {}
+
+/** Sets the base URL (default same origin) and extra headers, e.g. Authorization. */
+export function configureClient(options: ClientOptions): void {
+  clientOptions = { ...clientOptions, ...options }
+}
+
+async function send(method: string, path: string, body?: unknown): Promise<Response> {
+  const headers: Record<string, string> = { Accept: 'application/json', ...clientOptions.headers }
+  if (body !== undefined) headers['Content-Type'] = 'application/json'
+  const res = await fetch((clientOptions.baseUrl ?? '') + path, {
+    method,
+    headers,
+    body: body === undefined ? undefined : JSON.stringify(body),
+    credentials: 'same-origin',
+  })
+  if (!res.ok) {
+    const problem = await res.json().catch(() => ({ type: 'about:blank', title: res.statusText, status: res.status }))
+    throw new ApiError(res.status, problem as Problem)
+  }
+  return res
+}
+
+async function request<T>(method: string, path: string, body?: unknown): Promise<T> {
+  const res = await send(method, path, body)
+  if (res.status === 204) return undefined as T
+  return (await res.json()) as T
+}
+`
+
+// writeInterface writes a component as an interface, or a type alias for
+// components that aren't plain objects.
+func writeInterface(b *bytes.Buffer, name string, schema *openapi.Schema) {
+	if schema.Description != "" {
+		fmt.Fprintf(b, "/** %s */\n", comment(schema.Description))
+	}
+	if schema.Type != "object" || schema.Properties == nil {
+		fmt.Fprintf(b, "export type %s = %s\n\n", name, typeOf(schema))
+		return
+	}
+	fmt.Fprintf(b, "export interface %s %s\n\n", name, objectType(schema, ""))
+}
+
+// writeOperation writes the client function for one operation.
+func writeOperation(b *bytes.Buffer, op openapi.PathOperation) {
+	var params []string
+	for _, p := range op.Operation.Parameters {
+		params = append(params, p.Name+": string")
+	}
+	body := ""
+	if rb := op.Operation.RequestBody; rb != nil {
+		if media, ok := rb.Content["application/json"]; ok {
+			params = append(params, "body: "+typeOf(media.Schema))
+			body = ", body"
+		}
+	}
+
+	// URL template with encoded path parameters
+	url := "'" + op.Path + "'"
+	if len(op.Operation.Parameters) > 0 {
+		url = "`" + pathParam.ReplaceAllString(op.Path, "$${encodeURIComponent($1)}") + "`"
+	}
+
+	summary := op.Operation.Summary
+	if summary == "" {
+		summary = op.Method + " " + op.Path
+	}
+	fmt.Fprintf(b, "\n/** %s */\n", comment(summary))
+	result, call := successType(op.Operation), "request"
+	if result == "" {
+		// Not JSON (e.g. an HTML page): hand back the response
+		result, call = "Response", "send"
+	}
+	fmt.Fprintf(b, "export function %s(%s): Promise<%s> {\n", op.Operation.OperationID, strings.Join(params, ", "), result)
+	fmt.Fprintf(b, "  return %s('%s', %s%s)\n}\n", call, op.Method, url, body)
+}
+
+// successType is the body type of the lowest 2xx response, or "" when that
+// response isn't documented as JSON.
+func successType(op *openapi.Operation) string {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	sort.Strings(codes)
+	if len(codes) == 0 {
+		return ""
+	}
+	if codes[0] == "204" {
+		return "void"
+	}
+	media, ok := op.Responses[codes[0]].Content["application/json"]
+	if !ok {
+		return ""
+	}
+	return typeOf(media.Schema)
+}
+
+// typeOf converts a schema to a TypeScript type expression.
+func typeOf(schema *openapi.Schema) string {
+	if schema == nil {
+		return "unknown"
+	}
+	if schema.Ref != "" {
+		return schema.Ref[strings.LastIndex(schema.Ref, "/")+1:]
+	}
+	if len(schema.Enum) > 0 {
+		values := make([]string, len(schema.Enum))
+		for i, v := range schema.Enum {
+			values[i] = literal(v)
+		}
+		return strings.Join(values, " | ")
+	}
+
+	switch t := schema.Type.(type) {
+	case string:
+		return baseType(t, schema)
+	case []string:
+		types := make([]string, len(t))
+		for i, name := range t {
+			types[i] = baseType(name, schema)
+		}
+		return strings.Join(types, " | ")
+	}
+	return "unknown"
+}
+
+func baseType(name string, schema *openapi.Schema) string {
+	switch name {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "null":
+		return "null"
+	case "array":
+		item := typeOf(schema.Items)
+		if strings.Contains(item, " ") {
+			item = "(" + item + ")"
+		}
+		return item + "[]"
+	case "object":
+		if schema.Properties != nil {
+			return objectType(schema, "")
+		}
+		if schema.AdditionalProperties != nil {
+			return "Record<string, " + typeOf(schema.AdditionalProperties) + ">"
+		}
+		return "Record<string, unknown>"
+	}
+	return "unknown"
+}
+
+// objectType writes an object literal type with sorted properties.
+func objectType(schema *openapi.Schema, indent string) string {
+	if len(schema.Properties) == 0 {
+		return "{}"
+	}
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, name := range names {
+		prop := schema.Properties[name]
+		if prop.Description != "" {
+			fmt.Fprintf(&b, "%s  /** %s */\n", indent, comment(prop.Description))
+		}
+		optional := "?"
+		if required[name] {
+			optional = ""
+		}
+		typ := typeOf(prop)
+		if prop.Ref == "" && prop.Type == "object" && prop.Properties != nil {
+			typ = objectType(prop, indent+"  ")
+		}
+		fmt.Fprintf(&b, "%s  %s%s: %s\n", indent, property(name), optional, typ)
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+// property quotes names that aren't valid identifiers.
+func property(name string) string {
+	if identifier.MatchString(name) {
+		return name
+	}
+	return quote(name)
+}
+
+// literal renders an enum value.
+func literal(v any) string {
+	switch v := v.(type) {
+	case string:
+		return quote(v)
+	case nil:
+		return "null"
+	}
+	return fmt.Sprint(v)
+}
+
+// quote writes a single-quoted string literal, matching the frontend's style.
+func quote(s string) string {
+	q := strconv.Quote(s)
+	q = strings.ReplaceAll(q[1:len(q)-1], `\"`, `"`)
+	return "'" + strings.ReplaceAll(q, "'", `\'`) + "'"
+}
+
+// comment keeps text from closing a JSDoc comment.
+func comment(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "*/", "* /"), "\n", " ")
+}
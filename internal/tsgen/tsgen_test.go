@@ -0,0 +1,121 @@
+package tsgen_test
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/frontend"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/openapi"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tsgen"
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
+)
+
+type JobInput struct {
+	Name     string            `json:"name"`
+	Priority *int              `json:"priority"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Options  struct {
+		DryRun bool `json:"dry-run"`
+	} `json:"options"`
+}
+
+type JobOutput struct {
+	ID    string   `json:"id"`
+	Steps []*int   `json:"steps"`
+	Notes []string `json:"notes,omitempty"`
+}
+
+type BatchInput struct {
+	Items []string `json:"items"`
+}
+
+type BatchOutput struct {
+	Processed int `json:"processed"`
+}
+
+func noop(w http.ResponseWriter, r *http.Request) {}
+
+func generate(t *testing.T) string {
+	t.Helper()
+	router := frontend.NewRouter()
+	api := router.Group("/api")
+	api.Handle("POST /jobs", frontend.DescribeFunc(noop, frontend.RouteDoc{
+		Summary: "Start a job */ with a comment terminator",
+		Input:   JobInput{},
+		Output:  JobOutput{},
+		Status:  http.StatusCreated,
+	}))
+	api.Handle("GET /jobs/{id}", frontend.DescribeFunc(noop, frontend.RouteDoc{Output: JobOutput{}}))
+	api.Handle("DELETE /jobs/{id}", frontend.DescribeFunc(noop, frontend.RouteDoc{Status: http.StatusNoContent}))
+	api.HandleFunc("GET /docs", noop)
+
+	doc := openapi.Generate(router.Routes(), openapi.Info{Title: "test", Version: "2.0.0"})
+	functions := []workerfunctions.Definition{{
+		Name:        "process_batch",
+		Version:     "1.2.0",
+		Description: "Process a batch of items",
+		Tags:        []string{"batch", "it's"},
+		Input:       BatchInput{},
+		Output:      BatchOutput{},
+	}}
+	return string(tsgen.Generate(doc, functions))
+}
+
+func TestGenerate(t *testing.T) {
+	src := generate(t)
+
+	if !strings.HasPrefix(src, tsgen.Header+"// Client for test 2.0.0.") {
+		t.Errorf("header:\n%s", src[:200])
+	}
+
+	want := []string{
+		// Interfaces with sorted properties, optional and nullable fields,
+		// quoted names and inline objects
+		"export interface TsgenTestJobInput {\n" +
+			"  labels?: Record<string, string>\n" +
+			"  name: string\n" +
+			"  options: {\n" +
+			"    'dry-run': boolean\n" +
+			"  }\n" +
+			"  priority?: number | null\n" +
+			"}\n",
+		"export interface TsgenTestJobOutput {\n" +
+			"  id: string\n" +
+			"  notes?: string[]\n" +
+			"  steps: (number | null)[]\n" +
+			"}\n",
+		"export interface Problem {",
+		"export class ApiError extends Error {",
+
+		// Operations
+		"/** Start a job * / with a comment terminator */\n" +
+			"export function postJobs(body: TsgenTestJobInput): Promise<TsgenTestJobOutput> {\n" +
+			"  return request('POST', '/api/jobs', body)\n}\n",
+		"export function getJobsById(id: string): Promise<TsgenTestJobOutput> {\n" +
+			"  return request('GET', `/api/jobs/${encodeURIComponent(id)}`)\n}\n",
+		"export function deleteJobsById(id: string): Promise<void> {\n",
+		"export function getDocs(): Promise<Response> {\n  return send('GET', '/api/docs')\n}\n",
+
+		// Worker functions
+		"export interface TsgenTestBatchInput {\n  items: string[]\n}\n",
+		"  /** Process a batch of items (v1.2.0) */\n" +
+			"  process_batch: { input: TsgenTestBatchInput; output: TsgenTestBatchOutput }\n",
+		"  process_batch: { version: '1.2.0', description: 'Process a batch of items', tags: ['batch', 'it\\'s'] },\n",
+	}
+	for _, snippet := range want {
+		if !strings.Contains(src, snippet) {
+			t.Errorf("generated client is missing:\n%s\n\ngot:\n%s", snippet, src)
+			return
+		}
+	}
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	first := generate(t)
+	for i := 0; i < 5; i++ {
+		if generate(t) != first {
+			t.Fatal("output changed between runs")
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package vectorstore
+
+import "sort"
+
+// candidate is a graph node paired with its distance to the query.
+type candidate struct {
+	index    int32
+	distance float32
+}
+
+// sortCandidates orders candidates by ascending distance.
+func sortCandidates(c []candidate) {
+	sort.Slice(c, func(i, j int) bool { return c[i].distance < c[j].distance })
+}
+
+// candidateHeap is a min-heap on distance, or a max-heap when max is set.
+type candidateHeap struct {
+	items []candidate
+	max   bool
+}
+
+func (h *candidateHeap) Len() int { return len(h.items) }
+func (h *candidateHeap) Less(i, j int) bool {
+	if h.max {
+		return h.items[i].distance > h.items[j].distance
+	}
+	return h.items[i].distance < h.items[j].distance
+}
+func (h *candidateHeap) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *candidateHeap) Push(x interface{}) { h.items = append(h.items, x.(candidate)) }
+func (h *candidateHeap) Pop() interface{} {
+	n := len(h.items)
+	item := h.items[n-1]
+	h.items = h.items[:n-1]
+	return item
+}
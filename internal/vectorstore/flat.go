@@ -0,0 +1,118 @@
+package vectorstore
+
+import (
+	"context"
+	"sync"
+)
+
+// FlatIndex is an exact, brute-force in-process index.
+// Every query scans all records, so results are always exact.
+type FlatIndex struct {
+	mu        sync.RWMutex
+	dimension int
+	metric    Metric
+	records   map[string]Record
+	path      string
+}
+
+// NewFlatIndex creates an empty brute-force index.
+func NewFlatIndex(dimension int, metric Metric) *FlatIndex {
+	if metric == "" {
+		metric = Cosine
+	}
+	return &FlatIndex{
+		dimension: dimension,
+		metric:    metric,
+		records:   make(map[string]Record),
+	}
+}
+
+// Upsert inserts or replaces records.
+func (f *FlatIndex) Upsert(ctx context.Context, records ...Record) error {
+	for _, rec := range records {
+		if err := ValidateRecord(rec, f.dimension); err != nil {
+			return err
+		}
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, rec := range records {
+		f.records[rec.ID] = Record{
+			ID:       rec.ID,
+			Vector:   append([]float32(nil), rec.Vector...),
+			Metadata: copyMetadata(rec.Metadata),
+		}
+	}
+	return nil
+}
+
+// Delete removes records by ID.
+func (f *FlatIndex) Delete(ctx context.Context, ids ...string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, id := range ids {
+		delete(f.records, id)
+	}
+	return nil
+}
+
+// Query scans all records and returns the TopK closest matches.
+func (f *FlatIndex) Query(ctx context.Context, q Query) ([]Match, error) {
+	if err := ValidateQuery(q, f.dimension); err != nil {
+		return nil, err
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	results := newTopK(q.TopK)
+	scanned := 0
+	for _, rec := range f.records {
+		// Check for cancellation periodically on large scans
+		scanned++
+		if scanned%4096 == 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+		}
+
+		if !q.Filter.Matches(rec.Metadata) {
+			continue
+		}
+		results.push(Match{
+			ID:       rec.ID,
+			Distance: f.metric.Distance(q.Vector, rec.Vector),
+			Metadata: rec.Metadata,
+		})
+	}
+
+	return results.sorted(), nil
+}
+
+// Count returns the number of stored records.
+func (f *FlatIndex) Count(ctx context.Context) (int, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return len(f.records), nil
+}
+
+// Dimension returns the configured vector dimension.
+func (f *FlatIndex) Dimension() int {
+	return f.dimension
+}
+
+// Metric returns the configured distance metric.
+func (f *FlatIndex) Metric() Metric {
+	return f.metric
+}
+
+// Close saves a snapshot if the index was opened with a Path.
+func (f *FlatIndex) Close() error {
+	if f.path == "" {
+		return nil
+	}
+	return SaveFile(f.path, f)
+}
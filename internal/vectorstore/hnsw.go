@@ -0,0 +1,416 @@
+package vectorstore
+
+import (
+	"container/heap"
+	"context"
+	"math"
+	"math/rand"
+	"sync"
+)
+
+// HNSWConfig tunes the HNSW graph.
+// Higher values improve recall at the cost of memory and build/query time.
+type HNSWConfig struct {
+	M              int   // Max neighbours per node on upper layers (layer 0 keeps 2*M)
+	EfConstruction int   // Candidate list size while inserting
+	EfSearch       int   // Candidate list size while querying (raised to TopK if smaller)
+	Seed           int64 // Seed for level assignment, for reproducible graphs
+}
+
+// DefaultHNSWConfig returns settings that work well for typical embedding sizes.
+func DefaultHNSWConfig() HNSWConfig {
+	return HNSWConfig{
+		M:              16,
+		EfConstruction: 200,
+		EfSearch:       64,
+		Seed:           42,
+	}
+}
+
+// hnswNode is a vector in the graph. Deleted nodes stay in the graph as
+// routing points until the index is compacted, but never appear in results.
+type hnswNode struct {
+	ID        string
+	Vector    []float32
+	Metadata  map[string]string
+	Level     int
+	Neighbors [][]int32 // Neighbour node indices, one list per level
+	Deleted   bool
+}
+
+// HNSWIndex is an approximate nearest neighbour index based on
+// Hierarchical Navigable Small World graphs (Malkov & Yashunin, 2016).
+type HNSWIndex struct {
+	mu        sync.RWMutex
+	dimension int
+	metric    Metric
+	config    HNSWConfig
+	nodes     []*hnswNode
+	ids       map[string]int32 // Live record ID -> node index
+	entry     int32            // Entry point node index, -1 when empty
+	maxLevel  int
+	deleted   int
+	levelMult float64
+	rng       *rand.Rand
+	path      string
+}
+
+// NewHNSWIndex creates an empty HNSW index. Zero config values use defaults.
+func NewHNSWIndex(dimension int, metric Metric, config HNSWConfig) *HNSWIndex {
+	defaults := DefaultHNSWConfig()
+	if config.M <= 1 {
+		config.M = defaults.M
+	}
+	if config.EfConstruction <= 0 {
+		config.EfConstruction = defaults.EfConstruction
+	}
+	if config.EfSearch <= 0 {
+		config.EfSearch = defaults.EfSearch
+	}
+	if metric == "" {
+		metric = Cosine
+	}
+
+	h := &HNSWIndex{
+		dimension: dimension,
+		metric:    metric,
+		config:    config,
+	}
+	h.reset()
+	return h
+}
+
+// reset clears the graph. Callers must hold the write lock.
+func (h *HNSWIndex) reset() {
+	h.nodes = nil
+	h.ids = make(map[string]int32)
+	h.entry = -1
+	h.maxLevel = 0
+	h.deleted = 0
+	h.levelMult = 1 / math.Log(float64(h.config.M))
+	h.rng = rand.New(rand.NewSource(h.config.Seed))
+}
+
+// Upsert inserts records; an existing ID is replaced by a fresh node.
+func (h *HNSWIndex) Upsert(ctx context.Context, records ...Record) error {
+	for _, rec := range records {
+		if err := ValidateRecord(rec, h.dimension); err != nil {
+			return err
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, rec := range records {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if old, ok := h.ids[rec.ID]; ok {
+			h.nodes[old].Deleted = true
+			h.deleted++
+		}
+		h.insert(Record{
+			ID:       rec.ID,
+			Vector:   append([]float32(nil), rec.Vector...),
+			Metadata: copyMetadata(rec.Metadata),
+		})
+	}
+
+	h.maybeCompact()
+	return nil
+}
+
+// Delete marks records as deleted.
+func (h *HNSWIndex) Delete(ctx context.Context, ids ...string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, id := range ids {
+		idx, ok := h.ids[id]
+		if !ok {
+			continue
+		}
+		h.nodes[idx].Deleted = true
+		h.deleted++
+		delete(h.ids, id)
+	}
+
+	h.maybeCompact()
+	return nil
+}
+
+// Query returns the approximate TopK closest live records matching the filter.
+// When a selective filter leaves too few candidates, the search widens
+// until it has covered the whole graph.
+func (h *HNSWIndex) Query(ctx context.Context, q Query) ([]Match, error) {
+	if err := ValidateQuery(q, h.dimension); err != nil {
+		return nil, err
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	if h.entry < 0 {
+		return []Match{}, nil
+	}
+
+	ef := h.config.EfSearch
+	if ef < q.TopK {
+		ef = q.TopK
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		candidates := h.search(q.Vector, ef)
+		results := newTopK(q.TopK)
+		for _, c := range candidates {
+			node := h.nodes[c.index]
+			if node.Deleted || !q.Filter.Matches(node.Metadata) {
+				continue
+			}
+			results.push(Match{
+				ID:       node.ID,
+				Distance: c.distance,
+				Metadata: node.Metadata,
+			})
+		}
+
+		matches := results.sorted()
+		if len(matches) >= q.TopK || ef >= len(h.nodes) {
+			return matches, nil
+		}
+		ef *= 4
+	}
+}
+
+// Count returns the number of live records.
+func (h *HNSWIndex) Count(ctx context.Context) (int, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.ids), nil
+}
+
+// Dimension returns the configured vector dimension.
+func (h *HNSWIndex) Dimension() int {
+	return h.dimension
+}
+
+// Metric returns the configured distance metric.
+func (h *HNSWIndex) Metric() Metric {
+	return h.metric
+}
+
+// Close saves a snapshot if the index was opened with a Path.
+func (h *HNSWIndex) Close() error {
+	if h.path == "" {
+		return nil
+	}
+	return SaveFile(h.path, h)
+}
+
+// Compact rebuilds the graph without deleted nodes.
+func (h *HNSWIndex) Compact() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.compact()
+}
+
+// maybeCompact rebuilds the graph once tombstones outnumber live nodes.
+// Callers must hold the write lock.
+func (h *HNSWIndex) maybeCompact() {
+	if h.deleted > 64 && h.deleted > len(h.ids) {
+		h.compact()
+	}
+}
+
+// compact reinserts all live nodes into a fresh graph. Callers must hold the write lock.
+func (h *HNSWIndex) compact() {
+	live := make([]Record, 0, len(h.ids))
+	for _, node := range h.nodes {
+		if !node.Deleted {
+			live = append(live, Record{ID: node.ID, Vector: node.Vector, Metadata: node.Metadata})
+		}
+	}
+
+	h.reset()
+	for _, rec := range live {
+		h.insert(rec)
+	}
+}
+
+// insert adds a node to the graph. Callers must hold the write lock.
+func (h *HNSWIndex) insert(rec Record) {
+	level := int(math.Floor(-math.Log(1-h.rng.Float64()) * h.levelMult))
+	idx := int32(len(h.nodes))
+	node := &hnswNode{
+		ID:        rec.ID,
+		Vector:    rec.Vector,
+		Metadata:  rec.Metadata,
+		Level:     level,
+		Neighbors: make([][]int32, level+1),
+	}
+	h.nodes = append(h.nodes, node)
+	h.ids[rec.ID] = idx
+
+	if h.entry < 0 {
+		h.entry = idx
+		h.maxLevel = level
+		return
+	}
+
+	// Greedy descent through the layers above the new node's level
+	ep := h.entry
+	for l := h.maxLevel; l > level; l-- {
+		ep = h.greedyClosest(rec.Vector, ep, l)
+	}
+
+	// Connect the node on each of its layers
+	for l := min(level, h.maxLevel); l >= 0; l-- {
+		candidates := h.searchLayer(rec.Vector, []int32{ep}, h.config.EfConstruction, l)
+		neighbors := h.selectNeighbors(candidates, h.maxNeighbors(l))
+
+		node.Neighbors[l] = neighbors
+		for _, n := range neighbors {
+			h.link(n, idx, l)
+		}
+		ep = candidates[0].index
+	}
+
+	if level > h.maxLevel {
+		h.entry = idx
+		h.maxLevel = level
+	}
+}
+
+// link adds a directed edge from -> to on a layer, pruning to the layer's capacity.
+func (h *HNSWIndex) link(from, to int32, level int) {
+	node := h.nodes[from]
+	node.Neighbors[level] = append(node.Neighbors[level], to)
+
+	limit := h.maxNeighbors(level)
+	if len(node.Neighbors[level]) <= limit {
+		return
+	}
+
+	candidates := make([]candidate, len(node.Neighbors[level]))
+	for i, n := range node.Neighbors[level] {
+		candidates[i] = candidate{index: n, distance: h.metric.Distance(node.Vector, h.nodes[n].Vector)}
+	}
+	sortCandidates(candidates)
+	node.Neighbors[level] = h.selectNeighbors(candidates, limit)
+}
+
+// maxNeighbors returns the edge capacity of a layer.
+func (h *HNSWIndex) maxNeighbors(level int) int {
+	if level == 0 {
+		return 2 * h.config.M
+	}
+	return h.config.M
+}
+
+// selectNeighbors picks up to m neighbours from candidates sorted by distance,
+// preferring candidates that are not already covered by a closer selected
+// neighbour (the HNSW heuristic), then filling remaining slots by distance.
+func (h *HNSWIndex) selectNeighbors(candidates []candidate, m int) []int32 {
+	selected := make([]int32, 0, m)
+	skipped := make([]int32, 0)
+
+	for _, c := range candidates {
+		if len(selected) >= m {
+			break
+		}
+		keep := true
+		for _, s := range selected {
+			if h.metric.Distance(h.nodes[c.index].Vector, h.nodes[s].Vector) < c.distance {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			selected = append(selected, c.index)
+		} else {
+			skipped = append(skipped, c.index)
+		}
+	}
+
+	for _, s := range skipped {
+		if len(selected) >= m {
+			break
+		}
+		selected = append(selected, s)
+	}
+	return selected
+}
+
+// search runs a full top-down search and returns layer-0 candidates sorted by distance.
+func (h *HNSWIndex) search(vector []float32, ef int) []candidate {
+	ep := h.entry
+	for l := h.maxLevel; l > 0; l-- {
+		ep = h.greedyClosest(vector, ep, l)
+	}
+	return h.searchLayer(vector, []int32{ep}, ef, 0)
+}
+
+// greedyClosest walks a layer towards the node closest to vector.
+func (h *HNSWIndex) greedyClosest(vector []float32, ep int32, level int) int32 {
+	best := ep
+	bestDist := h.metric.Distance(vector, h.nodes[ep].Vector)
+
+	for changed := true; changed; {
+		changed = false
+		for _, n := range h.nodes[best].Neighbors[level] {
+			if d := h.metric.Distance(vector, h.nodes[n].Vector); d < bestDist {
+				best, bestDist = n, d
+				changed = true
+			}
+		}
+	}
+	return best
+}
+
+// searchLayer performs a best-first search on one layer and returns up to ef
+// candidates sorted by ascending distance.
+func (h *HNSWIndex) searchLayer(vector []float32, entryPoints []int32, ef int, level int) []candidate {
+	visited := make(map[int32]struct{}, ef*4)
+	frontier := &candidateHeap{}         // min-heap: closest unexplored first
+	results := &candidateHeap{max: true} // max-heap: furthest retained first
+
+	for _, ep := range entryPoints {
+		c := candidate{index: ep, distance: h.metric.Distance(vector, h.nodes[ep].Vector)}
+		visited[ep] = struct{}{}
+		heap.Push(frontier, c)
+		heap.Push(results, c)
+	}
+
+	for frontier.Len() > 0 {
+		current := heap.Pop(frontier).(candidate)
+		if results.Len() >= ef && current.distance > results.items[0].distance {
+			break
+		}
+
+		for _, n := range h.nodes[current.index].Neighbors[level] {
+			if _, seen := visited[n]; seen {
+				continue
+			}
+			visited[n] = struct{}{}
+
+			d := h.metric.Distance(vector, h.nodes[n].Vector)
+			if results.Len() < ef || d < results.items[0].distance {
+				c := candidate{index: n, distance: d}
+				heap.Push(frontier, c)
+				heap.Push(results, c)
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+
+	out := append([]candidate(nil), results.items...)
+	sortCandidates(out)
+	return out
+}
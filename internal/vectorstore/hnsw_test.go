@@ -0,0 +1,109 @@
+package vectorstore
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+// randomRecords returns n records with reproducible random vectors.
+func randomRecords(rng *rand.Rand, n, dim int) []Record {
+	records := make([]Record, n)
+	for i := range records {
+		records[i] = Record{
+			ID:       fmt.Sprintf("rec-%d", i),
+			Vector:   randomVector(rng, dim),
+			Metadata: map[string]string{"parity": fmt.Sprint(i % 2)},
+		}
+	}
+	return records
+}
+
+func randomVector(rng *rand.Rand, dim int) []float32 {
+	v := make([]float32, dim)
+	for i := range v {
+		v[i] = rng.Float32()*2 - 1
+	}
+	return v
+}
+
+func TestHNSWRecallAgainstFlat(t *testing.T) {
+	const (
+		dim     = 32
+		n       = 2000
+		queries = 50
+		topK    = 10
+	)
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(1))
+	records := randomRecords(rng, n, dim)
+
+	for _, metric := range []Metric{Cosine, Dot, L2} {
+		t.Run(string(metric), func(t *testing.T) {
+			flat := NewFlatIndex(dim, metric)
+			hnsw := NewHNSWIndex(dim, metric, DefaultHNSWConfig())
+			if err := flat.Upsert(ctx, records...); err != nil {
+				t.Fatal(err)
+			}
+			if err := hnsw.Upsert(ctx, records...); err != nil {
+				t.Fatal(err)
+			}
+
+			found, total := 0, 0
+			for i := 0; i < queries; i++ {
+				q := Query{Vector: randomVector(rng, dim), TopK: topK}
+				exact, err := flat.Query(ctx, q)
+				if err != nil {
+					t.Fatal(err)
+				}
+				approx, err := hnsw.Query(ctx, q)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(approx) != topK {
+					t.Fatalf("got %d matches, want %d", len(approx), topK)
+				}
+
+				want := make(map[string]bool, len(exact))
+				for _, m := range exact {
+					want[m.ID] = true
+				}
+				for _, m := range approx {
+					if want[m.ID] {
+						found++
+					}
+				}
+				total += len(exact)
+			}
+
+			if recall := float64(found) / float64(total); recall < 0.9 {
+				t.Errorf("recall@%d = %.3f, want >= 0.9", topK, recall)
+			}
+		})
+	}
+}
+
+func TestHNSWFilteredQueryReturnsTopK(t *testing.T) {
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(2))
+	hnsw := NewHNSWIndex(16, Cosine, DefaultHNSWConfig())
+	if err := hnsw.Upsert(ctx, randomRecords(rng, 500, 16)...); err != nil {
+		t.Fatal(err)
+	}
+	// Tag only a handful of records so the filter is very selective
+	for _, id := range []string{"rec-3", "rec-77", "rec-250", "rec-499"} {
+		rec := hnsw.nodes[hnsw.ids[id]]
+		if err := hnsw.Upsert(ctx, Record{ID: id, Vector: rec.Vector, Metadata: map[string]string{"tag": "rare"}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	matches, err := hnsw.Query(ctx, Query{Vector: randomVector(rng, 16), TopK: 4, Filter: Filter{"tag": "rare"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 4 {
+		t.Fatalf("got %d matches, want 4", len(matches))
+	}
+}
@@ -0,0 +1,75 @@
+package vectorstore
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// Metric is the distance function used to compare vectors.
+// Every metric is expressed as a distance: lower values mean more similar.
+type Metric string
+
+const (
+	// Cosine distance: 1 - cosine similarity (range 0..2)
+	Cosine Metric = "cosine"
+	// Dot is the negated inner product; use with normalized embeddings
+	Dot Metric = "dot"
+	// L2 is the Euclidean distance
+	L2 Metric = "l2"
+)
+
+// ParseMetric converts a string such as "cosine", "dot" or "l2" to a Metric.
+func ParseMetric(s string) (Metric, error) {
+	switch Metric(strings.ToLower(strings.TrimSpace(s))) {
+	case Cosine:
+		return Cosine, nil
+	case Dot, "inner_product", "ip":
+		return Dot, nil
+	case L2, "euclidean":
+		return L2, nil
+	default:
+		return "", fmt.Errorf("unknown distance metric %q (expected cosine, dot or l2)", s)
+	}
+}
+
+// Distance computes the distance between a and b under the metric.
+// Both vectors must have the same length.
+func (m Metric) Distance(a, b []float32) float32 {
+	switch m {
+	case Dot:
+		return -dot(a, b)
+	case L2:
+		return float32(math.Sqrt(float64(squaredL2(a, b))))
+	default:
+		na, nb := norm(a), norm(b)
+		if na == 0 || nb == 0 {
+			return 1
+		}
+		return 1 - dot(a, b)/(na*nb)
+	}
+}
+
+// dot returns the inner product of a and b.
+func dot(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+// squaredL2 returns the squared Euclidean distance between a and b.
+func squaredL2(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		d := a[i] - b[i]
+		sum += d * d
+	}
+	return sum
+}
+
+// norm returns the Euclidean length of v.
+func norm(v []float32) float32 {
+	return float32(math.Sqrt(float64(dot(v, v))))
+}
@@ -0,0 +1,231 @@
+package vectorstore
+
+import (
+	"bufio"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+)
+
+// snapshotVersion is bumped whenever the snapshot layout changes incompatibly.
+const snapshotVersion = 1
+
+// snapshot is the on-disk (gob) representation of an in-process index.
+type snapshot struct {
+	Version   int
+	Backend   string
+	Dimension int
+	Metric    Metric
+
+	// Flat index
+	Records []Record
+
+	// HNSW index
+	Config   HNSWConfig
+	Nodes    []hnswNode
+	Entry    int32
+	MaxLevel int
+}
+
+// Snapshot writes all records to w.
+func (f *FlatIndex) Snapshot(w io.Writer) error {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	snap := snapshot{
+		Version:   snapshotVersion,
+		Backend:   "flat",
+		Dimension: f.dimension,
+		Metric:    f.metric,
+		Records:   make([]Record, 0, len(f.records)),
+	}
+	for _, rec := range f.records {
+		snap.Records = append(snap.Records, rec)
+	}
+
+	return encodeSnapshot(w, &snap)
+}
+
+// Load replaces the index contents with a snapshot.
+func (f *FlatIndex) Load(r io.Reader) error {
+	snap, err := decodeSnapshot(r, "flat", f.dimension, f.metric)
+	if err != nil {
+		return err
+	}
+
+	records := make(map[string]Record, len(snap.Records))
+	for _, rec := range snap.Records {
+		records[rec.ID] = rec
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.records = records
+	return nil
+}
+
+// Snapshot writes the full graph to w so it can be restored without rebuilding.
+func (h *HNSWIndex) Snapshot(w io.Writer) error {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	snap := snapshot{
+		Version:   snapshotVersion,
+		Backend:   "hnsw",
+		Dimension: h.dimension,
+		Metric:    h.metric,
+		Config:    h.config,
+		Nodes:     make([]hnswNode, len(h.nodes)),
+		Entry:     h.entry,
+		MaxLevel:  h.maxLevel,
+	}
+	for i, node := range h.nodes {
+		snap.Nodes[i] = *node
+	}
+
+	return encodeSnapshot(w, &snap)
+}
+
+// Load replaces the graph with a snapshot.
+func (h *HNSWIndex) Load(r io.Reader) error {
+	snap, err := decodeSnapshot(r, "hnsw", h.dimension, h.metric)
+	if err != nil {
+		return err
+	}
+	if err := validateGraph(snap); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.config = snap.Config
+	h.reset()
+	h.nodes = make([]*hnswNode, len(snap.Nodes))
+	for i := range snap.Nodes {
+		node := snap.Nodes[i]
+		h.nodes[i] = &node
+		if node.Deleted {
+			h.deleted++
+		} else {
+			h.ids[node.ID] = int32(i)
+		}
+	}
+	h.entry = snap.Entry
+	h.maxLevel = snap.MaxLevel
+	// Advance the level generator so new inserts don't replay the original sequence
+	h.rng = rand.New(rand.NewSource(h.config.Seed + int64(len(h.nodes))))
+	return nil
+}
+
+// validateGraph checks that the graph in a snapshot only refers to nodes it
+// contains, so a corrupt snapshot fails to load instead of panicking in Search.
+func validateGraph(snap *snapshot) error {
+	n := int32(len(snap.Nodes))
+	if snap.Entry < -1 || snap.Entry >= n || (snap.Entry == -1) != (n == 0) {
+		return fmt.Errorf("corrupt snapshot: entry point %d with %d nodes", snap.Entry, n)
+	}
+	if snap.Entry >= 0 && snap.Nodes[snap.Entry].Level != snap.MaxLevel {
+		return fmt.Errorf("corrupt snapshot: max level %d does not match the entry point", snap.MaxLevel)
+	}
+	for i, node := range snap.Nodes {
+		if len(node.Vector) != snap.Dimension {
+			return fmt.Errorf("corrupt snapshot: node %d has %d dimensions", i, len(node.Vector))
+		}
+		if len(node.Neighbors) != node.Level+1 {
+			return fmt.Errorf("corrupt snapshot: node %d has %d neighbour lists for level %d", i, len(node.Neighbors), node.Level)
+		}
+		for _, neighbors := range node.Neighbors {
+			for _, nb := range neighbors {
+				if nb < 0 || nb >= n {
+					return fmt.Errorf("corrupt snapshot: node %d links to node %d of %d", i, nb, n)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// SaveFile atomically writes an index snapshot to path.
+// The snapshot is written to a temporary file first and renamed into place,
+// so a crash never leaves a truncated snapshot behind.
+func SaveFile(path string, idx Index) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create snapshot file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	buf := bufio.NewWriter(tmp)
+	if err := idx.Snapshot(buf); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := buf.Flush(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close snapshot file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace snapshot file: %w", err)
+	}
+	return nil
+}
+
+// LoadFile loads an index snapshot from path.
+// The returned error wraps os.ErrNotExist when the file does not exist.
+func LoadFile(path string, idx Index) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer file.Close()
+
+	if err := idx.Load(bufio.NewReader(file)); err != nil {
+		return fmt.Errorf("failed to load snapshot %s: %w", path, err)
+	}
+	return nil
+}
+
+// encodeSnapshot gob-encodes a snapshot.
+func encodeSnapshot(w io.Writer, snap *snapshot) error {
+	if err := gob.NewEncoder(w).Encode(snap); err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	return nil
+}
+
+// decodeSnapshot reads a snapshot and checks it is compatible with the index.
+// The metric must match: distances stored in an HNSW graph, and the results
+// callers expect, depend on it.
+func decodeSnapshot(r io.Reader, backend string, dimension int, metric Metric) (*snapshot, error) {
+	var snap snapshot
+	if err := gob.NewDecoder(r).Decode(&snap); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot: %w", err)
+	}
+
+	if snap.Version != snapshotVersion {
+		return nil, fmt.Errorf("unsupported snapshot version %d (expected %d)", snap.Version, snapshotVersion)
+	}
+	if snap.Backend != backend {
+		return nil, fmt.Errorf("snapshot is for a %s index, not %s", snap.Backend, backend)
+	}
+	if snap.Dimension != dimension {
+		return nil, fmt.Errorf("snapshot has %d dimensions, index expects %d", snap.Dimension, dimension)
+	}
+	if snap.Metric != metric {
+		return nil, fmt.Errorf("snapshot uses the %s metric, index expects %s", snap.Metric, metric)
+	}
+	return &snap, nil
+}
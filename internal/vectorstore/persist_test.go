@@ -0,0 +1,152 @@
+package vectorstore
+
+import (
+	"bytes"
+	"context"
+	"math/rand"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	const dim = 16
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(3))
+	records := randomRecords(rng, 300, dim)
+
+	indexes := map[string]func() Index{
+		"flat": func() Index { return NewFlatIndex(dim, Cosine) },
+		"hnsw": func() Index { return NewHNSWIndex(dim, Cosine, DefaultHNSWConfig()) },
+	}
+	for name, newIndex := range indexes {
+		t.Run(name, func(t *testing.T) {
+			src := newIndex()
+			if err := src.Upsert(ctx, records...); err != nil {
+				t.Fatal(err)
+			}
+			if err := src.Delete(ctx, "rec-0", "rec-1"); err != nil {
+				t.Fatal(err)
+			}
+
+			path := filepath.Join(t.TempDir(), "index.snap")
+			if err := SaveFile(path, src); err != nil {
+				t.Fatal(err)
+			}
+			dst := newIndex()
+			if err := LoadFile(path, dst); err != nil {
+				t.Fatal(err)
+			}
+
+			wantCount, _ := src.Count(ctx)
+			gotCount, _ := dst.Count(ctx)
+			if gotCount != wantCount {
+				t.Fatalf("loaded %d records, want %d", gotCount, wantCount)
+			}
+
+			for i := 0; i < 20; i++ {
+				q := Query{Vector: randomVector(rng, dim), TopK: 5, Filter: Filter{"parity": "1"}}
+				want, err := src.Query(ctx, q)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got, err := dst.Query(ctx, q)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("query %d after load:\n got %v\nwant %v", i, got, want)
+				}
+			}
+
+			// Inserts after loading keep working
+			if err := dst.Upsert(ctx, Record{ID: "new", Vector: randomVector(rng, dim)}); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestLoadRejectsIncompatibleSnapshot(t *testing.T) {
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(4))
+	src := NewHNSWIndex(8, Cosine, DefaultHNSWConfig())
+	if err := src.Upsert(ctx, randomRecords(rng, 10, 8)...); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := src.Snapshot(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		index Index
+		want  string
+	}{
+		{"metric", NewHNSWIndex(8, L2, DefaultHNSWConfig()), "metric"},
+		{"dimension", NewHNSWIndex(4, Cosine, DefaultHNSWConfig()), "dimensions"},
+		{"backend", NewFlatIndex(8, Cosine), "hnsw index"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.index.Load(bytes.NewReader(buf.Bytes()))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Load() error = %v, want it to mention %q", err, tt.want)
+			}
+			if tt.index.Metric() == Cosine && tt.name == "metric" {
+				t.Fatal("failed Load changed the index metric")
+			}
+		})
+	}
+}
+
+func TestLoadRejectsCorruptGraph(t *testing.T) {
+	ctx := context.Background()
+	rng := rand.New(rand.NewSource(5))
+	src := NewHNSWIndex(8, Cosine, DefaultHNSWConfig())
+	if err := src.Upsert(ctx, randomRecords(rng, 20, 8)...); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		corrupt func(snap *snapshot)
+	}{
+		{"neighbour past the end", func(snap *snapshot) { snap.Nodes[3].Neighbors[0] = append(snap.Nodes[3].Neighbors[0], 20) }},
+		{"negative neighbour", func(snap *snapshot) { snap.Nodes[0].Neighbors[0] = append(snap.Nodes[0].Neighbors[0], -2) }},
+		{"missing level", func(snap *snapshot) { snap.Nodes[5].Neighbors = nil }},
+		{"entry past the end", func(snap *snapshot) { snap.Entry = 20 }},
+		{"no entry", func(snap *snapshot) { snap.Entry = -1 }},
+		{"max level", func(snap *snapshot) { snap.MaxLevel++ }},
+		{"short vector", func(snap *snapshot) { snap.Nodes[7].Vector = snap.Nodes[7].Vector[:4] }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Round-trip through gob to get a deep copy of the graph to corrupt
+			var buf bytes.Buffer
+			if err := src.Snapshot(&buf); err != nil {
+				t.Fatal(err)
+			}
+			snap, err := decodeSnapshot(&buf, "hnsw", 8, Cosine)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.corrupt(snap)
+			buf.Reset()
+			if err := encodeSnapshot(&buf, snap); err != nil {
+				t.Fatal(err)
+			}
+
+			dst := NewHNSWIndex(8, Cosine, DefaultHNSWConfig())
+			err = dst.Load(&buf)
+			if err == nil || !strings.Contains(err.Error(), "corrupt snapshot") {
+				t.Fatalf("Load() error = %v, want a corrupt snapshot error", err)
+			}
+			if n, _ := dst.Count(ctx); n != 0 {
+				t.Errorf("failed Load left %d records in the index", n)
+			}
+		})
+	}
+}
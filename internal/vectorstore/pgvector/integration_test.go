@@ -0,0 +1,102 @@
+package pgvector
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/vectorstore"
+)
+
+// openTestStore opens a store on a fresh table in the database at
+// VECTOR_STORE_PG_TEST_DSN (with the pgvector extension available), or skips
+// the test when it is unset.
+func openTestStore(t *testing.T, cfg Config) *Store {
+	t.Helper()
+	dsn := os.Getenv("VECTOR_STORE_PG_TEST_DSN")
+	if dsn == "" {
+		t.Skip("VECTOR_STORE_PG_TEST_DSN not set")
+	}
+
+	ctx := context.Background()
+	cfg.Table = fmt.Sprintf("vectorstore_test_%d", time.Now().UnixNano())
+	store, err := Open(ctx, dsn, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		store.db.Exec("DROP TABLE IF EXISTS " + cfg.Table)
+		store.Close()
+	})
+	return store
+}
+
+func TestIntegrationQuery(t *testing.T) {
+	ctx := context.Background()
+	store := openTestStore(t, Config{Dimension: 3, Metric: vectorstore.Cosine})
+
+	err := store.Upsert(ctx,
+		vectorstore.Record{ID: "x", Vector: []float32{1, 0, 0}, Metadata: map[string]string{"lang": "en"}},
+		vectorstore.Record{ID: "y", Vector: []float32{0, 1, 0}, Metadata: map[string]string{"lang": "de"}},
+		vectorstore.Record{ID: "xy", Vector: []float32{1, 1, 0}, Metadata: map[string]string{"lang": "en"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := store.Count(ctx); err != nil || n != 3 {
+		t.Fatalf("Count() = %d, %v", n, err)
+	}
+
+	matches, err := store.Query(ctx, vectorstore.Query{Vector: []float32{1, 0.1, 0}, TopK: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 2 || matches[0].ID != "x" || matches[1].ID != "xy" {
+		t.Errorf("Query() = %+v", matches)
+	}
+
+	// A selective filter still returns every matching row
+	matches, err = store.Query(ctx, vectorstore.Query{Vector: []float32{1, 0, 0}, TopK: 5, Filter: map[string]string{"lang": "de"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 1 || matches[0].ID != "y" || matches[0].Metadata["lang"] != "de" {
+		t.Errorf("filtered Query() = %+v", matches)
+	}
+
+	if err := store.Delete(ctx, "x"); err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := store.Count(ctx); n != 2 {
+		t.Errorf("Count() after Delete = %d", n)
+	}
+}
+
+func TestIntegrationMigrateMetricChange(t *testing.T) {
+	ctx := context.Background()
+	store := openTestStore(t, Config{Dimension: 3, Metric: vectorstore.Cosine})
+
+	// Migrating the same column for another metric adds that metric's index
+	spec := ColumnSpec{Table: store.cfg.Table, Column: "embedding", Dimension: 3, Metric: vectorstore.L2}
+	if err := MigrateColumn(ctx, store.db, spec); err != nil {
+		t.Fatal(err)
+	}
+	var indexes []string
+	if err := store.db.Raw("SELECT indexname FROM pg_indexes WHERE tablename = ?", store.cfg.Table).Scan(&indexes).Error; err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{store.cfg.Table + "_embedding_hnsw_cosine_idx", store.cfg.Table + "_embedding_hnsw_l2_idx"} {
+		if !slices.Contains(indexes, want) {
+			t.Errorf("index %s missing from %v", want, indexes)
+		}
+	}
+
+	// Changing the dimension is refused
+	spec.Dimension = 4
+	if err := MigrateColumn(ctx, store.db, spec); err == nil {
+		t.Error("expected an error changing the column dimension")
+	}
+}
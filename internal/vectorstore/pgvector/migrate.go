@@ -0,0 +1,153 @@
+package pgvector
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/vectorstore"
+)
+
+// ColumnSpec describes a vector column on a table.
+type ColumnSpec struct {
+	Table     string
+	Column    string
+	Dimension int
+	Metric    vectorstore.Metric
+	Index     IndexType
+	Lists     int // IVFFlat list count (default 100)
+}
+
+// MigrateColumn adds a vector(Dimension) column and its ANN index to an existing table.
+// Use it after db.AutoMigrate for GORM models that store embeddings:
+//
+//	db.AutoMigrate(&models.Document{})
+//	pgvector.MigrateColumn(ctx, db, pgvector.ColumnSpec{
+//	    Table: "documents", Column: "embedding", Dimension: 1536, Metric: vectorstore.Cosine,
+//	})
+//
+// Changing the dimension of an existing column is refused, because stored
+// vectors would have to be regenerated with the new embedding model.
+func MigrateColumn(ctx context.Context, db *gorm.DB, spec ColumnSpec) error {
+	if !identifierPattern.MatchString(spec.Table) || !identifierPattern.MatchString(spec.Column) {
+		return fmt.Errorf("invalid table/column name %s.%s", spec.Table, spec.Column)
+	}
+	if spec.Dimension <= 0 {
+		return fmt.Errorf("dimension must be positive")
+	}
+	if spec.Metric == "" {
+		spec.Metric = vectorstore.Cosine
+	}
+	if spec.Index == "" {
+		spec.Index = IndexHNSW
+	}
+	if spec.Lists <= 0 {
+		spec.Lists = 100
+	}
+
+	db = db.WithContext(ctx)
+
+	existing, err := columnDimension(db, spec.Table, spec.Column)
+	if err != nil {
+		return err
+	}
+	switch {
+	case existing == 0:
+		addColumn := fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s vector(%d)",
+			spec.Table, spec.Column, spec.Dimension)
+		if err := db.Exec(addColumn).Error; err != nil {
+			return fmt.Errorf("failed to add vector column %s.%s: %w", spec.Table, spec.Column, err)
+		}
+	case existing != spec.Dimension:
+		return fmt.Errorf("column %s.%s: %w (re-embed into a new column instead)",
+			spec.Table, spec.Column, &vectorstore.DimensionError{Got: spec.Dimension, Want: existing})
+	}
+
+	return createIndex(db, spec)
+}
+
+// columnDimension returns the declared dimension of a vector column, or 0 if it does not exist.
+func columnDimension(db *gorm.DB, table, column string) (int, error) {
+	var dims []int
+	err := db.Raw(`SELECT a.atttypmod FROM pg_attribute a
+JOIN pg_class c ON c.oid = a.attrelid
+WHERE c.relname = ? AND a.attname = ? AND NOT a.attisdropped AND pg_table_is_visible(c.oid)`,
+		table, column).Scan(&dims).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to inspect column %s.%s: %w", table, column, err)
+	}
+	if len(dims) == 0 {
+		return 0, nil
+	}
+	return dims[0], nil
+}
+
+// createIndex builds the ANN index for a vector column if it does not exist yet.
+func createIndex(db *gorm.DB, spec ColumnSpec) error {
+	if spec.Index == IndexNone {
+		return nil
+	}
+
+	name, stmt, err := indexStatement(spec)
+	if err != nil {
+		return err
+	}
+	if err := db.Exec(stmt).Error; err != nil {
+		return fmt.Errorf("failed to create vector index %s: %w", name, err)
+	}
+	return nil
+}
+
+// indexStatement returns the name and CREATE INDEX statement of the ANN index
+// for a column. The name includes the metric: an index only serves queries
+// with its own operator class, so a store opened with another metric gets an
+// index of its own instead of reusing the existing one.
+func indexStatement(spec ColumnSpec) (name, stmt string, err error) {
+	ops, err := operatorClass(spec.Metric)
+	if err != nil {
+		return "", "", err
+	}
+
+	name = fmt.Sprintf("%s_%s_%s_%s_idx", spec.Table, spec.Column, spec.Index, spec.Metric)
+	switch spec.Index {
+	case IndexHNSW:
+		stmt = fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING hnsw (%s %s)",
+			name, spec.Table, spec.Column, ops)
+	case IndexIVFFlat:
+		stmt = fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING ivfflat (%s %s) WITH (lists = %d)",
+			name, spec.Table, spec.Column, ops, spec.Lists)
+	default:
+		return "", "", fmt.Errorf("unknown pgvector index type %q", spec.Index)
+	}
+	return name, stmt, nil
+}
+
+// operator returns the pgvector distance operator for a metric.
+// All three return distances where lower is more similar, matching vectorstore.Metric.
+func operator(metric vectorstore.Metric) (string, error) {
+	switch metric {
+	case vectorstore.Cosine:
+		return "<=>", nil
+	case vectorstore.Dot:
+		return "<#>", nil // negative inner product
+	case vectorstore.L2:
+		return "<->", nil
+	default:
+		return "", fmt.Errorf("unsupported metric %q for pgvector", metric)
+	}
+}
+
+// operatorClass returns the index operator class for a metric.
+func operatorClass(metric vectorstore.Metric) (string, error) {
+	switch metric {
+	case vectorstore.Cosine:
+		return "vector_cosine_ops", nil
+	case vectorstore.Dot:
+		return "vector_ip_ops", nil
+	case vectorstore.L2:
+		return "vector_l2_ops", nil
+	default:
+		return "", fmt.Errorf("unsupported metric %q for pgvector", metric)
+	}
+}
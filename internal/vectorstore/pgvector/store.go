@@ -0,0 +1,457 @@
+// Package pgvector implements vectorstore.Store on PostgreSQL with the pgvector extension.
+//
+// Vectors live in a table with an id, a vector(N) column, a jsonb metadata column
+// and timestamps. Migrate creates the extension, table and ANN index, and
+// MigrateColumn adds an embedding column to tables owned by your own GORM models.
+package pgvector
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pgvector/pgvector-go"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/vectorstore"
+)
+
+// IndexType selects the ANN index pgvector builds on the vector column.
+type IndexType string
+
+const (
+	// IndexHNSW builds an HNSW index (better recall/latency, slower builds)
+	IndexHNSW IndexType = "hnsw"
+	// IndexIVFFlat builds an IVFFlat index (faster builds, needs data before creation)
+	IndexIVFFlat IndexType = "ivfflat"
+	// IndexNone performs exact sequential scans
+	IndexNone IndexType = "none"
+)
+
+// upsertBatchSize bounds the number of rows per INSERT statement.
+const upsertBatchSize = 500
+
+// filteredScanFactor widens the index scan for queries with a metadata filter.
+const filteredScanFactor = 4
+
+// maxEfSearch is the largest hnsw.ef_search pgvector accepts.
+const maxEfSearch = 1000
+
+// identifierPattern restricts table/column names interpolated into SQL.
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Config configures a pgvector-backed store.
+type Config struct {
+	Table     string // Table name (default "embeddings")
+	Dimension int
+	Metric    vectorstore.Metric
+	Index     IndexType // Default IndexHNSW
+	Lists     int       // IVFFlat list count (default 100)
+
+	// Query-time index scan width. Filtered queries scan filteredScanFactor
+	// times wider, since pgvector applies the filter after the index scan.
+	EfSearch int // hnsw.ef_search (default 100, raised to TopK)
+	Probes   int // ivfflat.probes (default 10, at most Lists)
+}
+
+// ConfigFromEnv extends the shared vector store config with pgvector settings:
+//
+//	VECTOR_STORE_TABLE      table name (default embeddings)
+//	VECTOR_STORE_PG_INDEX   hnsw (default), ivfflat or none
+//	VECTOR_STORE_PG_LISTS   IVFFlat list count (default 100)
+//	VECTOR_STORE_PG_EF_SEARCH  hnsw.ef_search for queries (default 100)
+//	VECTOR_STORE_PG_PROBES  ivfflat.probes for queries (default 10)
+func ConfigFromEnv(base vectorstore.Config) (Config, error) {
+	cfg := Config{
+		Table:     os.Getenv("VECTOR_STORE_TABLE"),
+		Dimension: base.Dimension,
+		Metric:    base.Metric,
+		Index:     IndexType(strings.ToLower(os.Getenv("VECTOR_STORE_PG_INDEX"))),
+	}
+
+	if v := os.Getenv("VECTOR_STORE_PG_LISTS"); v != "" {
+		lists, err := strconv.Atoi(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid VECTOR_STORE_PG_LISTS %q: %w", v, err)
+		}
+		cfg.Lists = lists
+	}
+	if v := os.Getenv("VECTOR_STORE_PG_EF_SEARCH"); v != "" {
+		ef, err := strconv.Atoi(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid VECTOR_STORE_PG_EF_SEARCH %q: %w", v, err)
+		}
+		cfg.EfSearch = ef
+	}
+	if v := os.Getenv("VECTOR_STORE_PG_PROBES"); v != "" {
+		probes, err := strconv.Atoi(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid VECTOR_STORE_PG_PROBES %q: %w", v, err)
+		}
+		cfg.Probes = probes
+	}
+
+	return cfg, nil
+}
+
+// withDefaults fills zero values and validates the config.
+func (c Config) withDefaults() (Config, error) {
+	if c.Table == "" {
+		c.Table = "embeddings"
+	}
+	if c.Metric == "" {
+		c.Metric = vectorstore.Cosine
+	}
+	if c.Index == "" {
+		c.Index = IndexHNSW
+	}
+	if c.Lists <= 0 {
+		c.Lists = 100
+	}
+	if c.EfSearch <= 0 {
+		c.EfSearch = 100
+	}
+	if c.Probes <= 0 {
+		c.Probes = 10
+	}
+
+	if !identifierPattern.MatchString(c.Table) {
+		return Config{}, fmt.Errorf("invalid table name %q", c.Table)
+	}
+	if c.Dimension <= 0 {
+		return Config{}, fmt.Errorf("dimension must be positive")
+	}
+	if _, err := operator(c.Metric); err != nil {
+		return Config{}, err
+	}
+	switch c.Index {
+	case IndexHNSW, IndexIVFFlat, IndexNone:
+	default:
+		return Config{}, fmt.Errorf("unknown pgvector index type %q", c.Index)
+	}
+	return c, nil
+}
+
+// Store is a vectorstore.Store backed by a PostgreSQL table.
+type Store struct {
+	db     *gorm.DB
+	cfg    Config
+	ownsDB bool // Close only closes connections opened by Open
+}
+
+var _ vectorstore.Store = (*Store)(nil)
+
+// New creates a store on an existing GORM connection (e.g. AsyncGlobalState.DB).
+// Call Migrate before first use to create the table and index.
+func New(db *gorm.DB, cfg Config) (*Store, error) {
+	cfg, err := cfg.withDefaults()
+	if err != nil {
+		return nil, err
+	}
+	return &Store{db: db, cfg: cfg}, nil
+}
+
+// Open connects to dsn, creates a store and migrates its table.
+// The connection is closed when the store is closed.
+func Open(ctx context.Context, dsn string, cfg Config) (*Store, error) {
+	if dsn == "" {
+		return nil, fmt.Errorf("DATABASE_URL environment variable not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	store, err := New(db, cfg)
+	if err != nil {
+		closeDB(db)
+		return nil, err
+	}
+	store.ownsDB = true
+
+	if err := store.Migrate(ctx); err != nil {
+		closeDB(db)
+		return nil, err
+	}
+	return store, nil
+}
+
+// Migrate creates the pgvector extension, the store table and its ANN index.
+// It is safe to call on every startup.
+func (s *Store) Migrate(ctx context.Context) error {
+	db := s.db.WithContext(ctx)
+
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS vector").Error; err != nil {
+		return fmt.Errorf("failed to create vector extension: %w", err)
+	}
+
+	createTable := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	id TEXT PRIMARY KEY,
+	metadata JSONB NOT NULL DEFAULT '{}'::jsonb,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+)`, s.cfg.Table)
+	if err := db.Exec(createTable).Error; err != nil {
+		return fmt.Errorf("failed to create table %s: %w", s.cfg.Table, err)
+	}
+
+	if err := MigrateColumn(ctx, s.db, ColumnSpec{
+		Table:     s.cfg.Table,
+		Column:    "embedding",
+		Dimension: s.cfg.Dimension,
+		Metric:    s.cfg.Metric,
+		Index:     s.cfg.Index,
+		Lists:     s.cfg.Lists,
+	}); err != nil {
+		return err
+	}
+
+	metadataIndex := fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s_metadata_idx ON %s USING gin (metadata jsonb_path_ops)",
+		s.cfg.Table, s.cfg.Table)
+	if err := db.Exec(metadataIndex).Error; err != nil {
+		return fmt.Errorf("failed to create metadata index: %w", err)
+	}
+
+	return nil
+}
+
+// Upsert inserts records or replaces existing rows with the same ID.
+func (s *Store) Upsert(ctx context.Context, records ...vectorstore.Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+	for _, rec := range records {
+		if err := vectorstore.ValidateRecord(rec, s.cfg.Dimension); err != nil {
+			return err
+		}
+	}
+
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(records); start += upsertBatchSize {
+			end := min(start+upsertBatchSize, len(records))
+			if err := s.upsertBatch(tx, records[start:end]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// upsertBatch writes one multi-row INSERT ... ON CONFLICT statement.
+func (s *Store) upsertBatch(tx *gorm.DB, records []vectorstore.Record) error {
+	placeholders := make([]string, 0, len(records))
+	args := make([]interface{}, 0, len(records)*3)
+
+	for _, rec := range records {
+		metadata, err := json.Marshal(nonNilMetadata(rec.Metadata))
+		if err != nil {
+			return fmt.Errorf("record %s: failed to encode metadata: %w", rec.ID, err)
+		}
+		placeholders = append(placeholders, "(?, ?, ?::jsonb)")
+		args = append(args, rec.ID, pgvector.NewVector(rec.Vector), string(metadata))
+	}
+
+	query := fmt.Sprintf(`INSERT INTO %s (id, embedding, metadata) VALUES %s
+ON CONFLICT (id) DO UPDATE SET embedding = EXCLUDED.embedding, metadata = EXCLUDED.metadata, updated_at = now()`,
+		s.cfg.Table, strings.Join(placeholders, ", "))
+
+	if err := tx.Exec(query, args...).Error; err != nil {
+		return fmt.Errorf("failed to upsert vectors: %w", err)
+	}
+	return nil
+}
+
+// Delete removes rows by ID.
+func (s *Store) Delete(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	query := fmt.Sprintf("DELETE FROM %s WHERE id IN ?", s.cfg.Table)
+	if err := s.db.WithContext(ctx).Exec(query, ids).Error; err != nil {
+		return fmt.Errorf("failed to delete vectors: %w", err)
+	}
+	return nil
+}
+
+// Query runs a filtered nearest-neighbour search using the configured metric's operator.
+//
+// With an ANN index, pgvector applies the metadata filter to the rows the index
+// scan returns, so a selective filter can leave fewer than TopK matches. Filtered
+// queries therefore scan the index wider (EfSearch/Probes times
+// filteredScanFactor), and when that still returns fewer than TopK rows, the
+// query is repeated as an exact scan. A short result then means fewer rows match.
+func (s *Store) Query(ctx context.Context, q vectorstore.Query) ([]vectorstore.Match, error) {
+	if err := vectorstore.ValidateQuery(q, s.cfg.Dimension); err != nil {
+		return nil, err
+	}
+
+	var rows []queryRow
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := s.setScanWidth(tx, q); err != nil {
+			return err
+		}
+		var err error
+		if rows, err = s.nearest(tx, q); err != nil {
+			return err
+		}
+
+		if len(q.Filter) > 0 && len(rows) < q.TopK && s.cfg.Index != IndexNone {
+			// The index scan ran out of matching rows; the GIN filter index still applies
+			if err := tx.Exec("SET LOCAL enable_indexscan = off").Error; err != nil {
+				return fmt.Errorf("failed to disable index scan: %w", err)
+			}
+			rows, err = s.nearest(tx, q)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]vectorstore.Match, 0, len(rows))
+	for _, row := range rows {
+		var metadata map[string]string
+		if len(row.Metadata) > 0 {
+			if err := json.Unmarshal(row.Metadata, &metadata); err != nil {
+				return nil, fmt.Errorf("record %s: failed to decode metadata: %w", row.ID, err)
+			}
+		}
+		if len(metadata) == 0 {
+			metadata = nil
+		}
+		matches = append(matches, vectorstore.Match{
+			ID:       row.ID,
+			Distance: float32(row.Distance),
+			Metadata: metadata,
+		})
+	}
+	return matches, nil
+}
+
+// queryRow is one row of a nearest-neighbour query.
+type queryRow struct {
+	ID       string
+	Metadata []byte
+	Distance float64
+}
+
+// nearest selects the TopK rows closest to the query vector that match the filter.
+func (s *Store) nearest(tx *gorm.DB, q vectorstore.Query) ([]queryRow, error) {
+	query, args, err := s.nearestQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []queryRow
+	if err := tx.Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to query vectors: %w", err)
+	}
+	return rows, nil
+}
+
+// nearestQuery builds the nearest-neighbour SELECT and its arguments.
+func (s *Store) nearestQuery(q vectorstore.Query) (string, []interface{}, error) {
+	op, _ := operator(s.cfg.Metric)
+
+	where := ""
+	args := []interface{}{pgvector.NewVector(q.Vector)}
+	if len(q.Filter) > 0 {
+		filter, err := json.Marshal(q.Filter)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to encode filter: %w", err)
+		}
+		where = "WHERE metadata @> ?::jsonb"
+		args = append(args, string(filter))
+	}
+	args = append(args, q.TopK)
+
+	query := fmt.Sprintf(`SELECT id, metadata, embedding %s ? AS distance FROM %s %s ORDER BY distance LIMIT ?`,
+		op, s.cfg.Table, where)
+	return query, args, nil
+}
+
+// setScanWidth sets how much of the ANN index the query scans, for the
+// current transaction only.
+func (s *Store) setScanWidth(tx *gorm.DB, q vectorstore.Query) error {
+	set := s.scanWidthStatement(q)
+	if set == "" {
+		return nil
+	}
+	if err := tx.Exec(set).Error; err != nil {
+		return fmt.Errorf("failed to set index scan width: %w", err)
+	}
+	return nil
+}
+
+// scanWidthStatement returns the SET LOCAL statement for the query's index
+// scan width, or "" when the store has no ANN index.
+func (s *Store) scanWidthStatement(q vectorstore.Query) string {
+	factor := 1
+	if len(q.Filter) > 0 {
+		factor = filteredScanFactor
+	}
+
+	switch s.cfg.Index {
+	case IndexHNSW:
+		// pgvector never returns more than ef_search rows from an HNSW scan
+		ef := min(max(s.cfg.EfSearch, q.TopK)*factor, maxEfSearch)
+		return fmt.Sprintf("SET LOCAL hnsw.ef_search = %d", ef)
+	case IndexIVFFlat:
+		return fmt.Sprintf("SET LOCAL ivfflat.probes = %d", min(s.cfg.Probes*factor, s.cfg.Lists))
+	default:
+		return ""
+	}
+}
+
+// Count returns the number of rows in the table.
+func (s *Store) Count(ctx context.Context) (int, error) {
+	var count int64
+	if err := s.db.WithContext(ctx).Table(s.cfg.Table).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count vectors: %w", err)
+	}
+	return int(count), nil
+}
+
+// Dimension returns the configured vector dimension.
+func (s *Store) Dimension() int {
+	return s.cfg.Dimension
+}
+
+// Metric returns the configured distance metric.
+func (s *Store) Metric() vectorstore.Metric {
+	return s.cfg.Metric
+}
+
+// Close closes the database connection if it was opened by Open.
+func (s *Store) Close() error {
+	if !s.ownsDB {
+		return nil
+	}
+	return closeDB(s.db)
+}
+
+// nonNilMetadata ensures metadata encodes as {} rather than null.
+func nonNilMetadata(m map[string]string) map[string]string {
+	if m == nil {
+		return map[string]string{}
+	}
+	return m
+}
+
+// closeDB closes the connection pool behind a GORM handle.
+func closeDB(db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get underlying SQL DB: %w", err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		return fmt.Errorf("failed to close database: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package pgvector
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/vectorstore"
+)
+
+func TestIndexStatement(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     ColumnSpec
+		wantName string
+		wantStmt string
+	}{
+		{
+			"hnsw cosine",
+			ColumnSpec{Table: "docs", Column: "embedding", Metric: vectorstore.Cosine, Index: IndexHNSW},
+			"docs_embedding_hnsw_cosine_idx",
+			"CREATE INDEX IF NOT EXISTS docs_embedding_hnsw_cosine_idx ON docs USING hnsw (embedding vector_cosine_ops)",
+		},
+		{
+			"hnsw dot",
+			ColumnSpec{Table: "docs", Column: "embedding", Metric: vectorstore.Dot, Index: IndexHNSW},
+			"docs_embedding_hnsw_dot_idx",
+			"CREATE INDEX IF NOT EXISTS docs_embedding_hnsw_dot_idx ON docs USING hnsw (embedding vector_ip_ops)",
+		},
+		{
+			"ivfflat l2",
+			ColumnSpec{Table: "docs", Column: "vec", Metric: vectorstore.L2, Index: IndexIVFFlat, Lists: 50},
+			"docs_vec_ivfflat_l2_idx",
+			"CREATE INDEX IF NOT EXISTS docs_vec_ivfflat_l2_idx ON docs USING ivfflat (vec vector_l2_ops) WITH (lists = 50)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, stmt, err := indexStatement(tt.spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if name != tt.wantName || stmt != tt.wantStmt {
+				t.Errorf("got %q\n%q\nwant %q\n%q", name, stmt, tt.wantName, tt.wantStmt)
+			}
+		})
+	}
+
+	// Each metric needs its own index: an index only serves its operator class
+	names := map[string]bool{}
+	for _, metric := range []vectorstore.Metric{vectorstore.Cosine, vectorstore.Dot, vectorstore.L2} {
+		name, _, err := indexStatement(ColumnSpec{Table: "t", Column: "c", Metric: metric, Index: IndexHNSW})
+		if err != nil {
+			t.Fatal(err)
+		}
+		names[name] = true
+	}
+	if len(names) != 3 {
+		t.Errorf("index names collide across metrics: %v", names)
+	}
+
+	if _, _, err := indexStatement(ColumnSpec{Table: "t", Column: "c", Metric: "manhattan", Index: IndexHNSW}); err == nil {
+		t.Error("expected an error for an unsupported metric")
+	}
+	if _, _, err := indexStatement(ColumnSpec{Table: "t", Column: "c", Metric: vectorstore.Cosine, Index: "btree"}); err == nil {
+		t.Error("expected an error for an unknown index type")
+	}
+}
+
+func TestConfigDefaults(t *testing.T) {
+	cfg, err := Config{Dimension: 8}.withDefaults()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Config{Table: "embeddings", Dimension: 8, Metric: vectorstore.Cosine, Index: IndexHNSW, Lists: 100, EfSearch: 100, Probes: 10}
+	if cfg != want {
+		t.Errorf("withDefaults() = %+v, want %+v", cfg, want)
+	}
+
+	for name, bad := range map[string]Config{
+		"table injection": {Table: "docs; DROP TABLE users", Dimension: 8},
+		"no dimension":    {},
+		"metric":          {Dimension: 8, Metric: "manhattan"},
+		"index":           {Dimension: 8, Index: "btree"},
+	} {
+		if _, err := bad.withDefaults(); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestScanWidthStatement(t *testing.T) {
+	vector := []float32{1, 0}
+	filter := map[string]string{"lang": "en"}
+
+	tests := []struct {
+		name string
+		cfg  Config
+		q    vectorstore.Query
+		want string
+	}{
+		{"hnsw", Config{Index: IndexHNSW, EfSearch: 100}, vectorstore.Query{Vector: vector, TopK: 10}, "SET LOCAL hnsw.ef_search = 100"},
+		{"hnsw raised to TopK", Config{Index: IndexHNSW, EfSearch: 100}, vectorstore.Query{Vector: vector, TopK: 300}, "SET LOCAL hnsw.ef_search = 300"},
+		{"hnsw filtered", Config{Index: IndexHNSW, EfSearch: 100}, vectorstore.Query{Vector: vector, TopK: 10, Filter: filter}, "SET LOCAL hnsw.ef_search = 400"},
+		{"hnsw capped", Config{Index: IndexHNSW, EfSearch: 400}, vectorstore.Query{Vector: vector, TopK: 10, Filter: filter}, "SET LOCAL hnsw.ef_search = 1000"},
+		{"ivfflat", Config{Index: IndexIVFFlat, Probes: 10, Lists: 100}, vectorstore.Query{Vector: vector, TopK: 10}, "SET LOCAL ivfflat.probes = 10"},
+		{"ivfflat filtered", Config{Index: IndexIVFFlat, Probes: 10, Lists: 100}, vectorstore.Query{Vector: vector, TopK: 10, Filter: filter}, "SET LOCAL ivfflat.probes = 40"},
+		{"ivfflat capped at lists", Config{Index: IndexIVFFlat, Probes: 10, Lists: 20}, vectorstore.Query{Vector: vector, TopK: 10, Filter: filter}, "SET LOCAL ivfflat.probes = 20"},
+		{"no index", Config{Index: IndexNone}, vectorstore.Query{Vector: vector, TopK: 10, Filter: filter}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Store{cfg: tt.cfg}
+			if got := s.scanWidthStatement(tt.q); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNearestQuery(t *testing.T) {
+	s := &Store{cfg: Config{Table: "docs", Metric: vectorstore.L2}}
+
+	query, args, err := s.nearestQuery(vectorstore.Query{Vector: []float32{1, 2}, TopK: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "SELECT id, metadata, embedding <-> ? AS distance FROM docs  ORDER BY distance LIMIT ?"; query != want {
+		t.Errorf("query = %q, want %q", query, want)
+	}
+	if len(args) != 2 || args[1] != 5 {
+		t.Errorf("args = %v", args)
+	}
+
+	query, args, err = s.nearestQuery(vectorstore.Query{Vector: []float32{1, 2}, TopK: 5, Filter: map[string]string{"lang": "en"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(query, "WHERE metadata @> ?::jsonb") {
+		t.Errorf("filtered query = %q", query)
+	}
+	if len(args) != 3 || args[1] != `{"lang":"en"}` {
+		t.Errorf("args = %v", args)
+	}
+}
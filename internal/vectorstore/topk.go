@@ -0,0 +1,58 @@
+package vectorstore
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// topK collects the k matches with the smallest distance.
+// It keeps a max-heap so the worst retained match can be evicted in O(log k).
+type topK struct {
+	k     int
+	items matchHeap
+}
+
+// newTopK creates a collector for k results.
+func newTopK(k int) *topK {
+	return &topK{k: k, items: make(matchHeap, 0, k)}
+}
+
+// push offers a match to the collector.
+func (t *topK) push(m Match) {
+	if len(t.items) < t.k {
+		heap.Push(&t.items, m)
+		return
+	}
+	if m.Distance < t.items[0].Distance {
+		t.items[0] = m
+		heap.Fix(&t.items, 0)
+	}
+}
+
+// sorted returns the collected matches ordered by ascending distance.
+func (t *topK) sorted() []Match {
+	out := make([]Match, len(t.items))
+	copy(out, t.items)
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Distance == out[j].Distance {
+			return out[i].ID < out[j].ID
+		}
+		return out[i].Distance < out[j].Distance
+	})
+	return out
+}
+
+// matchHeap is a max-heap of matches keyed on distance.
+type matchHeap []Match
+
+func (h matchHeap) Len() int            { return len(h) }
+func (h matchHeap) Less(i, j int) bool  { return h[i].Distance > h[j].Distance }
+func (h matchHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *matchHeap) Push(x interface{}) { *h = append(*h, x.(Match)) }
+func (h *matchHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
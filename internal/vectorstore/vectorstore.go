@@ -0,0 +1,228 @@
+// Package vectorstore provides similarity search over embedding vectors.
+//
+// It defines a backend-agnostic Store interface plus two in-process indexes:
+//   - FlatIndex: exact brute-force search, best for up to ~100k vectors
+//   - HNSWIndex: approximate search using a Hierarchical Navigable Small World graph
+//
+// In-process indexes can be snapshotted to disk and loaded back on startup.
+// Use them from tasks through AsyncGlobalState (see internal/state).
+package vectorstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/config"
+)
+
+// ErrEmptyVector is returned when a record or query has no vector.
+var ErrEmptyVector = errors.New("vector cannot be empty")
+
+// DimensionError is returned when a vector's length does not match the store's
+// configured dimension, usually because the embedding model or its requested
+// dimensions changed. Check for it with errors.As.
+type DimensionError struct {
+	ID   string // Record ID, empty for queries and configuration checks
+	Got  int
+	Want int
+}
+
+func (e *DimensionError) Error() string {
+	if e.ID != "" {
+		return fmt.Sprintf("record %s: vector has %d dimensions, store expects %d", e.ID, e.Got, e.Want)
+	}
+	return fmt.Sprintf("vector has %d dimensions, store expects %d", e.Got, e.Want)
+}
+
+// Record is a vector with an ID and optional metadata for filtering.
+type Record struct {
+	ID       string            `json:"id"`
+	Vector   []float32         `json:"vector"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Filter restricts a query to records whose metadata matches every key/value pair.
+// A nil or empty filter matches all records.
+type Filter map[string]string
+
+// Matches reports whether the metadata satisfies the filter.
+func (f Filter) Matches(metadata map[string]string) bool {
+	for k, v := range f {
+		if metadata[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// Query describes a top-k nearest neighbour search.
+type Query struct {
+	Vector []float32
+	TopK   int
+	Filter Filter
+}
+
+// Match is a single search result, ordered by ascending Distance.
+type Match struct {
+	ID       string            `json:"id"`
+	Distance float32           `json:"similarity_distance"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Store is implemented by every vector store backend.
+// Tasks should depend on this interface so backends can be swapped by configuration.
+type Store interface {
+	// Upsert inserts records or replaces existing records with the same ID.
+	Upsert(ctx context.Context, records ...Record) error
+	// Delete removes records by ID. Unknown IDs are ignored.
+	Delete(ctx context.Context, ids ...string) error
+	// Query returns the TopK records closest to the query vector.
+	Query(ctx context.Context, q Query) ([]Match, error)
+	// Count returns the number of stored records.
+	Count(ctx context.Context) (int, error)
+	// Dimension returns the vector dimension the store was configured with.
+	Dimension() int
+	// Metric returns the distance metric used for queries.
+	Metric() Metric
+	// Close releases resources held by the store.
+	Close() error
+}
+
+// Index is an in-process Store that can be snapshotted and restored.
+type Index interface {
+	Store
+	// Snapshot writes the full index state to w.
+	Snapshot(w io.Writer) error
+	// Load replaces the index state with a snapshot read from r.
+	Load(r io.Reader) error
+}
+
+// Config selects and configures an in-process index.
+type Config struct {
+	Backend   string // "flat" or "hnsw" ("pgvector" is opened by the pgvector package)
+	Dimension int
+	Metric    Metric
+	Path      string // Snapshot file; loaded on open and saved on Close when set
+	HNSW      HNSWConfig
+}
+
+// ConfigFromEnv reads index configuration from environment variables:
+//
+//	VECTOR_STORE_BACKEND   flat (default), hnsw, or pgvector (see the pgvector package)
+//	VECTOR_STORE_DIMENSION vector dimension (default 1536, text-embedding-3-small)
+//	VECTOR_STORE_METRIC    cosine (default), dot or l2
+//	VECTOR_STORE_PATH      snapshot file (optional, in-memory only when empty)
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Backend:   config.GetEnvOrDefault("VECTOR_STORE_BACKEND", "flat"),
+		Dimension: 1536,
+		Path:      os.Getenv("VECTOR_STORE_PATH"),
+		HNSW:      DefaultHNSWConfig(),
+	}
+
+	if v := os.Getenv("VECTOR_STORE_DIMENSION"); v != "" {
+		dim, err := strconv.Atoi(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid VECTOR_STORE_DIMENSION %q: %w", v, err)
+		}
+		cfg.Dimension = dim
+	}
+
+	metric, err := ParseMetric(config.GetEnvOrDefault("VECTOR_STORE_METRIC", string(Cosine)))
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Metric = metric
+
+	return cfg, nil
+}
+
+// New creates an in-process index from the config.
+// If cfg.Path points to an existing snapshot, it is loaded before returning.
+func New(cfg Config) (Index, error) {
+	if cfg.Dimension <= 0 {
+		return nil, fmt.Errorf("dimension must be positive")
+	}
+	if cfg.Metric == "" {
+		cfg.Metric = Cosine
+	}
+
+	var idx Index
+	switch strings.ToLower(cfg.Backend) {
+	case "", "flat":
+		flat := NewFlatIndex(cfg.Dimension, cfg.Metric)
+		flat.path = cfg.Path
+		idx = flat
+	case "hnsw":
+		hnsw := NewHNSWIndex(cfg.Dimension, cfg.Metric, cfg.HNSW)
+		hnsw.path = cfg.Path
+		idx = hnsw
+	case "pgvector":
+		return nil, fmt.Errorf("pgvector is not an in-process backend, use pgvector.Open")
+	default:
+		return nil, fmt.Errorf("unknown vector store backend %q", cfg.Backend)
+	}
+
+	if cfg.Path != "" {
+		if err := LoadFile(cfg.Path, idx); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	return idx, nil
+}
+
+// ValidateRecord checks that a record can be stored in a store of the given dimension.
+// Backends call it before writing so every Store rejects malformed records the same way.
+func ValidateRecord(rec Record, dim int) error {
+	if rec.ID == "" {
+		return fmt.Errorf("record ID cannot be empty")
+	}
+	if len(rec.Vector) == 0 {
+		return fmt.Errorf("record %s: %w", rec.ID, ErrEmptyVector)
+	}
+	if len(rec.Vector) != dim {
+		return &DimensionError{ID: rec.ID, Got: len(rec.Vector), Want: dim}
+	}
+	return nil
+}
+
+// ValidateQuery checks a query against the store dimension.
+func ValidateQuery(q Query, dim int) error {
+	if len(q.Vector) == 0 {
+		return fmt.Errorf("query: %w", ErrEmptyVector)
+	}
+	if len(q.Vector) != dim {
+		return fmt.Errorf("query: %w", &DimensionError{Got: len(q.Vector), Want: dim})
+	}
+	if q.TopK <= 0 {
+		return fmt.Errorf("topK must be positive")
+	}
+	return nil
+}
+
+// CheckDimension verifies at startup that vectors of length dim can be written to
+// the store, e.g. that the embeddings client and the store agree.
+func CheckDimension(s Store, dim int) error {
+	if dim != s.Dimension() {
+		return &DimensionError{Got: dim, Want: s.Dimension()}
+	}
+	return nil
+}
+
+// copyMetadata returns a copy so callers cannot mutate stored metadata.
+func copyMetadata(m map[string]string) map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,74 @@
+package workerfunctions
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	sdk "github.com/dibbla-agents/sdk-go"
+)
+
+// Definition describes a worker function together with its Go input and output
+// types, for tooling that needs the types without a running server (e.g. the
+// TypeScript client generator).
+type Definition struct {
+	Name        string
+	Version     string
+	Description string
+	Tags        []string
+	Input       any // Zero value of the input type
+	Output      any // Zero value of the output type
+	Function    sdk.FunctionBuilder
+}
+
+// Simple describes a function built with sdk.NewSimpleFunction.
+func Simple[In, Out any](fn *sdk.SimpleFunction[In, Out]) Definition {
+	var in In
+	var out Out
+	return describe(fn, in, out)
+}
+
+// Full describes a function built with sdk.NewFunction.
+func Full[In, Out any](fn *sdk.Function[In, Out]) Definition {
+	var in In
+	var out Out
+	return describe(fn, in, out)
+}
+
+// describe reads the function metadata from the SDK definition.
+func describe(fn sdk.FunctionBuilder, in, out any) Definition {
+	def := fn.Build(nil).GetFunctionDefinition()
+	return Definition{
+		Name:        def.Name,
+		Version:     def.Version,
+		Description: def.Description,
+		Tags:        def.Tags,
+		Input:       in,
+		Output:      out,
+		Function:    fn,
+	}
+}
+
+// ErrInvalidInput is returned by Invoke when the input doesn't decode into the
+// function's input type.
+var ErrInvalidInput = errors.New("invalid input")
+
+// Invoke runs the function in this process with a JSON input and returns its
+// JSON output, as the SDK server would for a platform call. The handler gets
+// no event message or global state.
+func (d Definition) Invoke(input []byte) ([]byte, error) {
+	if d.Function == nil {
+		return nil, fmt.Errorf("function %s has no builder", d.Name)
+	}
+	if d.Input != nil {
+		if err := json.Unmarshal(input, reflect.New(reflect.TypeOf(d.Input)).Interface()); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+	}
+	output, err := d.Function.Build(nil).Execute(&input, nil)
+	if err != nil {
+		return nil, err
+	}
+	return *output, nil
+}
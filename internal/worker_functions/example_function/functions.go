@@ -16,11 +16,13 @@
 package examplefunction
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	sdk "github.com/dibbla-agents/sdk-go"
 	"github.com/dibbla-agents/go-worker-starter-template/internal/state"
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
 )
 
 // Input defines what the function receives
@@ -64,14 +66,14 @@ func (f *ExampleFunction) GetTags() []string {
 }
 
 // Register registers the function with the SDK server
-func (f *ExampleFunction) Register(server *sdk.Server, ags *state.AsyncGlobalState) error {
+func (f *ExampleFunction) Register(server workerfunctions.Server, ags *state.AsyncGlobalState) error {
 	fn := sdk.NewSimpleFunction[ExampleInput, ExampleOutput](
 		f.GetName(),
 		f.GetVersion(),
 		f.GetDescription(),
-	).WithHandler(func(input ExampleInput) (ExampleOutput, error) {
+	).WithHandler(workerfunctions.Instrument(f.GetName(), f.GetVersion(), func(_ context.Context, input ExampleInput) (ExampleOutput, error) {
 		return f.handler(input, ags)
-	}).WithTags(f.GetTags()...)
+	})).WithTags(f.GetTags()...)
 
 	server.RegisterFunction(fn)
 	return nil
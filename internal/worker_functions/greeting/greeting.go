@@ -3,9 +3,18 @@
 package greeting
 
 import (
+	"context"
 	"fmt"
 
 	sdk "github.com/dibbla-agents/sdk-go"
+
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
+)
+
+// Function identity, shared with the metrics and trace attributes
+const (
+	name    = "greeting"
+	version = "1.0.0"
 )
 
 // Input defines what the function receives
@@ -18,21 +27,24 @@ type GreetingOutput struct {
 	Message string `json:"message"`
 }
 
-// Register registers the greeting function with the SDK server
-func Register(server *sdk.Server) {
-	fn := sdk.NewSimpleFunction[GreetingInput, GreetingOutput](
-		"greeting",
-		"1.0.0",
+// Function builds the greeting function
+func Function() *sdk.SimpleFunction[GreetingInput, GreetingOutput] {
+	return sdk.NewSimpleFunction[GreetingInput, GreetingOutput](
+		name,
+		version,
 		"Generate a greeting message",
-	).WithHandler(func(input GreetingInput) (GreetingOutput, error) {
+	).WithHandler(workerfunctions.Instrument(name, version, func(_ context.Context, input GreetingInput) (GreetingOutput, error) {
 		if input.Name == "" {
 			return GreetingOutput{}, fmt.Errorf("name is required")
 		}
 		return GreetingOutput{
 			Message: fmt.Sprintf("Hello, %s!", input.Name),
 		}, nil
-	})
+	}))
+}
 
-	server.RegisterFunction(fn)
+// Register registers the greeting function with the SDK server
+func Register(server workerfunctions.Server) {
+	server.RegisterFunction(Function())
 }
 
@@ -0,0 +1,27 @@
+package workerfunctions
+
+import (
+	"context"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/tracing"
+)
+
+// Instrument wraps a handler so every call is traced, counted in the metrics
+// and recorded in DefaultInvocationLog. The handler gets the context of the function's span; pass it on to
+// jobs, tasks and outbound calls:
+//
+//	sdk.NewSimpleFunction[Input, Output](name, version, "...").
+//	    WithHandler(workerfunctions.Instrument(name, version, handle))
+func Instrument[In, Out any](name, version string, handler func(context.Context, In) (Out, error)) func(In) (Out, error) {
+	return metrics.Instrument(name, version, tracing.Instrument(name, version, logged(name, version, handler)))
+}
+
+// InstrumentFull is Instrument for sdk.NewFunction handlers.
+func InstrumentFull[In, Out, Event, State any](name, version string, handler func(context.Context, In, Event, State) (Out, error)) func(In, Event, State) (Out, error) {
+	return func(input In, event Event, state State) (Out, error) {
+		return Instrument(name, version, func(ctx context.Context, input In) (Out, error) {
+			return handler(ctx, input, event, state)
+		})(input)
+	}
+}
@@ -0,0 +1,98 @@
+package workerfunctions
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+)
+
+// Invocation is one worker function call.
+type Invocation struct {
+	Function   string    `json:"function"`
+	Version    string    `json:"version"`
+	StartedAt  time.Time `json:"started_at"`
+	DurationMs float64   `json:"duration_ms"`
+	Outcome    string    `json:"outcome"` // success, error or panic
+	Error      string    `json:"error,omitempty"`
+	TraceID    string    `json:"trace_id,omitempty"` // Set when tracing is enabled
+}
+
+// InvocationLog keeps the most recent invocations in memory.
+type InvocationLog struct {
+	mu      sync.Mutex
+	entries []Invocation
+	next    int
+	full    bool
+}
+
+// DefaultInvocationLog is filled by Instrument and InstrumentFull.
+var DefaultInvocationLog = NewInvocationLog(200)
+
+// NewInvocationLog keeps the last size invocations (default 200).
+func NewInvocationLog(size int) *InvocationLog {
+	if size <= 0 {
+		size = 200
+	}
+	return &InvocationLog{entries: make([]Invocation, size)}
+}
+
+// Record stores an invocation.
+func (l *InvocationLog) Record(inv Invocation) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries[l.next] = inv
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// Recent returns up to n invocations, newest first (n <= 0 returns all kept).
+func (l *InvocationLog) Recent(n int) []Invocation {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	count := l.next
+	if l.full {
+		count = len(l.entries)
+	}
+	if n <= 0 || n > count {
+		n = count
+	}
+
+	out := make([]Invocation, 0, n)
+	for i := 1; i <= n; i++ {
+		out = append(out, l.entries[(l.next-i+len(l.entries))%len(l.entries)])
+	}
+	return out
+}
+
+// logged wraps a handler so each call is recorded in DefaultInvocationLog.
+// It runs inside the function's span, so the trace ID is known.
+func logged[In, Out any](name, version string, handler func(context.Context, In) (Out, error)) func(context.Context, In) (Out, error) {
+	return func(ctx context.Context, input In) (out Out, err error) {
+		inv := Invocation{Function: name, Version: version, StartedAt: time.Now(), Outcome: metrics.OutcomeSuccess}
+		if sc := trace.SpanContextFromContext(ctx); sc.IsSampled() {
+			inv.TraceID = sc.TraceID().String()
+		}
+		defer func() {
+			inv.DurationMs = float64(time.Since(inv.StartedAt).Microseconds()) / 1000
+			if p := recover(); p != nil {
+				inv.Outcome, inv.Error = metrics.OutcomePanic, fmt.Sprint(p)
+				DefaultInvocationLog.Record(inv)
+				panic(p)
+			}
+			if err != nil {
+				inv.Outcome, inv.Error = metrics.OutcomeError, err.Error()
+			}
+			DefaultInvocationLog.Record(inv)
+		}()
+		return handler(ctx, input)
+	}
+}
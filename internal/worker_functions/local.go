@@ -0,0 +1,99 @@
+package workerfunctions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+
+	sdk "github.com/dibbla-agents/sdk-go"
+)
+
+// Server is what worker functions are registered with: the SDK's *sdk.Server,
+// or a LocalServer in offline mode.
+type Server interface {
+	RegisterFunction(fn sdk.FunctionBuilder)
+	Start() error
+}
+
+// Invoker runs registered functions in this process, for the HTTP API.
+type Invoker interface {
+	Functions() []string
+	Invoke(name string, input []byte) ([]byte, error)
+}
+
+// ErrUnknownFunction is returned by LocalServer.Invoke for names that were not registered.
+var ErrUnknownFunction = errors.New("unknown function")
+
+// LocalServer stands in for the SDK server when there is no Dibbla connection
+// (WORKER_MODE=offline). Registered functions run in-process through Invoke;
+// nothing is published and no gRPC stream is opened. Handlers get a nil SDK
+// global state, so use your own shared state (internal/state) instead.
+//
+// In connected mode the worker registers the functions it exposes to the
+// platform with a LocalServer too, so the HTTP API runs the same set.
+type LocalServer struct {
+	mu        sync.RWMutex
+	functions map[string]Definition
+}
+
+// NewLocalServer creates an empty local server.
+func NewLocalServer() *LocalServer {
+	return &LocalServer{functions: make(map[string]Definition)}
+}
+
+// Register adds a described function; Invoke then rejects input that doesn't
+// decode into its input type with ErrInvalidInput. A later function with the
+// same name replaces the earlier one.
+func (s *LocalServer) Register(def Definition) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.functions[def.Name] = def
+}
+
+// RegisterFunction adds a function built with the SDK, e.g. by
+// Registry.RegisterAll. Its input is passed to the handler unchecked.
+func (s *LocalServer) RegisterFunction(fn sdk.FunctionBuilder) {
+	s.Register(Definition{Name: fn.Build(nil).GetName(), Function: fn})
+}
+
+// Functions returns the registered function names, sorted.
+func (s *LocalServer) Functions() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make([]string, 0, len(s.functions))
+	for name := range s.functions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Invoke runs a registered function with JSON input and returns its JSON output.
+func (s *LocalServer) Invoke(name string, input []byte) ([]byte, error) {
+	s.mu.RLock()
+	def, ok := s.functions[name]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownFunction, name)
+	}
+	return def.Invoke(input)
+}
+
+// Start blocks until the process is interrupted (SIGINT or SIGTERM), like
+// sdk.Server.Start blocks while serving.
+func (s *LocalServer) Start() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("🔌 Offline mode: %d functions available in-process, waiting for interrupt...", len(s.Functions()))
+	<-ctx.Done()
+	log.Println("🛑 Stopping worker")
+	return nil
+}
@@ -0,0 +1,62 @@
+package workerfunctions
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	sdk "github.com/dibbla-agents/sdk-go"
+)
+
+type echoInput struct {
+	Name string `json:"name"`
+}
+
+type echoOutput struct {
+	Message string `json:"message"`
+}
+
+func echoFunction() *sdk.SimpleFunction[echoInput, echoOutput] {
+	return sdk.NewSimpleFunction[echoInput, echoOutput]("echo", "1.0.0", "Echo the name").
+		WithHandler(func(input echoInput) (echoOutput, error) {
+			if input.Name == "" {
+				return echoOutput{}, fmt.Errorf("name is required")
+			}
+			return echoOutput{Message: "hi " + input.Name}, nil
+		})
+}
+
+func TestLocalServerInvoke(t *testing.T) {
+	s := NewLocalServer()
+	s.Register(Simple(echoFunction()))
+
+	if got := s.Functions(); !slices.Equal(got, []string{"echo"}) {
+		t.Fatalf("Functions() = %v", got)
+	}
+
+	output, err := s.Invoke("echo", []byte(`{"name":"Ada"}`))
+	if err != nil || string(output) != `{"message":"hi Ada"}` {
+		t.Errorf("Invoke = %s, %v", output, err)
+	}
+	if _, err := s.Invoke("echo", []byte(`{}`)); err == nil {
+		t.Error("expected the handler's error")
+	}
+	if _, err := s.Invoke("echo", []byte(`{"name":42}`)); !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("wrong input type: err = %v, want ErrInvalidInput", err)
+	}
+	if _, err := s.Invoke("missing", []byte(`{}`)); !errors.Is(err, ErrUnknownFunction) {
+		t.Errorf("unknown function: err = %v, want ErrUnknownFunction", err)
+	}
+}
+
+func TestLocalServerRegisterFunction(t *testing.T) {
+	// Functions registered as SDK builders (Registry.RegisterAll) run unchecked
+	s := NewLocalServer()
+	s.RegisterFunction(echoFunction())
+
+	output, err := s.Invoke("echo", []byte(`{"name":"Grace"}`))
+	if err != nil || string(output) != `{"message":"hi Grace"}` {
+		t.Errorf("Invoke = %s, %v", output, err)
+	}
+}
@@ -7,10 +7,18 @@
 package processbatch
 
 import (
+	"context"
 	"fmt"
 
 	sdk "github.com/dibbla-agents/sdk-go"
 	"github.com/dibbla-agents/go-worker-starter-template/internal/jobs"
+	workerfunctions "github.com/dibbla-agents/go-worker-starter-template/internal/worker_functions"
+)
+
+// Function identity, shared with the metrics and trace attributes
+const (
+	name    = "process_batch"
+	version = "1.0.0"
 )
 
 // Input for the worker function
@@ -27,13 +35,13 @@ type ProcessBatchOutput struct {
 	Error          string  `json:"error,omitempty"`
 }
 
-// Register registers the process_batch function with the SDK server.
-func Register(server *sdk.Server) {
-	fn := sdk.NewSimpleFunction[ProcessBatchInput, ProcessBatchOutput](
-		"process_batch",
-		"1.0.0",
+// Function builds the process_batch function.
+func Function() *sdk.SimpleFunction[ProcessBatchInput, ProcessBatchOutput] {
+	return sdk.NewSimpleFunction[ProcessBatchInput, ProcessBatchOutput](
+		name,
+		version,
 		"Process a batch of items using a job",
-	).WithHandler(func(input ProcessBatchInput) (ProcessBatchOutput, error) {
+	).WithHandler(workerfunctions.Instrument(name, version, func(ctx context.Context, input ProcessBatchInput) (ProcessBatchOutput, error) {
 		// Validate input
 		if input.BatchName == "" {
 			return ProcessBatchOutput{}, fmt.Errorf("batch_name is required")
@@ -44,7 +52,7 @@ func Register(server *sdk.Server) {
 
 		// Create and execute the job
 		job := jobs.NewSimpleJob(input.BatchName, input.ItemCount)
-		result := job.Execute()
+		result := job.ExecuteContext(ctx)
 
 		// Convert job result to worker output
 		output := ProcessBatchOutput{
@@ -59,8 +67,11 @@ func Register(server *sdk.Server) {
 		}
 
 		return output, nil
-	})
+	}))
+}
 
-	server.RegisterFunction(fn)
+// Register registers the process_batch function with the SDK server.
+func Register(server workerfunctions.Server) {
+	server.RegisterFunction(Function())
 }
 
@@ -1,9 +1,12 @@
 package workerfunctions
 
 import (
+	"context"
 	"fmt"
+	"log"
 
-	sdk "github.com/dibbla-agents/sdk-go"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/metrics"
+	"github.com/dibbla-agents/go-worker-starter-template/internal/rbac"
 	"github.com/dibbla-agents/go-worker-starter-template/internal/state"
 )
 
@@ -13,12 +16,13 @@ type WorkerFunction interface {
 	GetVersion() string
 	GetDescription() string
 	GetTags() []string
-	Register(server *sdk.Server, ags *state.AsyncGlobalState) error
+	Register(server Server, ags *state.AsyncGlobalState) error
 }
 
 // Registry manages all registered worker functions
 type Registry struct {
 	functions []WorkerFunction
+	policy    *rbac.Enforcer
 }
 
 // NewRegistry creates a new function registry
@@ -33,19 +37,51 @@ func (r *Registry) Register(fn WorkerFunction) {
 	r.functions = append(r.functions, fn)
 }
 
-// RegisterAll registers all functions with the SDK server
-func (r *Registry) RegisterAll(server *sdk.Server, ags *state.AsyncGlobalState) error {
+// SetPolicy restricts which functions are exposed and who may invoke them
+func (r *Registry) SetPolicy(policy *rbac.Enforcer) {
+	r.policy = policy
+}
+
+// RegisterAll registers all functions with the SDK server (or a LocalServer).
+// Functions the policy denies to the gRPC subject are not registered.
+// Registered functions are listed in the metrics before their first call.
+func (r *Registry) RegisterAll(server Server, ags *state.AsyncGlobalState) error {
 	for _, fn := range r.functions {
+		if d := r.policy.CanInvoke(r.policy.GRPCIdentity(), fn.GetName()); !d.Allowed {
+			log.Printf("   🚫 Skipped: %s (not allowed for %s by policy)", fn.GetName(), d.Subject)
+			continue
+		}
 		if err := fn.Register(server, ags); err != nil {
 			return fmt.Errorf("failed to register function %s: %w", fn.GetName(), err)
 		}
-		fmt.Printf("✅ Registered function: %s (v%s)\n", fn.GetName(), fn.GetVersion())
+		metrics.InitFunction(fn.GetName(), fn.GetVersion())
+		log.Printf("   ✅ Registered: %s (v%s)", fn.GetName(), fn.GetVersion())
 	}
 	return nil
 }
 
+// Authorize checks whether the identity in ctx may invoke the named function.
+// Use it before running functions outside the SDK server (e.g. from HTTP handlers).
+func (r *Registry) Authorize(ctx context.Context, name string) error {
+	return r.policy.Authorize(ctx, name)
+}
+
 // GetFunctions returns all registered functions
 func (r *Registry) GetFunctions() []WorkerFunction {
 	return r.functions
 }
 
+// Definitions describes the registered functions for listings such as the
+// admin API. Only the metadata is set; Input, Output and Function are nil.
+func (r *Registry) Definitions() []Definition {
+	defs := make([]Definition, len(r.functions))
+	for i, fn := range r.functions {
+		defs[i] = Definition{
+			Name:        fn.GetName(),
+			Version:     fn.GetVersion(),
+			Description: fn.GetDescription(),
+			Tags:        fn.GetTags(),
+		}
+	}
+	return defs
+}